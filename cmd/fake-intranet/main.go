@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/joho/godotenv"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet/fakeintranet"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/log"
+	"go.uber.org/zap"
+)
+
+func main() {
+	// Load .env first so that the API key defaults to the one this server sends
+	godotenv.Load()
+
+	addr := flag.String("addr", ":3002", "address to listen on")
+	fixture := flag.String("fixture", "cmd/fake-intranet/employees.json", "JSON file with the list of employees to serve")
+	apiKey := flag.String("api-key", os.Getenv("INTRANET_API_KEY"), "X-API-Key to require; any key is accepted when empty")
+	prefix := flag.String("prefix", "/api/internal/v1", "path before /employees")
+	latency := flag.Duration("latency", 0, "delay every response, e.g. 2s")
+	errorRate := flag.Float64("error-rate", 0, "share of requests, from 0 to 1, answered with --error-status")
+	failFirst := flag.Int("fail-first", 0, "answer this many requests with --error-status before any succeeds")
+	errorStatus := flag.Int("error-status", http.StatusServiceUnavailable, "status of a simulated error")
+	paging := flag.String("paging", fakeintranet.PagingCursor, "how the employee list is paginated: cursor, page or none")
+	pageSize := flag.Int("page-size", 50, "employees in a page when the request does not send page_size")
+	flag.Parse()
+
+	logger, err := log.SetupLogger()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error setting up logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	switch *paging {
+	case fakeintranet.PagingCursor, fakeintranet.PagingPage, fakeintranet.PagingNone:
+	default:
+		zap.S().Errorf("Invalid --paging %q, expected cursor, page or none", *paging)
+		os.Exit(1)
+	}
+
+	employees, err := fakeintranet.LoadFixture(*fixture)
+	if err != nil {
+		zap.S().Error("Failed to load fixture: ", err)
+		os.Exit(1)
+	}
+
+	server := fakeintranet.NewServer(fakeintranet.Config{
+		APIKey:      *apiKey,
+		PathPrefix:  *prefix,
+		Latency:     *latency,
+		ErrorRate:   *errorRate,
+		FailFirst:   *failFirst,
+		ErrorStatus: *errorStatus,
+		Paging:      *paging,
+		PageSize:    *pageSize,
+	}, employees)
+
+	zap.S().Infof("Fake intranet serving %d employees on %s%s/employees", len(employees), *addr, *prefix)
+	if err := http.ListenAndServe(*addr, server); err != nil {
+		zap.S().Error("Fake intranet stopped: ", err)
+		os.Exit(1)
+	}
+}
@@ -13,8 +13,10 @@ import (
 	"github.com/joshsoftware/profile_builder_backend_go/internal/api"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/notifier"
 	cronjob "github.com/joshsoftware/profile_builder_backend_go/internal/cron-job"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	jwttoken "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/jwt_token"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/log"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
 	"github.com/rs/cors"
@@ -39,6 +41,13 @@ func main() {
 		return
 	}
 
+	//Load JWT signing and verification keys
+	err = jwttoken.InitKeySet()
+	if err != nil {
+		logger.Error("JWT key setup error : ", zap.Error(err))
+		return
+	}
+
 	fmt.Println("Starting Server...")
 	defer fmt.Println("Shutting Down Server...")
 
@@ -50,16 +59,42 @@ func main() {
 	}
 	fmt.Println("Connected to Database!")
 	defer db.Close()
+
+	// Intranet lookups are cached unless INTRANET_CACHE_TTL is 0
+	intranetClient := intranet.NewClient(os.Getenv("INTRANET_API_BASE_URL"), os.Getenv("INTRANET_API_KEY"))
+	if cacheConfig := intranet.CacheConfigFromEnv(); cacheConfig.TTL > 0 {
+		cache := intranet.NewMemoryCache(cacheConfig.MaxEntries, cacheConfig.TTL+cacheConfig.StaleTTL)
+		intranetClient = intranet.NewCachedClient(intranetClient, cache, cacheConfig)
+	}
+
+	// Emails go out through the backend chosen with EMAIL_BACKEND
+	emailNotifier, err := notifier.New(notifier.ConfigFromEnv())
+	if err != nil {
+		logger.Error("Email notifier setup error : ", zap.Error(err))
+		return
+	}
+
 	var repodeps = service.RepoDeps{
-		UserLoginDeps:   repository.NewUserLoginRepo(db),
-		UserEmailDeps:   repository.NewUserEmailRepo(db),
-		ProfileDeps:     repository.NewProfileRepo(db),
-		EducationDeps:   repository.NewEducationRepo(db),
-		ExperienceDeps:  repository.NewExperienceRepo(db),
-		ProjectDeps:     repository.NewProjectRepo(db),
-		CertificateDeps: repository.NewCertificateRepo(db),
-		AchievementDeps: repository.NewAchievementRepo(db),
-		IntranetClient:  intranet.NewClient(os.Getenv("INTRANET_API_BASE_URL"), os.Getenv("INTRANET_API_KEY")),
+		UserLoginDeps:       repository.NewUserLoginRepo(db),
+		UserEmailDeps:       repository.NewUserEmailRepo(db),
+		ProfileDeps:         repository.NewProfileRepo(db),
+		EducationDeps:       repository.NewEducationRepo(db),
+		ExperienceDeps:      repository.NewExperienceRepo(db),
+		ProjectDeps:         repository.NewProjectRepo(db),
+		CertificateDeps:     repository.NewCertificateRepo(db),
+		AchievementDeps:     repository.NewAchievementRepo(db),
+		RoleDeps:            repository.NewRoleRepo(db),
+		AuditDeps:           repository.NewAuditRepo(db),
+		APIKeyDeps:          repository.NewAPIKeyRepo(db),
+		IntranetSyncDeps:    repository.NewIntranetSyncRepo(db),
+		IntranetWebhookDeps: repository.NewIntranetWebhookRepo(db),
+		EmailOutboxDeps:     repository.NewEmailOutboxRepo(db),
+		EmailTemplateDeps:   repository.NewEmailTemplateRepo(db),
+		NotificationDeps:    repository.NewNotificationRepo(db),
+		ProfileReminderDeps: repository.NewProfileReminderRepo(db),
+		BackupDeps:          repository.NewBackupRepo(db),
+		IntranetClient:      intranetClient,
+		Notifier:            emailNotifier,
 	}
 
 	//Initializing Services
@@ -68,8 +103,12 @@ func main() {
 	//Initialize CRON Jobs
 	cronjob.InitCronJob(services)
 
+	// Cancelled once the server starts shutting down, so that long-lived requests such as notification streams end
+	serverCtx, stopServer := context.WithCancel(ctx)
+	defer stopServer()
+
 	//Initializing Router
-	router := api.NewRouter(ctx, services)
+	router := api.NewRouter(serverCtx, services)
 
 	// CORS middleware
 	cors := cors.New(constants.CorsOptions)
@@ -79,6 +118,7 @@ func main() {
 		Addr:    os.Getenv("PORT_INFO"),
 		Handler: cors.Handler(router),
 	}
+	server.RegisterOnShutdown(stopServer)
 
 	go func() {
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
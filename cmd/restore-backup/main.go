@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/joho/godotenv"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/log"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"go.uber.org/zap"
+)
+
+func main() {
+	file := flag.String("file", "", "the backup archive to restore")
+	check := flag.Bool("check", false, "only check that the archive is complete, without connecting to the database")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	// Set up zap logger
+	logger, err := log.SetupLogger()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error setting up logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	if *file == "" {
+		zap.S().Error("-file must be set to the backup archive to restore")
+		os.Exit(1)
+	}
+
+	// Load .env
+	if err := godotenv.Load(); err != nil {
+		zap.S().Warn("No .env file found, relying on environment variables")
+	}
+
+	var report specs.BackupReport
+	if *check {
+		report, err = service.NewServices(service.RepoDeps{}).CheckBackup(ctx, *file)
+		if err != nil {
+			zap.S().Error("Backup check failed: ", err)
+			os.Exit(1)
+		}
+		printSummary("Backup is complete", report)
+		return
+	}
+
+	// Initialize DB
+	db, err := repository.InitializeDatabase(ctx)
+	if err != nil {
+		zap.S().Error("Failed to connect to database: ", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+	zap.S().Info("Connected to database")
+
+	// Build dependencies
+	repoDeps := service.RepoDeps{
+		ProfileDeps: repository.NewProfileRepo(db),
+		BackupDeps:  repository.NewBackupRepo(db),
+	}
+
+	svc := service.NewServices(repoDeps)
+
+	zap.S().Info("Restoring backup ", *file, "...")
+	report, err = svc.RestoreBackup(ctx, *file)
+	if err != nil {
+		zap.S().Error("Restore failed: ", err)
+		os.Exit(1)
+	}
+	if !report.TriggersDisabled {
+		zap.S().Warn("The database user is not a superuser, so the triggers of the restored tables fired during the restore")
+	}
+	printSummary("Restore complete", report)
+}
+
+// printSummary prints the number of rows of every table in the archive
+func printSummary(title string, report specs.BackupReport) {
+	tables := make([]string, 0, len(report.RowCounts))
+	for table := range report.RowCounts {
+		tables = append(tables, table)
+	}
+	slices.Sort(tables)
+
+	counts := make([]string, len(tables))
+	for i, table := range tables {
+		counts[i] = fmt.Sprintf("%s: %d", table, report.RowCounts[table])
+	}
+	summary := fmt.Sprintf("%s. Taken: %s, Schema version: %d, Rows: %s", title, report.CreatedAt.Format("2006-01-02 15:04:05 MST"), report.SchemaVersion, strings.Join(counts, ", "))
+	if report.Checksum != "" {
+		summary += ", SHA-256: " + report.Checksum
+	}
+	zap.S().Info(summary)
+	fmt.Println(summary)
+}
@@ -2,18 +2,29 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/joho/godotenv"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
 	intranetclient "github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/log"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
 	"go.uber.org/zap"
 )
 
 func main() {
+	dryRun := flag.Bool("dry-run", false, "plan the changes without writing them to the database")
+	only := flag.String("only", "", "comma separated emails or employee ids to sync; all employees when empty")
+	deactivateLeavers := flag.Bool("deactivate-leavers", false, "also deactivate the profiles of employees who have left")
+	incremental := flag.Bool("incremental", false, "fetch only the employees changed since the last successful sync")
+	reportPath := flag.String("report", "", "write a report of every employee to this file, as CSV if it ends in .csv and as JSON otherwise")
+	flag.Parse()
+
 	ctx := context.Background()
 
 	// Set up zap logger
@@ -48,21 +59,54 @@ func main() {
 
 	// Build dependencies
 	repoDeps := service.RepoDeps{
-		ProfileDeps:    repository.NewProfileRepo(db),
-		IntranetClient: intranetclient.NewClient(baseURL, apiKey),
+		ProfileDeps:      repository.NewProfileRepo(db),
+		IntranetSyncDeps: repository.NewIntranetSyncRepo(db),
+		UserLoginDeps:    repository.NewUserLoginRepo(db),
+		IntranetClient:   intranetclient.NewClient(baseURL, apiKey),
 	}
 
 	svc := service.NewServices(repoDeps)
 
+	opts := specs.SyncEmployeesOptions{DryRun: *dryRun, DeactivateLeavers: *deactivateLeavers, Incremental: *incremental}
+	for _, value := range strings.Split(*only, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			opts.Only = append(opts.Only, value)
+		}
+	}
+
 	// Run sync
-	zap.S().Info("Starting employee ID sync...")
-	updated, skipped, err := svc.SyncEmployees(ctx)
+	if opts.DryRun {
+		zap.S().Info("Starting employee sync dry run, no changes will be written...")
+	} else {
+		zap.S().Info("Starting employee sync...")
+	}
+	// runs that write are recorded in the sync run history, and refused while another run has not finished
+	var report specs.SyncEmployeesReport
+	if opts.DryRun {
+		report, err = svc.SyncEmployees(ctx, opts)
+	} else {
+		_, report, err = svc.RunEmployeeSync(ctx, constants.SyncTriggerCommand, 0, opts)
+	}
 	if err != nil {
 		zap.S().Error("Employee sync failed: ", err)
 		os.Exit(1)
 	}
 
-	summary := fmt.Sprintf("Sync complete. Updated: %d, Skipped: %d", updated, skipped)
+	if *reportPath != "" {
+		if err := writeReport(*reportPath, report); err != nil {
+			zap.S().Error("Failed to write sync report: ", err)
+			os.Exit(1)
+		}
+		zap.S().Info("Sync report written to ", *reportPath)
+	}
+
+	summary := fmt.Sprintf("Sync complete. Updated: %d, Unchanged: %d, Skipped: %d, Left: %d, Rejoined: %d", report.Updated, report.Unchanged, report.Skipped, report.Left, report.Rejoined)
+	if opts.DryRun {
+		summary = fmt.Sprintf("Dry run complete. Would update: %d, Unchanged: %d, Skipped: %d, Left: %d, Rejoined: %d", report.Updated, report.Unchanged, report.Skipped, report.Left, report.Rejoined)
+	}
+	if report.LeaverCheckSkipped != "" {
+		summary += fmt.Sprintf(". Leavers were not checked because %s", report.LeaverCheckSkipped)
+	}
 	zap.S().Info(summary)
 	fmt.Println(summary)
 }
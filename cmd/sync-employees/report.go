@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// reportCSVHeader lists the columns of a CSV report, which has one row per changed field
+var reportCSVHeader = []string{"employee_id", "email", "profile_id", "status", "employment", "skip_reason", "error", "field", "old_value", "new_value", "policy", "reason"}
+
+// writeReport writes the sync report to path, as CSV if the file ends in .csv and as JSON otherwise
+func writeReport(path string, report specs.SyncEmployeesReport) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		err = writeCSVReport(file, report)
+	} else {
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(report)
+	}
+	if err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+// writeCSVReport writes a row for every changed field, and a single row without a field for employees with no changes
+func writeCSVReport(file *os.File, report specs.SyncEmployeesReport) error {
+	writer := csv.NewWriter(file)
+	if err := writer.Write(reportCSVHeader); err != nil {
+		return err
+	}
+
+	for _, result := range report.Employees {
+		profileID := ""
+		if result.ProfileID != 0 {
+			profileID = strconv.Itoa(result.ProfileID)
+		}
+		row := []string{result.EmployeeID, result.Email, profileID, result.Status, result.Employment, result.SkipReason, result.Error}
+
+		if len(result.Changes) == 0 {
+			if err := writer.Write(append(row, "", "", "", "", "")); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, change := range result.Changes {
+			changeRow := append(append([]string{}, row...), change.Field, change.OldValue, change.NewValue, change.Policy, change.Reason)
+			if err := writer.Write(changeRow); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -42,7 +42,7 @@ func CreateAchievementHandler(ctx context.Context, profileSvc service.Service) f
 			return
 		}
 
-		profileID, err = profileSvc.CreateAchievement(ctx, req, profileID, userID)
+		profileID, err = profileSvc.CreateAchievement(r.Context(), req, profileID, userID)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error("Unable to create achievement : ", err, "for profile id : ", profileID)
@@ -87,7 +87,7 @@ func UpdateAchievementHandler(ctx context.Context, achSvc service.Service) func(
 			return
 		}
 
-		updatedResp, err := achSvc.UpdateAchievement(ctx, profileID, achID, userID, req)
+		updatedResp, err := achSvc.UpdateAchievement(r.Context(), profileID, achID, userID, req)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error("Unable to update achievement : ", err, " for profile id : ", profileID, "achievement id : ", achID)
@@ -118,7 +118,7 @@ func ListAchievementsHandler(ctx context.Context, achSvc service.Service) func(h
 			return
 		}
 
-		achivementsResp, err := achSvc.ListAchievements(ctx, profileID, filter)
+		achivementsResp, err := achSvc.ListAchievements(r.Context(), profileID, filter)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailespecsFetch)
 			zap.S().Error("Unable to fetch achievement : ", err, "for profile id : ", profileID)
@@ -146,7 +146,7 @@ func DeleteAchievementHandler(ctx context.Context, achSvc service.Service) func(
 		}
 
 		// call the service
-		err = achSvc.DeleteAchievement(ctx, profileID, achievementID)
+		err = achSvc.DeleteAchievement(r.Context(), profileID, achievementID)
 		if err != nil {
 			if err == errors.ErrNoData {
 				middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
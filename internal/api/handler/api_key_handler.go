@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// ListAPIKeysHandler returns an HTTP handler that lists API keys using apiKeySvc.
+func ListAPIKeysHandler(ctx context.Context, apiKeySvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := apiKeySvc.ListAPIKeys(r.Context())
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list API keys : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// CreateAPIKeyHandler returns an HTTP handler that issues an API key using apiKeySvc.
+func CreateAPIKeyHandler(ctx context.Context, apiKeySvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		req, err := decodeCreateAPIKeyRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = req.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := apiKeySvc.CreateAPIKey(r.Context(), userID, req)
+		if err != nil {
+			if err == errors.ErrDuplicateKey {
+				middleware.ErrorResponse(w, http.StatusConflict, err)
+				return
+			}
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToCreate)
+			zap.S().Error("Unable to create API key : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusCreated, resp)
+	}
+}
+
+// RotateAPIKeyHandler returns an HTTP handler that replaces the key of an API key using apiKeySvc.
+func RotateAPIKeyHandler(ctx context.Context, apiKeySvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		apiKeyID, err := helpers.GetParamsByID(r, constants.APIKeyID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		resp, err := apiKeySvc.RotateAPIKey(r.Context(), apiKeyID)
+		if err != nil {
+			writeAPIKeyError(w, err, errors.ErrFailedToUpdateRecord)
+			zap.S().Error("Unable to rotate API key : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// RevokeAPIKeyHandler returns an HTTP handler that revokes an API key using apiKeySvc.
+func RevokeAPIKeyHandler(ctx context.Context, apiKeySvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		apiKeyID, err := helpers.GetParamsByID(r, constants.APIKeyID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		err = apiKeySvc.RevokeAPIKey(r.Context(), apiKeyID)
+		if err != nil {
+			writeAPIKeyError(w, err, errors.ErrFailedToUpdateRecord)
+			zap.S().Error("Unable to revoke API key : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
+			Message: "API key revoked successfully",
+		})
+	}
+}
+
+// writeAPIKeyError maps API key management errors to their HTTP status, falling back to fallbackErr.
+func writeAPIKeyError(w http.ResponseWriter, err error, fallbackErr error) {
+	switch err {
+	case errors.ErrAPIKeyNotFound:
+		middleware.ErrorResponse(w, http.StatusNotFound, err)
+	case errors.ErrAPIKeyRevoked:
+		middleware.ErrorResponse(w, http.StatusConflict, err)
+	default:
+		middleware.ErrorResponse(w, http.StatusBadGateway, fallbackErr)
+	}
+}
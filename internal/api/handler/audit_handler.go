@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// ListAuditEventsHandler returns an HTTP handler that lists audit events using auditSvc.
+func ListAuditEventsHandler(ctx context.Context, auditSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := helpers.DecodeAuditEventsRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := auditSvc.ListAuditEvents(r.Context(), filter)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list audit events : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// ListBackupsHandler returns a handler that lists the backups of the database with their size, checksum and
+// whether they were verified.
+func ListBackupsHandler(ctx context.Context, backupSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := backupSvc.ListBackups(r.Context())
+		if err != nil {
+			if err == errors.ErrBackupDirNotSet {
+				middleware.ErrorResponse(w, http.StatusServiceUnavailable, err)
+				return
+			}
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list backups : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
@@ -44,7 +44,7 @@ func CreateCertificateHandler(ctx context.Context, certificateSvc service.Servic
 			return
 		}
 
-		profileID, err = certificateSvc.CreateCertificate(ctx, req, profileID, userID)
+		profileID, err = certificateSvc.CreateCertificate(r.Context(), req, profileID, userID)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error("Unable to create certificate : ", err, "for profile id : ", profileID)
@@ -75,7 +75,7 @@ func ListCertificatesHandler(ctx context.Context, certificateSvc service.Service
 			return
 		}
 
-		cetificateResp, err := certificateSvc.ListCertificates(ctx, profileID, filter)
+		cetificateResp, err := certificateSvc.ListCertificates(r.Context(), profileID, filter)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailespecsFetch)
 			zap.S().Error("Unable to fetch certificate : ", err, "for profile id : ", profileID)
@@ -123,7 +123,7 @@ func UpdateCertificateHandler(ctx context.Context, certificateSvc service.Servic
 			return
 		}
 
-		updatedResp, err := certificateSvc.UpdateCertificate(ctx, profileID, certID, userID, req)
+		updatedResp, err := certificateSvc.UpdateCertificate(r.Context(), profileID, certID, userID, req)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error("Unable to update certificate : ", err, "for profile id : ", profileID, "certificate id : ", certID)
@@ -147,7 +147,7 @@ func DeleteCertificatesHandler(ctx context.Context, certificateSvc service.Servi
 			return
 		}
 		// call the service
-		err = certificateSvc.DeleteCertificate(ctx, profileID, certificateID)
+		err = certificateSvc.DeleteCertificate(r.Context(), profileID, certificateID)
 		if err != nil {
 			if err == errors.ErrNoData {
 				middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
@@ -2,7 +2,13 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
 
 	errors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
@@ -199,3 +205,328 @@ func decodeUpdateAchievementRequest(r *http.Request) (specs.UpdateAchievementReq
 
 	return req, nil
 }
+
+// Decodes the Role Creation object Request
+func decodeCreateRoleRequest(r *http.Request) (specs.CreateRoleRequest, error) {
+	var req specs.CreateRoleRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.CreateRoleRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the Role Updation object Request
+func decodeUpdateRoleRequest(r *http.Request) (specs.UpdateRoleRequest, error) {
+	var req specs.UpdateRoleRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.UpdateRoleRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the Role Assignment object Request
+func decodeAssignRoleRequest(r *http.Request) (specs.AssignRoleRequest, error) {
+	var req specs.AssignRoleRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.AssignRoleRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the Manager Assignment object Request
+func decodeAssignManagerRequest(r *http.Request) (specs.AssignManagerRequest, error) {
+	var req specs.AssignManagerRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.AssignManagerRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the User Creation object Request
+func decodeCreateUserRequest(r *http.Request) (specs.CreateUserRequest, error) {
+	var req specs.CreateUserRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.CreateUserRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the User Updation object Request
+func decodeUpdateUserRequest(r *http.Request) (specs.UpdateUserRequest, error) {
+	var req specs.UpdateUserRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.UpdateUserRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the User Status object Request
+func decodeUpdateUserStatusRequest(r *http.Request) (specs.UpdateUserStatusRequest, error) {
+	var req specs.UpdateUserStatusRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.UpdateUserStatusRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the API Key Creation object Request
+func decodeCreateAPIKeyRequest(r *http.Request) (specs.CreateAPIKeyRequest, error) {
+	var req specs.CreateAPIKeyRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.CreateAPIKeyRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the Resolve Employees object Request
+func decodeResolveEmployeesRequest(r *http.Request) (specs.ResolveEmployeesRequest, error) {
+	var req specs.ResolveEmployeesRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.ResolveEmployeesRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the Start Sync Run Request. An empty body starts a full sync.
+func decodeStartSyncRunRequest(r *http.Request) (specs.StartSyncRunRequest, error) {
+	var req specs.StartSyncRunRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil && err != io.EOF {
+		zap.S().Error(err)
+		return specs.StartSyncRunRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the limit of the List Sync Runs Request
+func decodeListSyncRunsRequest(r *http.Request) (int, error) {
+	value := r.URL.Query().Get(constants.SyncRunsLimitStr)
+	if value == "" {
+		return constants.DefaultSyncRunsLimit, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > constants.MaxSyncRunsLimit {
+		return 0, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), constants.SyncRunsLimitStr)
+	}
+	return limit, nil
+}
+
+// Decodes the Apply Intranet Projects Request
+func decodeApplyIntranetProjectsRequest(r *http.Request) (specs.ApplyIntranetProjectsRequest, error) {
+	var req specs.ApplyIntranetProjectsRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.ApplyIntranetProjectsRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes an Intranet Webhook Event, returning the raw body along with it to be stored as sent
+func decodeIntranetWebhookEvent(r *http.Request) (specs.IntranetWebhookEvent, []byte, error) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.IntranetWebhookEvent{}, nil, errors.ErrInvalidBody
+	}
+
+	var event specs.IntranetWebhookEvent
+	err = json.Unmarshal(payload, &event)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.IntranetWebhookEvent{}, nil, errors.ErrInvalidBody
+	}
+
+	return event, payload, nil
+}
+
+// Decodes the filter of the List Intranet Webhook Events Request
+func decodeListIntranetWebhookEventsRequest(r *http.Request) (specs.ListIntranetWebhookEventsFilter, error) {
+	filter := specs.ListIntranetWebhookEventsFilter{
+		Status: r.URL.Query().Get(constants.WebhookEventsStatusStr),
+		Limit:  constants.DefaultWebhookEventsLimit,
+	}
+
+	switch filter.Status {
+	case "", constants.WebhookEventPending, constants.WebhookEventProcessing, constants.WebhookEventProcessed,
+		constants.WebhookEventFailed, constants.WebhookEventDead:
+	default:
+		return specs.ListIntranetWebhookEventsFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), constants.WebhookEventsStatusStr)
+	}
+
+	if value := r.URL.Query().Get(constants.WebhookEventsLimitStr); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > constants.MaxWebhookEventsLimit {
+			return specs.ListIntranetWebhookEventsFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), constants.WebhookEventsLimitStr)
+		}
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
+
+// Decodes the filter of the List Outbox Emails Request
+func decodeListOutboxEmailsRequest(r *http.Request) (specs.ListOutboxEmailsFilter, error) {
+	filter := specs.ListOutboxEmailsFilter{
+		Status: r.URL.Query().Get(constants.EmailsStatusStr),
+		Limit:  constants.DefaultEmailsLimit,
+	}
+
+	switch filter.Status {
+	case "", constants.EmailPending, constants.EmailSending, constants.EmailSent, constants.EmailFailed, constants.EmailDead:
+	default:
+		return specs.ListOutboxEmailsFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), constants.EmailsStatusStr)
+	}
+
+	if value := r.URL.Query().Get(constants.EmailsLimitStr); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > constants.MaxEmailsLimit {
+			return specs.ListOutboxEmailsFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), constants.EmailsLimitStr)
+		}
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
+
+// Decodes the Email Template Updation object Request
+func decodeUpdateEmailTemplateRequest(r *http.Request) (specs.UpdateEmailTemplateRequest, error) {
+	var req specs.UpdateEmailTemplateRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		zap.S().Error(err)
+		return specs.UpdateEmailTemplateRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the Email Template Preview object Request. An empty body previews the latest template as it is.
+func decodePreviewEmailTemplateRequest(r *http.Request) (specs.PreviewEmailTemplateRequest, error) {
+	var req specs.PreviewEmailTemplateRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil && err != io.EOF {
+		zap.S().Error(err)
+		return specs.PreviewEmailTemplateRequest{}, errors.ErrInvalidBody
+	}
+
+	return req, nil
+}
+
+// Decodes the filter of the List Invitations Request. Dates without a time are days in IST, and a to date includes
+// the whole day.
+func decodeListInvitationsRequest(r *http.Request) (specs.ListInvitationsFilter, error) {
+	query := r.URL.Query()
+	filter := specs.ListInvitationsFilter{
+		Status: query.Get(constants.InvitationsStatusStr),
+		Page:   1,
+		Limit:  constants.DefaultInvitationsLimit,
+	}
+
+	intParams := map[string]*int{
+		constants.InvitationsInvitedByStr: &filter.InvitedByID,
+		constants.InvitationsPageStr:      &filter.Page,
+		constants.InvitationsLimitStr:     &filter.Limit,
+	}
+	for name, target := range intParams {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return specs.ListInvitationsFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), name)
+		}
+		*target = parsed
+	}
+
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	timeParams := map[string]**time.Time{
+		constants.InvitationsFromStr: &filter.From,
+		constants.InvitationsToStr:   &filter.To,
+	}
+	for name, target := range timeParams {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			parsed, err = time.ParseInLocation(time.DateOnly, value, ist)
+			if err == nil && name == constants.InvitationsToStr {
+				parsed = parsed.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			}
+		}
+		if err != nil {
+			return specs.ListInvitationsFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), name)
+		}
+		*target = &parsed
+	}
+
+	return filter, filter.Validate()
+}
+
+// Decodes the filter of the List Notifications Request
+func decodeListNotificationsRequest(r *http.Request) (specs.ListNotificationsFilter, error) {
+	query := r.URL.Query()
+	filter := specs.ListNotificationsFilter{
+		Page:  1,
+		Limit: constants.DefaultNotificationsLimit,
+	}
+
+	if value := query.Get(constants.NotificationsUnreadStr); value != "" {
+		unread, err := strconv.ParseBool(value)
+		if err != nil {
+			return specs.ListNotificationsFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), constants.NotificationsUnreadStr)
+		}
+		filter.UnreadOnly = unread
+	}
+
+	intParams := map[string]*int{
+		constants.NotificationsPageStr:  &filter.Page,
+		constants.NotificationsLimitStr: &filter.Limit,
+	}
+	for name, target := range intParams {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return specs.ListNotificationsFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), name)
+		}
+		*target = parsed
+	}
+
+	return filter, filter.Validate()
+}
@@ -44,7 +44,7 @@ func CreateEducationHandler(ctx context.Context, profileSvc service.Service) fun
 			return
 		}
 
-		profileID, err = profileSvc.CreateEducation(ctx, req, profileID, userID)
+		profileID, err = profileSvc.CreateEducation(r.Context(), req, profileID, userID)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error("Unable to create education : ", err, " for profile id : ", profileID)
@@ -74,7 +74,7 @@ func ListEducationHandler(ctx context.Context, eduSvc service.Service) func(http
 			return
 		}
 
-		eduResp, err := eduSvc.ListEducations(ctx, profileID, filter)
+		eduResp, err := eduSvc.ListEducations(r.Context(), profileID, filter)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailespecsFetch)
 			zap.S().Error("Unable to fetch educations : ", err, "for profile id : ", profileID)
@@ -122,7 +122,7 @@ func UpdateEducationHandler(ctx context.Context, eduSvc service.Service) func(ht
 			return
 		}
 
-		updatedResp, err := eduSvc.UpdateEducation(ctx, profileID, eduID, userID, req)
+		updatedResp, err := eduSvc.UpdateEducation(r.Context(), profileID, eduID, userID, req)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error("Unable to update education : ", err, "for profile id : ", profileID, "education id : ", eduID)
@@ -145,7 +145,7 @@ func DeleteEducationHandler(ctx context.Context, eduSvc service.Service) func(ht
 			zap.S().Error("error while getting the IDs from request : ", err)
 			return
 		}
-		err = eduSvc.DeleteEducation(ctx, profileID, educationID)
+		err = eduSvc.DeleteEducation(r.Context(), profileID, educationID)
 		if err != nil {
 			if err == errors.ErrNoData {
 				middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// ListOutboxEmailsHandler returns a handler that lists the latest emails in the outbox, e.g. the failed ones.
+func ListOutboxEmailsHandler(ctx context.Context, outboxSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := decodeListOutboxEmailsRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := outboxSvc.ListOutboxEmails(r.Context(), filter)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list emails : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// GetOutboxEmailHandler returns a handler that returns an email in the outbox.
+func GetOutboxEmailHandler(ctx context.Context, outboxSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := helpers.GetParamsByID(r, constants.EmailID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		resp, err := outboxSvc.GetOutboxEmail(r.Context(), int64(id))
+		if err != nil {
+			if err == errors.ErrOutboxEmailNotFound {
+				middleware.ErrorResponse(w, http.StatusNotFound, err)
+				return
+			}
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to get email : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// ResendOutboxEmailHandler returns a handler that sends an email in the outbox again in the background.
+func ResendOutboxEmailHandler(ctx context.Context, outboxSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := helpers.GetParamsByID(r, constants.EmailID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		resp, err := outboxSvc.ResendOutboxEmail(r.Context(), int64(id))
+		if err != nil {
+			switch err {
+			case errors.ErrOutboxEmailNotFound:
+				middleware.ErrorResponse(w, http.StatusNotFound, err)
+			case errors.ErrEmailNotResendable:
+				middleware.ErrorResponse(w, http.StatusConflict, err)
+			default:
+				middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToUpdateRecord)
+				zap.S().Error("Unable to resend email : ", err)
+			}
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusAccepted, resp)
+	}
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// ListEmailTemplatesHandler returns a handler that lists the latest template of every kind of email.
+func ListEmailTemplatesHandler(ctx context.Context, templateSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := templateSvc.ListEmailTemplates(r.Context())
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list email templates : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// GetEmailTemplateHandler returns a handler that returns the latest template of a kind of email.
+func GetEmailTemplateHandler(ctx context.Context, templateSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := templateSvc.GetEmailTemplate(r.Context(), mux.Vars(r)[constants.EmailTemplateKind])
+		if err != nil {
+			if err == errors.ErrEmailTemplateNotFound {
+				middleware.ErrorResponse(w, http.StatusNotFound, err)
+				return
+			}
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to get email template : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// ListEmailTemplateVersionsHandler returns a handler that lists every version of the template of a kind of email.
+func ListEmailTemplateVersionsHandler(ctx context.Context, templateSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := templateSvc.ListEmailTemplateVersions(r.Context(), mux.Vars(r)[constants.EmailTemplateKind])
+		if err != nil {
+			if err == errors.ErrEmailTemplateNotFound {
+				middleware.ErrorResponse(w, http.StatusNotFound, err)
+				return
+			}
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list email template versions : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// UpdateEmailTemplateHandler returns a handler that saves a new version of the template of a kind of email.
+func UpdateEmailTemplateHandler(ctx context.Context, templateSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		req, err := decodeUpdateEmailTemplateRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = req.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := templateSvc.UpdateEmailTemplate(r.Context(), mux.Vars(r)[constants.EmailTemplateKind], userID, req)
+		if err != nil {
+			writeEmailTemplateError(w, err, errors.ErrFailedToCreate)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusCreated, resp)
+	}
+}
+
+// PreviewEmailTemplateHandler returns a handler that renders a template of a kind of email without saving it.
+func PreviewEmailTemplateHandler(ctx context.Context, templateSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := decodePreviewEmailTemplateRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := templateSvc.PreviewEmailTemplate(r.Context(), mux.Vars(r)[constants.EmailTemplateKind], req)
+		if err != nil {
+			writeEmailTemplateError(w, err, errors.ErrFailedToGet)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// writeEmailTemplateError responds to a failure to save or render an email template. A template that does not
+// render is reported along with why, so that it can be fixed.
+func writeEmailTemplateError(w http.ResponseWriter, err error, failure error) {
+	switch {
+	case err == errors.ErrEmailTemplateNotFound || err == errors.ErrNoRecordFound:
+		middleware.ErrorResponse(w, http.StatusNotFound, err)
+	case errors.IsInvalidEmailTemplate(err):
+		middleware.ErrorResponse(w, http.StatusBadRequest, err)
+	case err == errors.ErrDuplicateKey:
+		middleware.ErrorResponse(w, http.StatusConflict, err)
+	default:
+		middleware.ErrorResponse(w, http.StatusBadGateway, failure)
+		zap.S().Error("Unable to save or preview email template : ", err)
+	}
+}
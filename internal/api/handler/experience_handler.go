@@ -44,7 +44,7 @@ func CreateExperienceHandler(ctx context.Context, profileSvc service.Service) fu
 			return
 		}
 
-		profileID, err = profileSvc.CreateExperience(ctx, req, profileID, userID)
+		profileID, err = profileSvc.CreateExperience(r.Context(), req, profileID, userID)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error("Unable to create experiences : ", err, "for profile id : ", profileID)
@@ -75,7 +75,7 @@ func ListExperienceHandler(ctx context.Context, expSvc service.Service) func(htt
 			return
 		}
 
-		expResp, err := expSvc.ListExperiences(ctx, profileID, filter)
+		expResp, err := expSvc.ListExperiences(r.Context(), profileID, filter)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailespecsFetch)
 			zap.S().Error("Unable to fetch experiences : ", err, "for profile id : ", profileID)
@@ -123,7 +123,7 @@ func UpdateExperienceHandler(ctx context.Context, eduSvc service.Service) func(h
 			return
 		}
 
-		updatedResp, err := eduSvc.UpdateExperience(ctx, profileID, expID, userID, req)
+		updatedResp, err := eduSvc.UpdateExperience(r.Context(), profileID, expID, userID, req)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error("Unable to update experience : ", err, "for profile id : ", profileID, "experience id : ", expID)
@@ -147,7 +147,7 @@ func DeleteExperienceHandler(ctx context.Context, expSvc service.Service) func(h
 			return
 		}
 
-		err = expSvc.DeleteExperience(ctx, profileID, experienceID)
+		err = expSvc.DeleteExperience(r.Context(), profileID, experienceID)
 
 		if err != nil {
 			if err == errors.ErrNoData {
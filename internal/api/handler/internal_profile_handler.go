@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// GetInternalProfileHandler handles request from other services to read the full profile of an employee.
+func GetInternalProfileHandler(ctx context.Context, internalSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		employeeID, ok := mux.Vars(r)["employee_id"]
+		if !ok || employeeID == "" {
+			middleware.ErrorResponse(w, http.StatusBadRequest, errors.ErrInvalidRequestData)
+			zap.S().Error("employee_id missing from request vars")
+			return
+		}
+
+		resp, err := internalSvc.GetInternalProfile(r.Context(), employeeID)
+		if err != nil {
+			if err == errors.ErrNoRecordFound {
+				middleware.ErrorResponse(w, http.StatusNotFound, err)
+				return
+			}
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to get internal profile : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// ResolveEmployeesHandler handles request from other services to resolve many employee ids to profile ids.
+func ResolveEmployeesHandler(ctx context.Context, internalSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := decodeResolveEmployeesRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = req.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := internalSvc.ResolveEmployeeIDs(r.Context(), req)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to resolve employee IDs : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// ListProfileChangesHandler handles request from other services to list the profiles changed since their last sync.
+func ListProfileChangesHandler(ctx context.Context, internalSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := helpers.DecodeProfileChangesRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := internalSvc.ListProfileChanges(r.Context(), filter)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list profile changes : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
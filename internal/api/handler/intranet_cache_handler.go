@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// GetIntranetCacheStatsHandler returns a handler that reports how often intranet lookups were answered from cache.
+func GetIntranetCacheStatsHandler(ctx context.Context, cacheSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		middleware.SuccessResponse(w, http.StatusOK, cacheSvc.GetIntranetCacheStats(r.Context()))
+	}
+}
+
+// ClearIntranetCacheHandler returns a handler that forgets every cached intranet lookup.
+func ClearIntranetCacheHandler(ctx context.Context, cacheSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cacheSvc.InvalidateIntranetCache(r.Context(), "")
+
+		middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
+			Message: "Intranet cache cleared",
+		})
+	}
+}
+
+// InvalidateIntranetEmployeeHandler returns a handler that forgets the cached intranet lookups of an employee.
+func InvalidateIntranetEmployeeHandler(ctx context.Context, cacheSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		employeeID, ok := mux.Vars(r)["employee_id"]
+		if !ok || employeeID == "" {
+			middleware.ErrorResponse(w, http.StatusBadRequest, errors.ErrInvalidRequestData)
+			zap.S().Error("employee_id missing from request vars")
+			return
+		}
+
+		cacheSvc.InvalidateIntranetCache(r.Context(), employeeID)
+
+		middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
+			Message: "Intranet cache invalidated for the employee",
+		})
+	}
+}
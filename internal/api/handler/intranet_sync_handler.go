@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// ListProfileSyncChangesHandler returns a handler that lists the changes the intranet sync made to a profile.
+func ListProfileSyncChangesHandler(ctx context.Context, syncSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		profileID, err := helpers.GetParamsByID(r, constants.ProfileID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		resp, err := syncSvc.ListProfileSyncChanges(r.Context(), profileID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list profile sync changes : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// ListSyncRunsHandler returns a handler that lists the latest intranet sync runs.
+func ListSyncRunsHandler(ctx context.Context, syncSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, err := decodeListSyncRunsRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := syncSvc.ListSyncRuns(r.Context(), limit)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list sync runs : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// GetSyncRunHandler returns a handler that returns an intranet sync run.
+func GetSyncRunHandler(ctx context.Context, syncSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		runID, err := helpers.GetParamsByID(r, constants.SyncRunID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		resp, err := syncSvc.GetSyncRun(r.Context(), int64(runID))
+		if err != nil {
+			if err == errors.ErrSyncRunNotFound {
+				middleware.ErrorResponse(w, http.StatusNotFound, err)
+				return
+			}
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to get sync run : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// StartSyncRunHandler returns a handler that starts an intranet sync run in the background.
+func StartSyncRunHandler(ctx context.Context, syncSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		req, err := decodeStartSyncRunRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		opts := specs.SyncEmployeesOptions{Incremental: req.Incremental, DeactivateLeavers: req.DeactivateLeavers}
+		resp, err := syncSvc.StartEmployeeSync(r.Context(), constants.SyncTriggerManual, userID, opts)
+		if err != nil {
+			if err == errors.ErrSyncInProgress {
+				middleware.ErrorResponse(w, http.StatusConflict, err)
+				return
+			}
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToCreate)
+			zap.S().Error("Unable to start sync run : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusAccepted, resp)
+	}
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// IntranetWebhookHandler returns a handler that accepts an employee lifecycle event pushed by the intranet. The
+// event is stored and processed in the background, so the intranet is answered with 202 straight away, or with
+// 200 for an event it already delivered.
+func IntranetWebhookHandler(ctx context.Context, webhookSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		event, payload, err := decodeIntranetWebhookEvent(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = event.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := webhookSvc.ReceiveIntranetWebhook(r.Context(), event, payload)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusInternalServerError, errors.ErrFailedToCreate)
+			zap.S().Error("Unable to receive intranet event : ", err)
+			return
+		}
+
+		if resp.Duplicate {
+			middleware.SuccessResponse(w, http.StatusOK, resp)
+			return
+		}
+		middleware.SuccessResponse(w, http.StatusAccepted, resp)
+	}
+}
+
+// ListIntranetWebhookEventsHandler returns a handler that lists the latest intranet events, e.g. the dead ones.
+func ListIntranetWebhookEventsHandler(ctx context.Context, webhookSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := decodeListIntranetWebhookEventsRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := webhookSvc.ListIntranetWebhookEvents(r.Context(), filter)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list intranet events : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// GetIntranetWebhookEventHandler returns a handler that returns a stored intranet event.
+func GetIntranetWebhookEventHandler(ctx context.Context, webhookSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := helpers.GetParamsByID(r, constants.WebhookEventID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		resp, err := webhookSvc.GetIntranetWebhookEvent(r.Context(), int64(id))
+		if err != nil {
+			if err == errors.ErrWebhookEventNotFound {
+				middleware.ErrorResponse(w, http.StatusNotFound, err)
+				return
+			}
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to get intranet event : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// RetryIntranetWebhookEventHandler returns a handler that retries a failed or dead intranet event in the background.
+func RetryIntranetWebhookEventHandler(ctx context.Context, webhookSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := helpers.GetParamsByID(r, constants.WebhookEventID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		resp, err := webhookSvc.RetryIntranetWebhookEvent(r.Context(), int64(id))
+		if err != nil {
+			switch err {
+			case errors.ErrWebhookEventNotFound:
+				middleware.ErrorResponse(w, http.StatusNotFound, err)
+			case errors.ErrWebhookEventNotRetryable:
+				middleware.ErrorResponse(w, http.StatusConflict, err)
+			default:
+				middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToUpdateRecord)
+				zap.S().Error("Unable to retry intranet event : ", err)
+			}
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusAccepted, resp)
+	}
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// ListInvitationsHandler returns a handler that lists the employee invitations, filtered by status, inviter and when
+// they were sent, and narrowed to the profiles within the scope of profiles:invite.
+func ListInvitationsHandler(ctx context.Context, invitationSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := decodeListInvitationsRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		switch scope, _ := r.Context().Value(constants.PermissionScope).(string); scope {
+		case constants.ScopeReportees:
+			userID, err := helpers.GetUserIDFromContext(r)
+			if err != nil {
+				middleware.ErrorResponse(w, http.StatusBadRequest, err)
+				zap.S().Error(err)
+				return
+			}
+			filter.ManagerID = userID
+		case constants.ScopeOwn:
+			ownProfileID, _ := r.Context().Value(constants.ProfileIDKey).(int)
+			if ownProfileID <= 0 {
+				middleware.SuccessResponse(w, http.StatusOK, specs.ListInvitationsResponse{Invitations: []specs.Invitation{}, Page: filter.Page, Limit: filter.Limit})
+				return
+			}
+			filter.ProfileID = ownProfileID
+		}
+
+		resp, err := invitationSvc.ListInvitations(r.Context(), filter)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list invitations : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// ListProfileInvitationsHandler returns a handler that lists every invitation sent for a profile.
+func ListProfileInvitationsHandler(ctx context.Context, invitationSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		profileID, err := helpers.GetProfileID(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := invitationSvc.ListProfileInvitations(r.Context(), profileID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list invitations : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// ResendInvitationHandler returns a handler that emails the invitation of a profile again with a new expiry.
+func ResendInvitationHandler(ctx context.Context, invitationSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		profileID, err := helpers.GetProfileID(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = invitationSvc.ResendInvitation(r.Context(), userID, profileID)
+		if err != nil {
+			if err == errors.ErrInvitationNotFound {
+				middleware.ErrorResponse(w, http.StatusNotFound, err)
+				return
+			}
+			zap.S().Errorf("Error resending invitation: %v", err)
+			middleware.ErrorResponse(w, http.StatusInternalServerError, errors.ErrUnableToSendEmail)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
+			Message: "Invitation resent successfully to employee",
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	jwttoken "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/jwt_token"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"go.uber.org/zap"
+)
+
+// JWKSHandler publishes the public keys used to verify our tokens so other services can validate them.
+// The key set is written as a bare JWKS document, not wrapped in the usual data envelope, since JWKS
+// consumers expect the standard format.
+func JWKSHandler(ctx context.Context, profileSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jwks, err := jwttoken.JWKS()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusInternalServerError, err)
+			zap.S().Error("Unable to build JWKS : ", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(jwks); err != nil {
+			zap.S().Error("Unable to write JWKS response : ", err)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// ListNotificationsHandler returns a handler that lists the notifications of the logged in user, newest first.
+func ListNotificationsHandler(ctx context.Context, notificationSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		filter, err := decodeListNotificationsRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := notificationSvc.ListNotifications(r.Context(), userID, filter)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list notifications : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// MarkNotificationReadHandler returns a handler that marks a notification of the logged in user read.
+func MarkNotificationReadHandler(ctx context.Context, notificationSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		id, err := helpers.GetParamsByID(r, constants.NotificationID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		resp, err := notificationSvc.MarkNotificationRead(r.Context(), userID, int64(id))
+		if err != nil {
+			if err == errors.ErrNotificationNotFound {
+				middleware.ErrorResponse(w, http.StatusNotFound, err)
+				return
+			}
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToUpdateRecord)
+			zap.S().Error("Unable to mark notification read : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// MarkAllNotificationsReadHandler returns a handler that marks every notification of the logged in user read.
+func MarkAllNotificationsReadHandler(ctx context.Context, notificationSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := notificationSvc.MarkAllNotificationsRead(r.Context(), userID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToUpdateRecord)
+			zap.S().Error("Unable to mark notifications read : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// StreamNotificationsHandler returns a handler that streams the notifications of the logged in user as server-sent
+// events until the client goes away or ctx, the server's context, is cancelled as it shuts down. The unread count is
+// sent first and again every heartbeat.
+func StreamNotificationsHandler(ctx context.Context, notificationSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			middleware.ErrorResponse(w, http.StatusInternalServerError, errors.ErrStreamingUnsupported)
+			zap.S().Error(errors.ErrStreamingUnsupported)
+			return
+		}
+
+		userID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		events, unsubscribe := notificationSvc.SubscribeNotifications(userID)
+		defer unsubscribe()
+
+		unread, err := notificationSvc.CountUnreadNotifications(r.Context(), userID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to count unread notifications : ", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		if err := writeNotificationEvent(w, flusher, constants.NotificationStreamEventUnread, specs.NotificationEvent{UnreadCount: unread}); err != nil {
+			zap.S().Error("Unable to write notification event : ", err)
+			return
+		}
+
+		heartbeat := time.NewTicker(constants.NotificationStreamHeartbeat)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-ctx.Done():
+				return
+			case event := <-events:
+				name := constants.NotificationStreamEventUnread
+				if event.Notification != nil {
+					name = constants.NotificationStreamEventNotification
+				}
+				err = writeNotificationEvent(w, flusher, name, event)
+			case <-heartbeat.C:
+				unread, err = notificationSvc.CountUnreadNotifications(r.Context(), userID)
+				if err != nil {
+					zap.S().Error("Unable to count unread notifications : ", err)
+					continue
+				}
+				err = writeNotificationEvent(w, flusher, constants.NotificationStreamEventUnread, specs.NotificationEvent{UnreadCount: unread})
+			}
+			if err != nil {
+				zap.S().Error("Unable to write notification event : ", err)
+				return
+			}
+		}
+	}
+}
+
+// writeNotificationEvent writes an event to a notification stream and flushes it to the client
+func writeNotificationEvent(w http.ResponseWriter, flusher http.Flusher, name string, event specs.NotificationEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if _, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data); err != nil {
+		return err
+	}
+	flusher.Flush()
+	return nil
+}
@@ -94,7 +94,26 @@ func CreateFullProfileHandler(ctx context.Context, profileSvc service.Service) f
 // ProfileListHandler returns an HTTP handler that lists profiles using profileSvc.
 func ProfileListHandler(ctx context.Context, profileSvc service.Service) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		profResponse, err := profileSvc.ListProfiles(ctx)
+		var filter specs.ListProfilesFilter
+		switch scope, _ := r.Context().Value(constants.PermissionScope).(string); scope {
+		case constants.ScopeReportees:
+			userID, err := helpers.GetUserIDFromContext(r)
+			if err != nil {
+				middleware.ErrorResponse(w, http.StatusBadRequest, err)
+				zap.S().Error(err)
+				return
+			}
+			filter.ManagerID = userID
+		case constants.ScopeOwn:
+			ownProfileID, _ := r.Context().Value(constants.ProfileIDKey).(int)
+			if ownProfileID <= 0 {
+				middleware.SuccessResponse(w, http.StatusOK, specs.ListProfilesResponse{Profiles: []specs.ResponseListProfiles{}})
+				return
+			}
+			filter.ProfileID = ownProfileID
+		}
+
+		profResponse, err := profileSvc.ListProfiles(r.Context(), filter)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
 			zap.S().Error("Unable to list profiles : ", err)
@@ -110,7 +129,7 @@ func ProfileListHandler(ctx context.Context, profileSvc service.Service) func(ht
 // SkillsListHandler returns an HTTP handler that lists skills using profileSvc.
 func SkillsListHandler(ctx context.Context, profileSvc service.Service) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		skillsResp, err := profileSvc.ListSkills(ctx)
+		skillsResp, err := profileSvc.ListSkills(r.Context())
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error("Unable to list skills : ", err)
@@ -131,7 +150,7 @@ func GetProfileHandler(ctx context.Context, profileSvc service.Service) func(htt
 			return
 		}
 
-		profResp, err := profileSvc.GetProfile(ctx, profileID)
+		profResp, err := profileSvc.GetProfile(r.Context(), profileID)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error("Unable to get profile : ", err, "for profile id : ", profileID)
@@ -204,7 +223,7 @@ func DeleteProfileHandler(ctx context.Context, profileSvc service.Service) func(
 			return
 		}
 
-		err = profileSvc.DeleteProfile(ctx, profileID)
+		err = profileSvc.DeleteProfile(r.Context(), profileID)
 		if err != nil {
 			if err == errors.ErrNoData {
 				middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
@@ -248,7 +267,16 @@ func UpdateSequenceHandler(ctx context.Context, profileSvc service.Service) func
 			return
 		}
 
-		updatedResp, err := profileSvc.UpdateSequence(ctx, userID, req)
+		// the profile comes from the body, so the permission middleware cannot check its scope
+		scope, _ := r.Context().Value(constants.PermissionScope).(string)
+		err = middleware.CheckProfileScope(r.Context(), profileSvc, scope, req.ProfileID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusForbidden, errors.ErrPermissionDenied)
+			zap.S().Error("Unable to update sequence : ", err, "for profile id : ", req.ProfileID)
+			return
+		}
+
+		updatedResp, err := profileSvc.UpdateSequence(r.Context(), userID, req)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToUpdateRecord)
 			zap.S().Error("Unable to update sequence : ", err, "for profile id : ", updatedResp)
@@ -285,7 +313,7 @@ func UpdateProfileStatusHandler(ctx context.Context, profileSvc service.Service)
 			return
 		}
 
-		err = profileSvc.UpdateProfileStatus(ctx, profileID, req)
+		err = profileSvc.UpdateProfileStatus(r.Context(), profileID, req)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToUpdateStatus)
 			zap.S().Error("error while updating the profile status: ", err)
@@ -309,7 +337,7 @@ func ResolveEmployeeHandler(ctx context.Context, profileSvc service.Service) fun
 			return
 		}
 
-		profileID, err := profileSvc.ResolveEmployeeID(ctx, employeeID)
+		profileID, err := profileSvc.ResolveEmployeeID(r.Context(), employeeID)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusNotFound, errors.ErrNoRecordFound)
 			zap.S().Error("employee not found or resolution failed: ", err)
@@ -334,12 +362,14 @@ func GetIntranetEmployeeHandler(ctx context.Context, profileSvc service.Service)
 			return
 		}
 
-		response, err := profileSvc.GetIntranetEmployee(ctx, employeeID)
+		response, err := profileSvc.GetIntranetEmployee(r.Context(), employeeID)
 		if err != nil {
 			if err == errors.ErrNoRecordFound {
 				middleware.ErrorResponse(w, http.StatusNotFound, errors.ErrNoRecordFound)
 			} else if _, ok := err.(errors.ProfileExistsError); ok {
 				middleware.ErrorResponse(w, http.StatusConflict, err)
+			} else if errors.IsIntranetUnavailable(err) {
+				middleware.ErrorResponse(w, http.StatusServiceUnavailable, errors.ErrIntranetUnavailable)
 			} else {
 				middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
 			}
@@ -44,7 +44,7 @@ func CreateProjectHandler(ctx context.Context, projectSvc service.Service) func(
 			return
 		}
 
-		profileID, err = projectSvc.CreateProject(ctx, req, profileID, userID)
+		profileID, err = projectSvc.CreateProject(r.Context(), req, profileID, userID)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error(err)
@@ -74,7 +74,7 @@ func ListProjectHandler(ctx context.Context, projSvc service.Service) func(http.
 			return
 		}
 
-		projectsResp, err := projSvc.ListProjects(ctx, profileID, filter)
+		projectsResp, err := projSvc.ListProjects(r.Context(), profileID, filter)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailespecsFetch)
 			zap.S().Error("Unable to fetch projects : ", err, "for profile id : ", profileID)
@@ -122,7 +122,7 @@ func UpdateProjectHandler(ctx context.Context, projSvc service.Service) func(htt
 			return
 		}
 
-		updatedResp, err := projSvc.UpdateProject(ctx, profileID, projID, userID, req)
+		updatedResp, err := projSvc.UpdateProject(r.Context(), profileID, projID, userID, req)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadGateway, err)
 			zap.S().Error("Unable to update project : ", err, " for profile id : ", profileID, "project id : ", projID)
@@ -146,7 +146,7 @@ func DeleteProjectHandler(ctx context.Context, projSvc service.Service) func(htt
 			return
 		}
 
-		err = projSvc.DeleteProject(ctx, profileID, projectID)
+		err = projSvc.DeleteProject(r.Context(), profileID, projectID)
 		if err != nil {
 			if err == errors.ErrNoData {
 				middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
@@ -164,3 +164,80 @@ func DeleteProjectHandler(ctx context.Context, projSvc service.Service) func(htt
 		})
 	}
 }
+
+// ListIntranetProjectsHandler returns an HTTP handler that proposes intranet projects for an existing profile.
+func ListIntranetProjectsHandler(ctx context.Context, projSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		profileID, err := helpers.GetParamsByID(r, constants.ProfileID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := projSvc.ListIntranetProjectProposals(r.Context(), profileID)
+		if err != nil {
+			writeIntranetProjectsError(w, err)
+			zap.S().Error("Unable to propose intranet projects : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// ApplyIntranetProjectsHandler returns an HTTP handler that applies the decisions taken on proposed intranet projects.
+func ApplyIntranetProjectsHandler(ctx context.Context, projSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		profileID, err := helpers.GetParamsByID(r, constants.ProfileID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		userID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		req, err := decodeApplyIntranetProjectsRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = req.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := projSvc.ApplyIntranetProjectProposals(r.Context(), profileID, userID, req)
+		if err != nil {
+			writeIntranetProjectsError(w, err)
+			zap.S().Error("Unable to apply intranet projects : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// writeIntranetProjectsError maps an error from proposing or applying intranet projects to its HTTP response
+func writeIntranetProjectsError(w http.ResponseWriter, err error) {
+	switch {
+	case err == errors.ErrNoRecordFound:
+		middleware.ErrorResponse(w, http.StatusNotFound, err)
+	case err == errors.ErrNoEmployeeID, err == errors.ErrProjectNotProposed:
+		middleware.ErrorResponse(w, http.StatusConflict, err)
+	case errors.IsIntranetUnavailable(err):
+		middleware.ErrorResponse(w, http.StatusServiceUnavailable, errors.ErrIntranetUnavailable)
+	default:
+		middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+	}
+}
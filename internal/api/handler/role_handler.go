@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// ListRolesHandler returns an HTTP handler that lists roles and their permissions using roleSvc.
+func ListRolesHandler(ctx context.Context, roleSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := roleSvc.ListRoles(r.Context())
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list roles : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// ListPermissionsHandler returns an HTTP handler that lists the permissions that can be granted to a role.
+func ListPermissionsHandler(ctx context.Context, roleSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		middleware.SuccessResponse(w, http.StatusOK, roleSvc.ListPermissions(r.Context()))
+	}
+}
+
+// CreateRoleHandler returns an HTTP handler that creates a role using roleSvc.
+func CreateRoleHandler(ctx context.Context, roleSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := decodeCreateRoleRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = req.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		roleID, err := roleSvc.CreateRole(r.Context(), req)
+		if err != nil {
+			if err == errors.ErrDuplicateKey {
+				middleware.ErrorResponse(w, http.StatusConflict, err)
+				return
+			}
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToCreate)
+			zap.S().Error("Unable to create role : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusCreated, specs.RoleMessageResponse{
+			Message: "Role created successfully",
+			RoleID:  roleID,
+		})
+	}
+}
+
+// UpdateRoleHandler returns an HTTP handler that replaces the permissions of a role using roleSvc.
+func UpdateRoleHandler(ctx context.Context, roleSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		roleID, err := helpers.GetParamsByID(r, constants.RoleID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		req, err := decodeUpdateRoleRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = req.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = roleSvc.UpdateRole(r.Context(), roleID, req)
+		if err != nil {
+			writeRoleError(w, err, errors.ErrFailedToUpdateRecord)
+			zap.S().Error("Unable to update role : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, specs.RoleMessageResponse{
+			Message: "Role updated successfully",
+			RoleID:  roleID,
+		})
+	}
+}
+
+// DeleteRoleHandler returns an HTTP handler that deletes a role using roleSvc.
+func DeleteRoleHandler(ctx context.Context, roleSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		roleID, err := helpers.GetParamsByID(r, constants.RoleID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		err = roleSvc.DeleteRole(r.Context(), roleID)
+		if err != nil {
+			writeRoleError(w, err, errors.ErrFailedToDelete)
+			zap.S().Error("Unable to delete role : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
+			Message: "Role deleted successfully",
+		})
+	}
+}
+
+// AssignUserRoleHandler returns an HTTP handler that changes the role of a user using roleSvc.
+func AssignUserRoleHandler(ctx context.Context, roleSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		actorID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		userID, err := helpers.GetParamsByID(r, constants.UserID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		req, err := decodeAssignRoleRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = req.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = roleSvc.AssignUserRole(r.Context(), actorID, userID, req)
+		if err != nil {
+			writeRoleError(w, err, errors.ErrFailedToUpdateRecord)
+			zap.S().Error("Unable to assign role : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
+			Message: "Role assigned successfully",
+		})
+	}
+}
+
+// AssignProfileManagerHandler returns an HTTP handler that sets the reporting manager of a profile using roleSvc.
+func AssignProfileManagerHandler(ctx context.Context, roleSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		profileID, err := helpers.GetParamsByID(r, constants.ProfileID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		req, err := decodeAssignManagerRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = req.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = roleSvc.AssignProfileManager(r.Context(), profileID, req)
+		if err != nil {
+			writeRoleError(w, err, errors.ErrFailedToUpdateRecord)
+			zap.S().Error("Unable to assign manager : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponseWithID{
+			Message:   "Manager assigned successfully",
+			ProfileID: profileID,
+		})
+	}
+}
+
+// writeRoleError maps role management errors to their HTTP status, falling back to fallbackErr.
+func writeRoleError(w http.ResponseWriter, err error, fallbackErr error) {
+	switch err {
+	case errors.ErrRoleNotFound, errors.ErrNoRecordFound, errors.ErrUserNotFound:
+		middleware.ErrorResponse(w, http.StatusNotFound, err)
+	case errors.ErrSystemRole, errors.ErrRoleInUse, errors.ErrLastAdmin, errors.ErrSelfManagement:
+		middleware.ErrorResponse(w, http.StatusConflict, err)
+	case errors.ErrInvalidUserID:
+		middleware.ErrorResponse(w, http.StatusBadRequest, err)
+	default:
+		middleware.ErrorResponse(w, http.StatusBadGateway, fallbackErr)
+	}
+}
@@ -40,7 +40,7 @@ func InviteAdmin(ctx context.Context, userService service.Service) func(http.Res
 			return
 		}
 
-		err = userService.InviteAdmin(ctx, userID, req)
+		err = userService.InviteAdmin(r.Context(), userID, req)
 		if err != nil {
 			if err == errors.ErrDuplicateKey {
 				middleware.ErrorResponse(w, http.StatusConflict, err)
@@ -29,7 +29,7 @@ func SendUserInvitation(ctx context.Context, userService service.Service) func(h
 			return
 		}
 
-		err = userService.SendUserInvitation(ctx, userID, profileID)
+		err = userService.SendUserInvitation(r.Context(), userID, profileID)
 		if err != nil {
 			zap.S().Errorf("Error sending invitation: ", err)
 			middleware.ErrorResponse(w, http.StatusInternalServerError, errors.ErrUnableToSendEmail)
@@ -59,7 +59,7 @@ func SendAdminInvitation(ctx context.Context, userService service.Service) func(
 			return
 		}
 
-		err = userService.UpdateInvitation(ctx, userID, profileID)
+		err = userService.UpdateInvitation(r.Context(), userID, profileID)
 		if err != nil {
 			zap.S().Errorf("Error sending invitation: %v", err)
 			middleware.ErrorResponse(w, http.StatusInternalServerError, errors.ErrUnableToSendEmail)
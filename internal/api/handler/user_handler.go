@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// ListUsersHandler returns an HTTP handler that lists users using userSvc.
+func ListUsersHandler(ctx context.Context, userSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter, err := helpers.DecodeUsersRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		resp, err := userSvc.ListUsers(r.Context(), filter)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadGateway, errors.ErrFailedToGet)
+			zap.S().Error("Unable to list users : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// GetUserHandler returns an HTTP handler that fetches a user using userSvc.
+func GetUserHandler(ctx context.Context, userSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := helpers.GetParamsByID(r, constants.UserID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		resp, err := userSvc.GetUser(r.Context(), userID)
+		if err != nil {
+			writeRoleError(w, err, errors.ErrFailedToGet)
+			zap.S().Error("Unable to get user : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, resp)
+	}
+}
+
+// CreateUserHandler returns an HTTP handler that adds a user using userSvc.
+func CreateUserHandler(ctx context.Context, userSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := decodeCreateUserRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = req.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		userID, err := userSvc.CreateUser(r.Context(), req)
+		if err != nil {
+			if err == errors.ErrDuplicateKey {
+				middleware.ErrorResponse(w, http.StatusConflict, err)
+				return
+			}
+			writeRoleError(w, err, errors.ErrFailedToCreate)
+			zap.S().Error("Unable to create user : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusCreated, specs.UserMessageResponse{
+			Message: "User created successfully",
+			UserID:  userID,
+		})
+	}
+}
+
+// UpdateUserHandler returns an HTTP handler that updates the name and role of a user using userSvc.
+func UpdateUserHandler(ctx context.Context, userSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		actorID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		userID, err := helpers.GetParamsByID(r, constants.UserID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		req, err := decodeUpdateUserRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = req.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = userSvc.UpdateUser(r.Context(), actorID, userID, req)
+		if err != nil {
+			writeRoleError(w, err, errors.ErrFailedToUpdateRecord)
+			zap.S().Error("Unable to update user : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, specs.UserMessageResponse{
+			Message: "User updated successfully",
+			UserID:  userID,
+		})
+	}
+}
+
+// UpdateUserStatusHandler returns an HTTP handler that activates or deactivates a user using userSvc.
+func UpdateUserStatusHandler(ctx context.Context, userSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		actorID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		userID, err := helpers.GetParamsByID(r, constants.UserID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		req, err := decodeUpdateUserStatusRequest(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = req.Validate()
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		err = userSvc.UpdateUserStatus(r.Context(), actorID, userID, req)
+		if err != nil {
+			writeRoleError(w, err, errors.ErrFailedToUpdateRecord)
+			zap.S().Error("Unable to update user status : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, specs.UserMessageResponse{
+			Message: "User status updated successfully",
+			UserID:  userID,
+		})
+	}
+}
+
+// DeleteUserHandler returns an HTTP handler that deletes a user using userSvc.
+func DeleteUserHandler(ctx context.Context, userSvc service.Service) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		actorID, err := helpers.GetUserIDFromContext(r)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error(err)
+			return
+		}
+
+		userID, err := helpers.GetParamsByID(r, constants.UserID)
+		if err != nil {
+			middleware.ErrorResponse(w, http.StatusBadRequest, err)
+			zap.S().Error("error while getting the IDs from request : ", err)
+			return
+		}
+
+		err = userSvc.DeleteUser(r.Context(), actorID, userID)
+		if err != nil {
+			writeRoleError(w, err, errors.ErrFailedToDelete)
+			zap.S().Error("Unable to delete user : ", err)
+			return
+		}
+
+		middleware.SuccessResponse(w, http.StatusOK, specs.MessageResponse{
+			Message: "User deleted successfully",
+		})
+	}
+}
@@ -37,7 +37,7 @@ func Login(ctx context.Context, profileSvc service.Service) func(http.ResponseWr
 			"Content-Type": "application/json",
 		}
 
-		body, err := helpers.SendRequest(ctx, http.MethodGet, os.Getenv("GOOGLE_USER_INFO_URL"), req.AccessToken, nil, headers)
+		body, err := helpers.SendRequest(r.Context(), http.MethodGet, os.Getenv("GOOGLE_USER_INFO_URL"), req.AccessToken, nil, headers)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadRequest, err)
 			zap.S().Error(errors.ErrGoogleRequest, ": ", err)
@@ -56,13 +56,23 @@ func Login(ctx context.Context, profileSvc service.Service) func(http.ResponseWr
 			return
 		}
 
-		info, err := profileSvc.GenerateLoginToken(ctx, userInfo)
+		info, err := profileSvc.GenerateLoginToken(r.Context(), userInfo)
 		if err != nil {
 			if err == errors.ErrNoRecordFound {
 				middleware.ErrorResponse(w, http.StatusUnauthorized, errors.ErrAuthToken)
 				zap.S().Info("User not found")
 				return
 			}
+			if err == errors.ErrUserInactive {
+				middleware.ErrorResponse(w, http.StatusForbidden, err)
+				zap.S().Info("Deactivated user tried to login")
+				return
+			}
+			if err == errors.ErrInvitationExpired {
+				middleware.ErrorResponse(w, http.StatusForbidden, err)
+				zap.S().Info("Employee with an expired invitation tried to login")
+				return
+			}
 			middleware.ErrorResponse(w, http.StatusInternalServerError, err)
 			zap.S().Error(errors.ErrGenerateToken, " : ", err)
 			return
@@ -96,7 +106,7 @@ func Logout(ctx context.Context, profileSvc service.Service) func(http.ResponseW
 
 		token := strings.TrimPrefix(authHeader, "Bearer ")
 
-		err := profileSvc.RemoveToken(token)
+		err := profileSvc.RemoveToken(r.Context(), token)
 		if err != nil {
 			middleware.ErrorResponse(w, http.StatusBadRequest, err)
 			zap.S().Error(errors.ErrTokenNotFound, " : ", err)
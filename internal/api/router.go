@@ -14,67 +14,128 @@ import (
 // NewRouter returns a object that contains all routes of application
 func NewRouter(ctx context.Context, svc service.Service) *mux.Router {
 	router := mux.NewRouter()
+	router.Use(middleware.RequestIDMiddleware)
 
 	// user login router
 	router.HandleFunc("/login", handler.Login(ctx, svc)).Methods(http.MethodPost)
 
+	// Public keys used to verify our tokens
+	router.HandleFunc("/.well-known/jwks.json", handler.JWKSHandler(ctx, svc)).Methods(http.MethodGet)
+
 	profileSubrouter := router.PathPrefix("/api").Subrouter()
 	profileSubrouter.Use(middleware.AuthMiddleware)
 
-	// Internal server-to-server subrouter — protected by API key only (no JWT).
+	// Internal server-to-server subrouter — protected by API key only (no JWT). Each route requires its own scope.
 	internalSubrouter := router.PathPrefix("/api/internal").Subrouter()
-	internalSubrouter.Use(middleware.APIKeyMiddleware)
-	internalSubrouter.Handle("/profiles/resolve/{employee_id}", http.HandlerFunc(handler.ResolveEmployeeHandler(ctx, svc))).Methods(http.MethodGet)
+	internalSubrouter.Handle("/profiles/resolve/{employee_id}", middleware.APIKeyMiddleware(svc, constants.APIScopeProfilesResolve)(http.HandlerFunc(handler.ResolveEmployeeHandler(ctx, svc)))).Methods(http.MethodGet)
+	internalSubrouter.Handle("/v1/profiles/resolve", middleware.APIKeyMiddleware(svc, constants.APIScopeProfilesResolve)(http.HandlerFunc(handler.ResolveEmployeesHandler(ctx, svc)))).Methods(http.MethodPost)
+	internalSubrouter.Handle("/v1/profiles/changes", middleware.APIKeyMiddleware(svc, constants.APIScopeProfilesRead)(http.HandlerFunc(handler.ListProfileChangesHandler(ctx, svc)))).Methods(http.MethodGet)
+	internalSubrouter.Handle("/v1/profiles/{employee_id}", middleware.APIKeyMiddleware(svc, constants.APIScopeProfilesRead)(http.HandlerFunc(handler.GetInternalProfileHandler(ctx, svc)))).Methods(http.MethodGet)
+	internalSubrouter.Handle("/webhooks/intranet", middleware.IntranetSignatureMiddleware(http.HandlerFunc(handler.IntranetWebhookHandler(ctx, svc)))).Methods(http.MethodPost)
 
 	// Profile APIs
-	profileSubrouter.Handle("/profiles", middleware.RoleMiddleware([]string{constants.Admin})(http.HandlerFunc(handler.CreateProfileHandler(ctx, svc)))).Methods(http.MethodPost)
-	profileSubrouter.Handle("/profiles/full", middleware.RoleMiddleware([]string{constants.Admin})(http.HandlerFunc(handler.CreateFullProfileHandler(ctx, svc)))).Methods(http.MethodPost)
-	profileSubrouter.Handle("/profiles/{profile_id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.UpdateProfileHandler(ctx, svc)))).Methods(http.MethodPut)
-	profileSubrouter.Handle("/profiles", middleware.RoleMiddleware([]string{constants.Admin})(http.HandlerFunc(handler.ProfileListHandler(ctx, svc)))).Methods(http.MethodGet)
-	profileSubrouter.Handle("/profiles/{profile_id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.GetProfileHandler(ctx, svc)))).Methods(http.MethodGet)
-	profileSubrouter.Handle("/skills", middleware.RoleMiddleware([]string{constants.Admin})(http.HandlerFunc(handler.SkillsListHandler(ctx, svc)))).Methods(http.MethodGet)
-	profileSubrouter.Handle("/profiles/{profile_id}", middleware.RoleMiddleware([]string{constants.Admin})(http.HandlerFunc(handler.DeleteProfileHandler(ctx, svc)))).Methods(http.MethodDelete)
-	profileSubrouter.Handle("/updateSequence", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.UpdateSequenceHandler(ctx, svc)))).Methods(http.MethodPut)
-	profileSubrouter.Handle("/profiles/{profile_id}", middleware.RoleMiddleware([]string{constants.Admin})(http.HandlerFunc(handler.UpdateProfileStatusHandler(ctx, svc)))).Methods(http.MethodPatch)
-	profileSubrouter.Handle("/intranet/employees/{employee_id}", middleware.RoleMiddleware([]string{constants.Admin})(http.HandlerFunc(handler.GetIntranetEmployeeHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/profiles", middleware.PermissionMiddleware(svc, constants.PermProfilesCreate)(http.HandlerFunc(handler.CreateProfileHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/profiles/full", middleware.PermissionMiddleware(svc, constants.PermProfilesCreate)(http.HandlerFunc(handler.CreateFullProfileHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/profiles/{profile_id}", middleware.PermissionMiddleware(svc, constants.PermProfilesUpdate)(http.HandlerFunc(handler.UpdateProfileHandler(ctx, svc)))).Methods(http.MethodPut)
+	profileSubrouter.Handle("/profiles", middleware.PermissionMiddleware(svc, constants.PermProfilesList)(http.HandlerFunc(handler.ProfileListHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/profiles/{profile_id}", middleware.PermissionMiddleware(svc, constants.PermProfilesRead)(http.HandlerFunc(handler.GetProfileHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/skills", middleware.PermissionMiddleware(svc, constants.PermSkillsRead)(http.HandlerFunc(handler.SkillsListHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/profiles/{profile_id}", middleware.PermissionMiddleware(svc, constants.PermProfilesDelete)(http.HandlerFunc(handler.DeleteProfileHandler(ctx, svc)))).Methods(http.MethodDelete)
+	profileSubrouter.Handle("/updateSequence", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.UpdateSequenceHandler(ctx, svc)))).Methods(http.MethodPut)
+	profileSubrouter.Handle("/profiles/{profile_id}", middleware.PermissionMiddleware(svc, constants.PermProfilesStatus)(http.HandlerFunc(handler.UpdateProfileStatusHandler(ctx, svc)))).Methods(http.MethodPatch)
+	profileSubrouter.Handle("/intranet/employees/{employee_id}", middleware.PermissionMiddleware(svc, constants.PermIntranetRead)(http.HandlerFunc(handler.GetIntranetEmployeeHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/intranet/employees/{employee_id}/cache", middleware.PermissionMiddleware(svc, constants.PermIntranetRead)(http.HandlerFunc(handler.InvalidateIntranetEmployeeHandler(ctx, svc)))).Methods(http.MethodDelete)
+	profileSubrouter.Handle("/intranet/cache", middleware.PermissionMiddleware(svc, constants.PermIntranetSync)(http.HandlerFunc(handler.GetIntranetCacheStatsHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/intranet/cache", middleware.PermissionMiddleware(svc, constants.PermIntranetSync)(http.HandlerFunc(handler.ClearIntranetCacheHandler(ctx, svc)))).Methods(http.MethodDelete)
+	profileSubrouter.Handle("/profiles/{profile_id}/sync_changes", middleware.PermissionMiddleware(svc, constants.PermProfilesRead)(http.HandlerFunc(handler.ListProfileSyncChangesHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/intranet/sync_runs", middleware.PermissionMiddleware(svc, constants.PermIntranetSync)(http.HandlerFunc(handler.ListSyncRunsHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/intranet/sync_runs", middleware.PermissionMiddleware(svc, constants.PermIntranetSync)(http.HandlerFunc(handler.StartSyncRunHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/intranet/sync_runs/{sync_run_id}", middleware.PermissionMiddleware(svc, constants.PermIntranetSync)(http.HandlerFunc(handler.GetSyncRunHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/intranet/webhook_events", middleware.PermissionMiddleware(svc, constants.PermIntranetSync)(http.HandlerFunc(handler.ListIntranetWebhookEventsHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/intranet/webhook_events/{webhook_event_id}", middleware.PermissionMiddleware(svc, constants.PermIntranetSync)(http.HandlerFunc(handler.GetIntranetWebhookEventHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/intranet/webhook_events/{webhook_event_id}/retry", middleware.PermissionMiddleware(svc, constants.PermIntranetSync)(http.HandlerFunc(handler.RetryIntranetWebhookEventHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/emails", middleware.PermissionMiddleware(svc, constants.PermEmailsManage)(http.HandlerFunc(handler.ListOutboxEmailsHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/emails/{email_id}", middleware.PermissionMiddleware(svc, constants.PermEmailsManage)(http.HandlerFunc(handler.GetOutboxEmailHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/emails/{email_id}/resend", middleware.PermissionMiddleware(svc, constants.PermEmailsManage)(http.HandlerFunc(handler.ResendOutboxEmailHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/email_templates", middleware.PermissionMiddleware(svc, constants.PermEmailTemplatesManage)(http.HandlerFunc(handler.ListEmailTemplatesHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/email_templates/{kind}", middleware.PermissionMiddleware(svc, constants.PermEmailTemplatesManage)(http.HandlerFunc(handler.GetEmailTemplateHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/email_templates/{kind}", middleware.PermissionMiddleware(svc, constants.PermEmailTemplatesManage)(http.HandlerFunc(handler.UpdateEmailTemplateHandler(ctx, svc)))).Methods(http.MethodPut)
+	profileSubrouter.Handle("/email_templates/{kind}/versions", middleware.PermissionMiddleware(svc, constants.PermEmailTemplatesManage)(http.HandlerFunc(handler.ListEmailTemplateVersionsHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/email_templates/{kind}/preview", middleware.PermissionMiddleware(svc, constants.PermEmailTemplatesManage)(http.HandlerFunc(handler.PreviewEmailTemplateHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/backups", middleware.PermissionMiddleware(svc, constants.PermBackupsRead)(http.HandlerFunc(handler.ListBackupsHandler(ctx, svc)))).Methods(http.MethodGet)
 
 	// Educations APIs
-	profileSubrouter.Handle("/profiles/{profile_id}/educations", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.CreateEducationHandler(ctx, svc)))).Methods(http.MethodPost)
-	profileSubrouter.Handle("/profiles/{profile_id}/educations", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.ListEducationHandler(ctx, svc)))).Methods(http.MethodGet)
-	profileSubrouter.Handle("/profiles/{profile_id}/educations/{id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.UpdateEducationHandler(ctx, svc)))).Methods(http.MethodPut)
-	profileSubrouter.Handle("/profiles/{profile_id}/educations/{id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.DeleteEducationHandler(ctx, svc)))).Methods(http.MethodDelete)
+	profileSubrouter.Handle("/profiles/{profile_id}/educations", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.CreateEducationHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/profiles/{profile_id}/educations", middleware.PermissionMiddleware(svc, constants.PermSectionsRead)(http.HandlerFunc(handler.ListEducationHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/profiles/{profile_id}/educations/{id}", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.UpdateEducationHandler(ctx, svc)))).Methods(http.MethodPut)
+	profileSubrouter.Handle("/profiles/{profile_id}/educations/{id}", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.DeleteEducationHandler(ctx, svc)))).Methods(http.MethodDelete)
 
 	// Certificates APIs
-	profileSubrouter.Handle("/profiles/{profile_id}/certificates", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.CreateCertificateHandler(ctx, svc)))).Methods(http.MethodPost)
-	profileSubrouter.Handle("/profiles/{profile_id}/certificates", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.ListCertificatesHandler(ctx, svc)))).Methods(http.MethodGet)
-	profileSubrouter.Handle("/profiles/{profile_id}/certificates/{id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.UpdateCertificateHandler(ctx, svc)))).Methods(http.MethodPut)
-	profileSubrouter.Handle("/profiles/{profile_id}/certificates/{id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.DeleteCertificatesHandler(ctx, svc)))).Methods(http.MethodDelete)
+	profileSubrouter.Handle("/profiles/{profile_id}/certificates", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.CreateCertificateHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/profiles/{profile_id}/certificates", middleware.PermissionMiddleware(svc, constants.PermSectionsRead)(http.HandlerFunc(handler.ListCertificatesHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/profiles/{profile_id}/certificates/{id}", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.UpdateCertificateHandler(ctx, svc)))).Methods(http.MethodPut)
+	profileSubrouter.Handle("/profiles/{profile_id}/certificates/{id}", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.DeleteCertificatesHandler(ctx, svc)))).Methods(http.MethodDelete)
 
 	// Projects APIs
-	profileSubrouter.Handle("/profiles/{profile_id}/projects", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.CreateProjectHandler(ctx, svc)))).Methods(http.MethodPost)
-	profileSubrouter.Handle("/profiles/{profile_id}/projects", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.ListProjectHandler(ctx, svc)))).Methods(http.MethodGet)
-	profileSubrouter.Handle("/profiles/{profile_id}/projects/{id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.UpdateProjectHandler(ctx, svc)))).Methods(http.MethodPut)
-	profileSubrouter.Handle("/profiles/{profile_id}/projects/{id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.DeleteProjectHandler(ctx, svc)))).Methods(http.MethodDelete)
+	profileSubrouter.Handle("/profiles/{profile_id}/projects", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.CreateProjectHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/profiles/{profile_id}/projects", middleware.PermissionMiddleware(svc, constants.PermSectionsRead)(http.HandlerFunc(handler.ListProjectHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/profiles/{profile_id}/projects/{id}", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.UpdateProjectHandler(ctx, svc)))).Methods(http.MethodPut)
+	profileSubrouter.Handle("/profiles/{profile_id}/projects/{id}", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.DeleteProjectHandler(ctx, svc)))).Methods(http.MethodDelete)
+	profileSubrouter.Handle("/profiles/{profile_id}/intranet_projects", middleware.PermissionMiddleware(svc, constants.PermSectionsRead)(http.HandlerFunc(handler.ListIntranetProjectsHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/profiles/{profile_id}/intranet_projects", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.ApplyIntranetProjectsHandler(ctx, svc)))).Methods(http.MethodPost)
 
 	// Experiences APIs
-	profileSubrouter.Handle("/profiles/{profile_id}/experiences", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.CreateExperienceHandler(ctx, svc)))).Methods(http.MethodPost)
-	profileSubrouter.Handle("/profiles/{profile_id}/experiences", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.ListExperienceHandler(ctx, svc)))).Methods(http.MethodGet)
-	profileSubrouter.Handle("/profiles/{profile_id}/experiences/{id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.UpdateExperienceHandler(ctx, svc)))).Methods(http.MethodPut)
-	profileSubrouter.Handle("/profiles/{profile_id}/experiences/{id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.DeleteExperienceHandler(ctx, svc)))).Methods(http.MethodDelete)
+	profileSubrouter.Handle("/profiles/{profile_id}/experiences", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.CreateExperienceHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/profiles/{profile_id}/experiences", middleware.PermissionMiddleware(svc, constants.PermSectionsRead)(http.HandlerFunc(handler.ListExperienceHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/profiles/{profile_id}/experiences/{id}", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.UpdateExperienceHandler(ctx, svc)))).Methods(http.MethodPut)
+	profileSubrouter.Handle("/profiles/{profile_id}/experiences/{id}", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.DeleteExperienceHandler(ctx, svc)))).Methods(http.MethodDelete)
 
 	// Achievements APIs
-	profileSubrouter.Handle("/profiles/{profile_id}/achievements", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.CreateAchievementHandler(ctx, svc)))).Methods(http.MethodPost)
-	profileSubrouter.Handle("/profiles/{profile_id}/achievements", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.ListAchievementsHandler(ctx, svc)))).Methods(http.MethodGet)
-	profileSubrouter.Handle("/profiles/{profile_id}/achievements/{id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.UpdateAchievementHandler(ctx, svc)))).Methods(http.MethodPut)
-	profileSubrouter.Handle("/profiles/{profile_id}/achievements/{id}", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.DeleteAchievementHandler(ctx, svc)))).Methods(http.MethodDelete)
+	profileSubrouter.Handle("/profiles/{profile_id}/achievements", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.CreateAchievementHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/profiles/{profile_id}/achievements", middleware.PermissionMiddleware(svc, constants.PermSectionsRead)(http.HandlerFunc(handler.ListAchievementsHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/profiles/{profile_id}/achievements/{id}", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.UpdateAchievementHandler(ctx, svc)))).Methods(http.MethodPut)
+	profileSubrouter.Handle("/profiles/{profile_id}/achievements/{id}", middleware.PermissionMiddleware(svc, constants.PermSectionsWrite)(http.HandlerFunc(handler.DeleteAchievementHandler(ctx, svc)))).Methods(http.MethodDelete)
 
 	// User Email APIs
-	profileSubrouter.Handle("/profiles/{profile_id}/employee_invite", middleware.RoleMiddleware([]string{constants.Admin})(http.HandlerFunc(handler.SendUserInvitation(ctx, svc)))).Methods(http.MethodPost)
-	profileSubrouter.Handle("/profiles/{profile_id}/profile_complete", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.SendAdminInvitation(ctx, svc)))).Methods(http.MethodPatch)
-	profileSubrouter.Handle("/admin_invite", middleware.RoleMiddleware([]string{constants.Admin})(http.HandlerFunc(handler.InviteAdmin(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/profiles/{profile_id}/employee_invite", middleware.PermissionMiddleware(svc, constants.PermProfilesInvite)(http.HandlerFunc(handler.SendUserInvitation(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/profiles/{profile_id}/employee_invite/resend", middleware.PermissionMiddleware(svc, constants.PermProfilesInvite)(http.HandlerFunc(handler.ResendInvitationHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/profiles/{profile_id}/invitations", middleware.PermissionMiddleware(svc, constants.PermProfilesInvite)(http.HandlerFunc(handler.ListProfileInvitationsHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/invitations", middleware.PermissionMiddleware(svc, constants.PermProfilesInvite)(http.HandlerFunc(handler.ListInvitationsHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/profiles/{profile_id}/profile_complete", middleware.PermissionMiddleware(svc, constants.PermProfilesSubmit)(http.HandlerFunc(handler.SendAdminInvitation(ctx, svc)))).Methods(http.MethodPatch)
+	profileSubrouter.Handle("/admin_invite", middleware.PermissionMiddleware(svc, constants.PermAdminsInvite)(http.HandlerFunc(handler.InviteAdmin(ctx, svc)))).Methods(http.MethodPost)
+
+	// Role and permission APIs
+	profileSubrouter.Handle("/roles", middleware.PermissionMiddleware(svc, constants.PermRolesManage)(http.HandlerFunc(handler.ListRolesHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/roles", middleware.PermissionMiddleware(svc, constants.PermRolesManage)(http.HandlerFunc(handler.CreateRoleHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/roles/{role_id}", middleware.PermissionMiddleware(svc, constants.PermRolesManage)(http.HandlerFunc(handler.UpdateRoleHandler(ctx, svc)))).Methods(http.MethodPut)
+	profileSubrouter.Handle("/roles/{role_id}", middleware.PermissionMiddleware(svc, constants.PermRolesManage)(http.HandlerFunc(handler.DeleteRoleHandler(ctx, svc)))).Methods(http.MethodDelete)
+	profileSubrouter.Handle("/permissions", middleware.PermissionMiddleware(svc, constants.PermRolesManage)(http.HandlerFunc(handler.ListPermissionsHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/users", middleware.PermissionMiddleware(svc, constants.PermUsersManage)(http.HandlerFunc(handler.ListUsersHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/users", middleware.PermissionMiddleware(svc, constants.PermUsersManage)(http.HandlerFunc(handler.CreateUserHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/users/{user_id}", middleware.PermissionMiddleware(svc, constants.PermUsersManage)(http.HandlerFunc(handler.GetUserHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/users/{user_id}", middleware.PermissionMiddleware(svc, constants.PermUsersManage)(http.HandlerFunc(handler.UpdateUserHandler(ctx, svc)))).Methods(http.MethodPut)
+	profileSubrouter.Handle("/users/{user_id}/status", middleware.PermissionMiddleware(svc, constants.PermUsersManage)(http.HandlerFunc(handler.UpdateUserStatusHandler(ctx, svc)))).Methods(http.MethodPatch)
+	profileSubrouter.Handle("/users/{user_id}", middleware.PermissionMiddleware(svc, constants.PermUsersManage)(http.HandlerFunc(handler.DeleteUserHandler(ctx, svc)))).Methods(http.MethodDelete)
+	profileSubrouter.Handle("/users/{user_id}/role", middleware.PermissionMiddleware(svc, constants.PermRolesManage)(http.HandlerFunc(handler.AssignUserRoleHandler(ctx, svc)))).Methods(http.MethodPut)
+	profileSubrouter.Handle("/profiles/{profile_id}/manager", middleware.PermissionMiddleware(svc, constants.PermProfilesAssignManager)(http.HandlerFunc(handler.AssignProfileManagerHandler(ctx, svc)))).Methods(http.MethodPut)
+
+	// Audit log APIs
+	profileSubrouter.Handle("/audit_events", middleware.PermissionMiddleware(svc, constants.PermAuditRead)(http.HandlerFunc(handler.ListAuditEventsHandler(ctx, svc)))).Methods(http.MethodGet)
+
+	// API key APIs
+	profileSubrouter.Handle("/api_keys", middleware.PermissionMiddleware(svc, constants.PermAPIKeysManage)(http.HandlerFunc(handler.ListAPIKeysHandler(ctx, svc)))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/api_keys", middleware.PermissionMiddleware(svc, constants.PermAPIKeysManage)(http.HandlerFunc(handler.CreateAPIKeyHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/api_keys/{api_key_id}/rotate", middleware.PermissionMiddleware(svc, constants.PermAPIKeysManage)(http.HandlerFunc(handler.RotateAPIKeyHandler(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/api_keys/{api_key_id}", middleware.PermissionMiddleware(svc, constants.PermAPIKeysManage)(http.HandlerFunc(handler.RevokeAPIKeyHandler(ctx, svc)))).Methods(http.MethodDelete)
+
+	// Notification APIs
+	profileSubrouter.Handle("/notifications", http.HandlerFunc(handler.ListNotificationsHandler(ctx, svc))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/notifications/stream", http.HandlerFunc(handler.StreamNotificationsHandler(ctx, svc))).Methods(http.MethodGet)
+	profileSubrouter.Handle("/notifications/read_all", http.HandlerFunc(handler.MarkAllNotificationsReadHandler(ctx, svc))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/notifications/{notification_id}/read", http.HandlerFunc(handler.MarkNotificationReadHandler(ctx, svc))).Methods(http.MethodPost)
 
 	// User Logout APIs
-	profileSubrouter.Handle("/logout", middleware.RoleMiddleware([]string{constants.Admin, constants.Employee})(http.HandlerFunc(handler.Logout(ctx, svc)))).Methods(http.MethodPost)
+	profileSubrouter.Handle("/logout", http.HandlerFunc(handler.Logout(ctx, svc))).Methods(http.MethodPost)
 
 	return router
 }
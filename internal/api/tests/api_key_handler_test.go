@@ -0,0 +1,146 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateAPIKeyHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.CreateAPIKeyHandler(context.Background(), mockService)
+
+	tests := []struct {
+		name               string
+		input              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_create_api_key",
+			input: `{"name":"staffing","scopes":["profiles:read"],"allowed_ips":["10.0.0.0/8"]}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("CreateAPIKey", mock.Anything, 1, specs.CreateAPIKeyRequest{
+					Name: "staffing", Scopes: []string{"profiles:read"}, AllowedIPs: []string{"10.0.0.0/8"},
+				}).Return(specs.APIKeySecretResponse{Message: "API key created successfully", APIKeyID: 3, Key: "pbk_0a1b2c3d_5f3c9e"}, nil).Once()
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedResponse:   `{"data":{"message":"API key created successfully","api_key_id":3,"key":"pbk_0a1b2c3d_5f3c9e"}}`,
+		},
+		{
+			name:               "Fail_for_unknown_scope",
+			input:              `{"name":"staffing","scopes":["profiles:write"]}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"unknown API key scope : profiles:write "}`,
+		},
+		{
+			name:               "Fail_for_invalid_allowed_ip",
+			input:              `{"name":"staffing","scopes":["profiles:read"],"allowed_ips":["10.0.0"]}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid IP address or CIDR range : 10.0.0 "}`,
+		},
+		{
+			name:  "Fail_for_duplicate_name",
+			input: `{"name":"staffing","scopes":["profiles:resolve"]}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("CreateAPIKey", mock.Anything, 1, specs.CreateAPIKeyRequest{
+					Name: "staffing", Scopes: []string{"profiles:resolve"}, AllowedIPs: []string{},
+				}).Return(specs.APIKeySecretResponse{}, errors.ErrDuplicateKey).Once()
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedResponse:   `{"error_code":409,"error_message":"record already exists"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/api_keys", bytes.NewBuffer([]byte(tt.input)))
+			req = req.WithContext(context.WithValue(req.Context(), constants.UserIDKey, 1.0))
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestRevokeAPIKeyHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.RevokeAPIKeyHandler(context.Background(), mockService)
+
+	tests := []struct {
+		name               string
+		apiKeyID           string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:     "Success_for_revoke_api_key",
+			apiKeyID: "3",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("RevokeAPIKey", mock.Anything, 3).Return(nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"message":"API key revoked successfully"}}`,
+		},
+		{
+			name:     "Fail_for_missing_api_key",
+			apiKeyID: "4",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("RevokeAPIKey", mock.Anything, 4).Return(errors.ErrAPIKeyNotFound).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"error_code":404,"error_message":"API key not found"}`,
+		},
+		{
+			name:     "Fail_for_already_revoked_api_key",
+			apiKeyID: "5",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("RevokeAPIKey", mock.Anything, 5).Return(errors.ErrAPIKeyRevoked).Once()
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedResponse:   `{"error_code":409,"error_message":"API key has been revoked"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/api_keys/"+tt.apiKeyID, nil)
+			req = mux.SetURLVars(req, map[string]string{constants.APIKeyID: tt.apiKeyID})
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListAuditEventsHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.ListAuditEventsHandler(context.Background(), mockService)
+
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	actorID, profileID, requestID := 1, 7, "req-1"
+
+	tests := []struct {
+		name               string
+		query              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_filtered_events",
+			query: "?actor_id=1&profile_id=7&action=update&from=2024-06-01&to=2024-06-30T12:00:00Z&page=2&limit=10",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListAuditEvents", mock.Anything, specs.ListAuditEventsFilter{
+					ActorID:   1,
+					ProfileID: 7,
+					Action:    constants.AuditActionUpdate,
+					From:      &from,
+					To:        &to,
+					Page:      2,
+					Limit:     10,
+				}).Return(specs.ListAuditEventsResponse{
+					Events: []specs.AuditEventResponse{{
+						ID:         42,
+						ActorID:    &actorID,
+						Action:     constants.AuditActionUpdate,
+						TargetType: "educations",
+						TargetID:   &profileID,
+						ProfileID:  &profileID,
+						Before:     []byte(`{"degree":"BE"}`),
+						After:      []byte(`{"degree":"ME"}`),
+						RequestID:  &requestID,
+						CreatedAt:  from,
+					}},
+					Page:  2,
+					Limit: 10,
+				}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"events":[{"id":42,"actor_id":1,"actor_role":null,"action":"update","target_type":"educations","target_id":7,"profile_id":7,"before":{"degree":"BE"},"after":{"degree":"ME"},"request_id":"req-1","created_at":"2024-06-01T00:00:00Z"}],"page":2,"limit":10}}`,
+		},
+		{
+			name:  "Success_for_default_page",
+			query: "",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListAuditEvents", mock.Anything, specs.ListAuditEventsFilter{Page: 1, Limit: constants.DefaultAuditEventsLimit}).
+					Return(specs.ListAuditEventsResponse{Events: []specs.AuditEventResponse{}, Page: 1, Limit: constants.DefaultAuditEventsLimit}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"events":[],"page":1,"limit":100}}`,
+		},
+		{
+			name:               "Fail_for_invalid_actor_id",
+			query:              "?actor_id=abc",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request format : actor_id "}`,
+		},
+		{
+			name:               "Fail_for_reversed_time_range",
+			query:              "?from=2024-06-30&to=2024-06-01",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request data : to must not be before from "}`,
+		},
+		{
+			name:               "Fail_for_limit_too_large",
+			query:              "?limit=5000",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request data : limit must not exceed 1000 "}`,
+		},
+		{
+			name:  "Fail_for_service_error",
+			query: "?action=login",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListAuditEvents", mock.Anything, specs.ListAuditEventsFilter{Action: constants.AuditActionLogin, Page: 1, Limit: constants.DefaultAuditEventsLimit}).
+					Return(specs.ListAuditEventsResponse{}, errors.New("db error")).Once()
+			},
+			expectedStatusCode: http.StatusBadGateway,
+			expectedResponse:   `{"error_code":502,"error_message":"failed to get data"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/audit_events"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
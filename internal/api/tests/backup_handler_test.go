@@ -0,0 +1,70 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListBackupsHandler(t *testing.T) {
+	verifiedAt := time.Date(2026, 3, 22, 2, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name               string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "Success",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListBackups", mock.Anything).Return(specs.ListBackupsResponse{Backups: []specs.Backup{{
+					FileName:           "profile_builder_backup_20260322000000.ndjson.gz",
+					SizeBytes:          2048,
+					CreatedAt:          time.Date(2026, 3, 22, 0, 0, 0, 0, time.UTC),
+					Checksum:           "abc",
+					VerificationStatus: constants.BackupVerificationFailed,
+					VerifiedAt:         &verifiedAt,
+					VerificationError:  "invalid backup archive : the archive ends before its trailer",
+				}}}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"backups":[{"file_name":"profile_builder_backup_20260322000000.ndjson.gz","size_bytes":2048,"created_at":"2026-03-22T00:00:00Z","checksum":"abc","verification_status":"failed","verified_at":"2026-03-22T02:00:00Z","verification_error":"invalid backup archive : the archive ends before its trailer"}]}}`,
+		},
+		{
+			name: "Fail_without_a_backup_directory",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListBackups", mock.Anything).Return(specs.ListBackupsResponse{}, errors.ErrBackupDirNotSet).Once()
+			},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedResponse:   `{"error_code":503,"error_message":"BACKUP_DIR is not set"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/backups", nil)
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.ListBackupsHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
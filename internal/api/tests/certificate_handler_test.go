@@ -372,7 +372,7 @@ func TestUpdateCertificateHandler(t *testing.T) {
 				}
 			}`,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("UpdateCertificate", context.Background(), 1, 1, 1, mock.AnythingOfType("specs.UpdateCertificateRequest")).Return(1, nil).Once()
+				mockSvc.On("UpdateCertificate", mock.Anything, 1, 1, 1, mock.AnythingOfType("specs.UpdateCertificateRequest")).Return(1, nil).Once()
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedResponse:   `{"data":{"message":"Certificate updated successfully","profile_id":1}}`,
@@ -390,7 +390,7 @@ func TestUpdateCertificateHandler(t *testing.T) {
 				}
 			}`,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("UpdateCertificate", context.Background(), 1, 1, 1, mock.AnythingOfType("specs.UpdateCertificateRequest")).Return(1, nil).Once()
+				mockSvc.On("UpdateCertificate", mock.Anything, 1, 1, 1, mock.AnythingOfType("specs.UpdateCertificateRequest")).Return(1, nil).Once()
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedResponse:   `{"data":{"message":"Certificate updated successfully","profile_id":1}}`,
@@ -431,7 +431,7 @@ func TestUpdateCertificateHandler(t *testing.T) {
 					}
 			}`,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("UpdateCertificate", context.Background(), 1, 1, 1, mock.AnythingOfType("specs.UpdateCertificateRequest")).Return(0, errors.New("service layer error")).Once()
+				mockSvc.On("UpdateCertificate", mock.Anything, 1, 1, 1, mock.AnythingOfType("specs.UpdateCertificateRequest")).Return(0, errors.New("service layer error")).Once()
 			},
 			expectedStatusCode: http.StatusBadGateway,
 			expectedResponse:   `{"error_code":502,"error_message":"service layer error"}`,
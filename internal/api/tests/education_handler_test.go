@@ -332,7 +332,7 @@ func TestUpdateEducationHandler(t *testing.T) {
 				}
 			}`,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("UpdateEducation", context.Background(), TestProfileID, TestEducationID, TestUserID, mock.AnythingOfType("specs.UpdateEducationRequest")).Return(1, nil).Once()
+				mockSvc.On("UpdateEducation", mock.Anything, TestProfileID, TestEducationID, TestUserID, mock.AnythingOfType("specs.UpdateEducationRequest")).Return(1, nil).Once()
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedResponse:   `{"data":{"message":"Education updated successfully","profile_id":1}}`,
@@ -386,7 +386,7 @@ func TestUpdateEducationHandler(t *testing.T) {
 				}
 			}`,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("UpdateEducation", context.Background(), TestProfileID, TestEducationID, TestUserID, mock.AnythingOfType("specs.UpdateEducationRequest")).Return(0, errors.New("Service Error")).Once()
+				mockSvc.On("UpdateEducation", mock.Anything, TestProfileID, TestEducationID, TestUserID, mock.AnythingOfType("specs.UpdateEducationRequest")).Return(0, errors.New("Service Error")).Once()
 			},
 			expectedStatusCode: http.StatusBadGateway,
 			expectedResponse:   `{"error_code":502,"error_message":"Service Error"}`,
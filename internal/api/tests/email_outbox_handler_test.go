@@ -0,0 +1,126 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListOutboxEmailsHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		query              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_failed_emails",
+			query: "?status=failed&limit=10",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListOutboxEmails", mock.Anything, specs.ListOutboxEmailsFilter{Status: constants.EmailFailed, Limit: 10}).Return(specs.ListOutboxEmailsResponse{Emails: []specs.OutboxEmail{}}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"emails":[]}}`,
+		},
+		{
+			name:               "Fail_for_unknown_status",
+			query:              "?status=lost",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request format : status "}`,
+		},
+		{
+			name:               "Fail_for_limit_over_maximum",
+			query:              "?limit=501",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request format : limit "}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/emails"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.ListOutboxEmailsHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResendOutboxEmailHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		id                 string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+	}{
+		{
+			name: "Success_for_a_dead_email",
+			id:   "6",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ResendOutboxEmail", mock.Anything, int64(6)).Return(specs.OutboxEmail{ID: 6, Status: constants.EmailPending}, nil).Once()
+			},
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name: "Fail_for_a_pending_email",
+			id:   "6",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ResendOutboxEmail", mock.Anything, int64(6)).Return(specs.OutboxEmail{}, errors.ErrEmailNotResendable).Once()
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "Fail_for_missing_email",
+			id:   "7",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ResendOutboxEmail", mock.Anything, int64(7)).Return(specs.OutboxEmail{}, errors.ErrOutboxEmailNotFound).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Fail_for_invalid_id",
+			id:                 "abc",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/emails/"+tt.id+"/resend", nil)
+			req = mux.SetURLVars(req, map[string]string{"email_id": tt.id})
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.ResendOutboxEmailHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
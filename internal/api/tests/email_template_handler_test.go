@@ -0,0 +1,145 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateEmailTemplateHandler(t *testing.T) {
+	validReq := specs.UpdateEmailTemplateRequest{Subject: "Welcome {{.Name}}", HTMLBody: "<p>Hi {{.Name}}</p>", TextBody: "Hi {{.Name}}"}
+	validBody := `{"subject":"Welcome {{.Name}}","html_body":"<p>Hi {{.Name}}</p>","text_body":"Hi {{.Name}}"}`
+
+	tests := []struct {
+		name               string
+		kind               string
+		body               string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "Success_saves_a_new_version",
+			kind: constants.EmailKindEmployeeInvitation,
+			body: validBody,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("UpdateEmailTemplate", mock.Anything, constants.EmailKindEmployeeInvitation, 1, validReq).Return(specs.EmailTemplate{Kind: constants.EmailKindEmployeeInvitation, Version: 2}, nil).Once()
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:               "Fail_for_missing_text_body",
+			kind:               constants.EmailKindEmployeeInvitation,
+			body:               `{"subject":"Welcome","html_body":"<p>Hi</p>"}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid email template"}`,
+		},
+		{
+			name: "Fail_for_template_that_does_not_render",
+			kind: constants.EmailKindEmployeeInvitation,
+			body: validBody,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("UpdateEmailTemplate", mock.Anything, constants.EmailKindEmployeeInvitation, 1, validReq).Return(specs.EmailTemplate{}, fmt.Errorf("%w : can't evaluate field Password", errors.ErrInvalidEmailTemplate)).Once()
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid email template : can't evaluate field Password"}`,
+		},
+		{
+			name: "Fail_for_unknown_kind",
+			kind: "birthday",
+			body: validBody,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("UpdateEmailTemplate", mock.Anything, "birthday", 1, validReq).Return(specs.EmailTemplate{}, errors.ErrEmailTemplateNotFound).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"error_code":404,"error_message":"email template not found"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/email_templates/"+tt.kind, strings.NewReader(tt.body))
+			req = mux.SetURLVars(req, map[string]string{"kind": tt.kind})
+			req = req.WithContext(context.WithValue(req.Context(), constants.UserIDKey, 1.0))
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.UpdateEmailTemplateHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			if tt.expectedResponse != "" && rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPreviewEmailTemplateHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		body               string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "Success_without_a_body",
+			body: "",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("PreviewEmailTemplate", mock.Anything, constants.EmailKindAdminInvitation, specs.PreviewEmailTemplateRequest{}).Return(specs.EmailPreviewResponse{Subject: "Welcome"}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"subject":"Welcome","html_body":"","text_body":"","data":{"name":"","email":"","employee_name":"","profile_id":0,"profile_link":"","login_link":"","expires_at":""}}}`,
+		},
+		{
+			name: "Fail_for_missing_profile",
+			body: `{"profile_id":42}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("PreviewEmailTemplate", mock.Anything, constants.EmailKindAdminInvitation, specs.PreviewEmailTemplateRequest{ProfileID: 42}).Return(specs.EmailPreviewResponse{}, errors.ErrNoRecordFound).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Fail_for_invalid_body",
+			body:               `{"profile_id":"x"}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/email_templates/admin_invitation/preview", strings.NewReader(tt.body))
+			req = mux.SetURLVars(req, map[string]string{"kind": constants.EmailKindAdminInvitation})
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.PreviewEmailTemplateHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			if tt.expectedResponse != "" && rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
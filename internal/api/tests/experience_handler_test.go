@@ -308,7 +308,7 @@ func TestUpdateExperienceHandler(t *testing.T) {
 				}
 			}`,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("UpdateExperience", context.Background(), TestProfileID, TestUserID, TestExperienceID, mock.AnythingOfType("specs.UpdateExperienceRequest")).Return(1, nil).Once()
+				mockSvc.On("UpdateExperience", mock.Anything, TestProfileID, TestUserID, TestExperienceID, mock.AnythingOfType("specs.UpdateExperienceRequest")).Return(1, nil).Once()
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedResponse:   `{"data":{"message":"Experience updated successfully","profile_id":1}}`,
@@ -359,7 +359,7 @@ func TestUpdateExperienceHandler(t *testing.T) {
 				}
 			}`,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("UpdateExperience", context.Background(), TestProfileID, TestUserID, TestExperienceID, mock.AnythingOfType("specs.UpdateExperienceRequest")).Return(0, errors.New("Service Error")).Once()
+				mockSvc.On("UpdateExperience", mock.Anything, TestProfileID, TestUserID, TestExperienceID, mock.AnythingOfType("specs.UpdateExperienceRequest")).Return(0, errors.New("Service Error")).Once()
 			},
 			expectedStatusCode: http.StatusBadGateway,
 			expectedResponse:   `{"error_code":502,"error_message":"Service Error"}`,
@@ -0,0 +1,188 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetInternalProfileHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.GetInternalProfileHandler(context.Background(), mockService)
+
+	tests := []struct {
+		name               string
+		employeeID         string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:       "Success_for_full_profile",
+			employeeID: "E1",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetInternalProfile", mock.Anything, "E1").Return(specs.InternalProfileV1{
+					ProfileID:    4,
+					Name:         "Jane",
+					Educations:   []specs.InternalEducationV1{},
+					Certificates: []specs.InternalCertificateV1{},
+					Projects:     []specs.InternalProjectV1{},
+					Experiences:  []specs.InternalExperienceV1{},
+					Achievements: []specs.InternalAchievementV1{{ID: 1, Name: "Award"}},
+				}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"profile_id":4,"employee_id":null,"name":"Jane","email":"","gender":"","mobile":"","designation":"","description":"","title":"","years_of_experience":0,"primary_skills":null,"secondary_skills":null,"josh_joining_date":null,"github_link":"","linkedin_link":"","career_objectives":"","educations":[],"certificates":[],"projects":[],"experiences":[],"achievements":[{"id":1,"name":"Award","description":""}]}}`,
+		},
+		{
+			name:       "Fail_for_unknown_employee",
+			employeeID: "E9",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetInternalProfile", mock.Anything, "E9").Return(specs.InternalProfileV1{}, errors.ErrNoRecordFound).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"error_code":404,"error_message":"` + errors.ErrNoRecordFound.Error() + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/internal/v1/profiles/"+tt.employeeID, nil)
+			req = mux.SetURLVars(req, map[string]string{"employee_id": tt.employeeID})
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestResolveEmployeesHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.ResolveEmployeesHandler(context.Background(), mockService)
+
+	tests := []struct {
+		name               string
+		input              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_resolve_employees",
+			input: `{"employee_ids":["E1"," E2","E1"]}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ResolveEmployeeIDs", mock.Anything, specs.ResolveEmployeesRequest{EmployeeIDs: []string{"E1", "E2"}}).Return(specs.ResolveEmployeesResponseV1{
+					Profiles: []specs.ResolvedEmployeeV1{{EmployeeID: "E1", ProfileID: 4}},
+					NotFound: []string{"E2"},
+				}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"profiles":[{"employee_id":"E1","profile_id":4}],"not_found":["E2"]}}`,
+		},
+		{
+			name:               "Fail_for_empty_employee_ids",
+			input:              `{"employee_ids":[]}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"empty payload array : employee_ids "}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/internal/v1/profiles/resolve", bytes.NewBuffer([]byte(tt.input)))
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestListProfileChangesHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.ListProfileChangesHandler(context.Background(), mockService)
+	since := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		query              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_changes_since",
+			query: "?since=2024-08-01T10:00:00Z&after_id=3",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListProfileChanges", mock.Anything, specs.ListProfileChangesFilter{Since: since, AfterID: 3, Limit: constants.DefaultProfileChangesLimit}).Return(specs.ProfileChangesResponseV1{
+					Changes:     []specs.ProfileChangeV1{{EmployeeID: "E1", ProfileID: 4, ChangedAt: since.Add(time.Minute)}},
+					NextSince:   since.Add(time.Minute),
+					NextAfterID: 4,
+				}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"changes":[{"employee_id":"E1","profile_id":4,"changed_at":"2024-08-01T10:01:00Z","deleted":false}],"has_more":false,"next_since":"2024-08-01T10:01:00Z","next_after_id":4}}`,
+		},
+		{
+			name:               "Fail_for_invalid_since",
+			query:              "?since=yesterday",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request format : since "}`,
+		},
+		{
+			name:               "Fail_for_limit_too_large",
+			query:              "?limit=5000",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request data : limit must not exceed 1000 "}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/internal/v1/profiles/changes"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetIntranetCacheStatsHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.GetIntranetCacheStatsHandler(context.Background(), mockService)
+	mockService.On("GetIntranetCacheStats", mock.Anything).Return(specs.IntranetCacheStats{Enabled: true, Entries: 3, Hits: 3, Misses: 1, HitRate: 0.75}).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/intranet/cache", nil)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+	expectedResponse := `{"data":{"enabled":true,"entries":3,"hits":3,"stale_hits":0,"misses":1,"errors":0,"hit_rate":0.75}}`
+	if rr.Result().StatusCode != http.StatusOK {
+		t.Errorf("Expected %d but got %d", http.StatusOK, rr.Result().StatusCode)
+	}
+	if rr.Body.String() != expectedResponse {
+		t.Errorf("Expected response body %s but got %s", expectedResponse, rr.Body.String())
+	}
+}
+
+func TestInvalidateIntranetCacheHandlers(t *testing.T) {
+	tests := []struct {
+		name             string
+		handlerFunc      func(mockSvc *mocks.Service) func(http.ResponseWriter, *http.Request)
+		employeeID       string
+		setup            func(mockSvc *mocks.Service)
+		expectedResponse string
+	}{
+		{
+			name: "Success_for_clearing_the_cache",
+			handlerFunc: func(mockSvc *mocks.Service) func(http.ResponseWriter, *http.Request) {
+				return handler.ClearIntranetCacheHandler(context.Background(), mockSvc)
+			},
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("InvalidateIntranetCache", mock.Anything, "").Return().Once()
+			},
+			expectedResponse: `{"data":{"message":"Intranet cache cleared"}}`,
+		},
+		{
+			name: "Success_for_invalidating_an_employee",
+			handlerFunc: func(mockSvc *mocks.Service) func(http.ResponseWriter, *http.Request) {
+				return handler.InvalidateIntranetEmployeeHandler(context.Background(), mockSvc)
+			},
+			employeeID: "EMP001",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("InvalidateIntranetCache", mock.Anything, "EMP001").Return().Once()
+			},
+			expectedResponse: `{"data":{"message":"Intranet cache invalidated for the employee"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/intranet/cache", nil)
+			if tt.employeeID != "" {
+				req = mux.SetURLVars(req, map[string]string{"employee_id": tt.employeeID})
+			}
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(tt.handlerFunc(mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != http.StatusOK {
+				t.Errorf("Expected %d but got %d", http.StatusOK, rr.Result().StatusCode)
+			}
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListProfileSyncChangesHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.ListProfileSyncChangesHandler(context.Background(), mockService)
+	createdAt := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		profileID          string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:      "Success_for_listing_sync_changes",
+			profileID: "1",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListProfileSyncChanges", mock.Anything, 1).Return(specs.ListProfileSyncChangesResponse{
+					Changes: []specs.ProfileSyncChange{{ID: 3, ProfileID: 1, EmployeeID: "E1", Field: "designation", OldValue: "Engineer", NewValue: "Senior Engineer", Policy: constants.SyncPolicyIntranetWins, Reason: "the intranet value differs and the intranet wins", CreatedAt: createdAt}},
+				}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"changes":[{"id":3,"profile_id":1,"employee_id":"E1","field":"designation","old_value":"Engineer","new_value":"Senior Engineer","policy":"intranet_wins","reason":"the intranet value differs and the intranet wins","created_at":"2024-08-01T10:00:00Z"}]}}`,
+		},
+		{
+			name:               "Fail_for_invalid_profile_id",
+			profileID:          "abc",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"` + errors.ErrInvalidRequestData.Error() + `"}`,
+		},
+		{
+			name:      "Fail_for_service_error",
+			profileID: "2",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListProfileSyncChanges", mock.Anything, 2).Return(specs.ListProfileSyncChangesResponse{}, errors.ErrNoData).Once()
+			},
+			expectedStatusCode: http.StatusBadGateway,
+			expectedResponse:   `{"error_code":502,"error_message":"` + errors.ErrFailedToGet.Error() + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/profiles/"+tt.profileID+"/sync_changes", nil)
+			req = mux.SetURLVars(req, map[string]string{"profile_id": tt.profileID})
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestListSyncRunsHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.ListSyncRunsHandler(context.Background(), mockService)
+	startedAt := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(time.Minute)
+
+	tests := []struct {
+		name               string
+		query              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_listing_sync_runs",
+			query: "",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListSyncRuns", mock.Anything, constants.DefaultSyncRunsLimit).Return(specs.ListSyncRunsResponse{
+					Runs: []specs.SyncRun{{ID: 2, Trigger: constants.SyncTriggerSchedule, Incremental: true, Status: constants.SyncRunSucceeded, StartedAt: startedAt, FinishedAt: &finishedAt, Updated: 3, Skipped: 1}},
+				}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"runs":[{"id":2,"trigger":"schedule","triggered_by_id":null,"incremental":true,"deactivate_leavers":false,"status":"succeeded","started_at":"2024-08-01T10:00:00Z","finished_at":"2024-08-01T10:01:00Z","updated":3,"unchanged":0,"skipped":1,"left":0,"rejoined":0,"errors":0,"error":""}]}}`,
+		},
+		{
+			name:               "Fail_for_invalid_limit",
+			query:              "?limit=0",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"` + errors.ErrInvalidFormat.Error() + ` : limit "}`,
+		},
+		{
+			name:  "Fail_for_service_error",
+			query: "?limit=5",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListSyncRuns", mock.Anything, 5).Return(specs.ListSyncRunsResponse{}, errors.ErrNoData).Once()
+			},
+			expectedStatusCode: http.StatusBadGateway,
+			expectedResponse:   `{"error_code":502,"error_message":"` + errors.ErrFailedToGet.Error() + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/intranet/sync_runs"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetSyncRunHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.GetSyncRunHandler(context.Background(), mockService)
+	startedAt := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		runID              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_getting_a_running_sync_run",
+			runID: "4",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetSyncRun", mock.Anything, int64(4)).Return(specs.SyncRun{ID: 4, Trigger: constants.SyncTriggerManual, Status: constants.SyncRunRunning, StartedAt: startedAt}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"id":4,"trigger":"manual","triggered_by_id":null,"incremental":false,"deactivate_leavers":false,"status":"running","started_at":"2024-08-01T10:00:00Z","finished_at":null,"updated":0,"unchanged":0,"skipped":0,"left":0,"rejoined":0,"errors":0,"error":""}}`,
+		},
+		{
+			name:  "Fail_for_unknown_sync_run",
+			runID: "5",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetSyncRun", mock.Anything, int64(5)).Return(specs.SyncRun{}, errors.ErrSyncRunNotFound).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"error_code":404,"error_message":"` + errors.ErrSyncRunNotFound.Error() + `"}`,
+		},
+		{
+			name:               "Fail_for_invalid_sync_run_id",
+			runID:              "abc",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"` + errors.ErrInvalidRequestData.Error() + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/intranet/sync_runs/"+tt.runID, nil)
+			req = mux.SetURLVars(req, map[string]string{"sync_run_id": tt.runID})
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestStartSyncRunHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.StartSyncRunHandler(context.Background(), mockService)
+	startedAt := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+	adminID := 1
+
+	tests := []struct {
+		name               string
+		body               string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "Success_for_starting_a_full_sync_without_a_body",
+			body: "",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("StartEmployeeSync", mock.Anything, constants.SyncTriggerManual, 1, specs.SyncEmployeesOptions{}).Return(specs.SyncRun{ID: 6, Trigger: constants.SyncTriggerManual, TriggeredByID: &adminID, Status: constants.SyncRunRunning, StartedAt: startedAt}, nil).Once()
+			},
+			expectedStatusCode: http.StatusAccepted,
+			expectedResponse:   `{"data":{"id":6,"trigger":"manual","triggered_by_id":1,"incremental":false,"deactivate_leavers":false,"status":"running","started_at":"2024-08-01T10:00:00Z","finished_at":null,"updated":0,"unchanged":0,"skipped":0,"left":0,"rejoined":0,"errors":0,"error":""}}`,
+		},
+		{
+			name: "Fail_while_another_run_is_running",
+			body: `{"incremental":true}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("StartEmployeeSync", mock.Anything, constants.SyncTriggerManual, 1, specs.SyncEmployeesOptions{Incremental: true}).Return(specs.SyncRun{}, errors.ErrSyncInProgress).Once()
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedResponse:   `{"error_code":409,"error_message":"` + errors.ErrSyncInProgress.Error() + `"}`,
+		},
+		{
+			name:               "Fail_for_invalid_body",
+			body:               `{"incremental":`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"` + errors.ErrInvalidBody.Error() + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/intranet/sync_runs", strings.NewReader(tt.body))
+			req = req.WithContext(context.WithValue(req.Context(), constants.UserIDKey, 1.0))
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
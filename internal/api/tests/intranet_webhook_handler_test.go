@@ -0,0 +1,184 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIntranetWebhookHandler(t *testing.T) {
+	event := specs.IntranetWebhookEvent{ID: "evt_1", Type: constants.IntranetEventEmployeeExited, EmployeeID: "EMP001"}
+	body := `{"id":"evt_1","type":"employee.exited","employee_id":"EMP001"}`
+
+	tests := []struct {
+		name               string
+		body               string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "Success_for_a_new_event",
+			body: body,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ReceiveIntranetWebhook", mock.Anything, event, []byte(body)).Return(specs.ReceiveIntranetWebhookResponse{EventID: "evt_1", Status: constants.WebhookEventPending}, nil).Once()
+			},
+			expectedStatusCode: http.StatusAccepted,
+			expectedResponse:   `{"data":{"event_id":"evt_1","status":"pending","duplicate":false}}`,
+		},
+		{
+			name: "Success_for_a_duplicate_event",
+			body: body,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ReceiveIntranetWebhook", mock.Anything, event, []byte(body)).Return(specs.ReceiveIntranetWebhookResponse{EventID: "evt_1", Status: constants.WebhookEventPending, Duplicate: true}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"event_id":"evt_1","status":"pending","duplicate":true}}`,
+		},
+		{
+			name:               "Fail_for_unknown_event_type",
+			body:               `{"id":"evt_2","type":"employee.promoted","employee_id":"EMP001"}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"` + errors.ErrInvalidWebhookEvent.Error() + `"}`,
+		},
+		{
+			name:               "Fail_for_invalid_body",
+			body:               `{"id":`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"` + errors.ErrInvalidBody.Error() + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/internal/webhooks/intranet", strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.IntranetWebhookHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListIntranetWebhookEventsHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		query              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_dead_events",
+			query: "?status=dead&limit=10",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListIntranetWebhookEvents", mock.Anything, specs.ListIntranetWebhookEventsFilter{Status: constants.WebhookEventDead, Limit: 10}).Return(specs.ListIntranetWebhookEventsResponse{Events: []specs.IntranetWebhookEventRecord{}}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"events":[]}}`,
+		},
+		{
+			name:               "Fail_for_unknown_status",
+			query:              "?status=lost",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request format : status "}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/intranet/webhook_events"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.ListIntranetWebhookEventsHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestRetryIntranetWebhookEventHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		id                 string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+	}{
+		{
+			name: "Success_for_a_dead_event",
+			id:   "6",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("RetryIntranetWebhookEvent", mock.Anything, int64(6)).Return(specs.IntranetWebhookEventRecord{ID: 6, Status: constants.WebhookEventPending}, nil).Once()
+			},
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name: "Fail_for_a_processed_event",
+			id:   "6",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("RetryIntranetWebhookEvent", mock.Anything, int64(6)).Return(specs.IntranetWebhookEventRecord{}, errors.ErrWebhookEventNotRetryable).Once()
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "Fail_for_missing_event",
+			id:   "7",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("RetryIntranetWebhookEvent", mock.Anything, int64(7)).Return(specs.IntranetWebhookEventRecord{}, errors.ErrWebhookEventNotFound).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "Fail_for_invalid_id",
+			id:                 "abc",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/intranet/webhook_events/"+tt.id+"/retry", nil)
+			req = mux.SetURLVars(req, map[string]string{"webhook_event_id": tt.id})
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.RetryIntranetWebhookEventHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
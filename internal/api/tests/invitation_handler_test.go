@@ -0,0 +1,205 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListInvitationsHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		query              string
+		scope              string
+		ownProfileID       int
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_overdue_invitations",
+			query: "?status=overdue",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListInvitations", mock.Anything, specs.ListInvitationsFilter{Status: constants.InvitationOverdue, Page: 1, Limit: constants.DefaultInvitationsLimit}).
+					Return(specs.ListInvitationsResponse{Invitations: []specs.Invitation{}, Page: 1, Limit: constants.DefaultInvitationsLimit}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"invitations":[],"page":1,"limit":100}}`,
+		},
+		{
+			name:  "Success_for_invitations_of_an_inviter_within_days",
+			query: "?status=completed&invited_by=3&from=2024-05-01&to=2024-05-31&page=2&limit=10",
+			setup: func(mockSvc *mocks.Service) {
+				ist := time.FixedZone("IST", 5*60*60+30*60)
+				from := time.Date(2024, 5, 1, 0, 0, 0, 0, ist)
+				to := time.Date(2024, 6, 1, 0, 0, 0, 0, ist).Add(-time.Nanosecond)
+				mockSvc.On("ListInvitations", mock.Anything, mock.MatchedBy(func(filter specs.ListInvitationsFilter) bool {
+					return filter.Status == constants.InvitationCompleted && filter.InvitedByID == 3 && filter.Page == 2 && filter.Limit == 10 &&
+						filter.From.Equal(from) && filter.To.Equal(to)
+				})).Return(specs.ListInvitationsResponse{Invitations: []specs.Invitation{}, Page: 2, Limit: 10}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"invitations":[],"page":2,"limit":10}}`,
+		},
+		{
+			name:  "Success_for_invitations_of_reportees",
+			scope: constants.ScopeReportees,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListInvitations", mock.Anything, specs.ListInvitationsFilter{ManagerID: 1, Page: 1, Limit: constants.DefaultInvitationsLimit}).
+					Return(specs.ListInvitationsResponse{Invitations: []specs.Invitation{}, Page: 1, Limit: constants.DefaultInvitationsLimit}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"invitations":[],"page":1,"limit":100}}`,
+		},
+		{
+			name:         "Success_for_invitations_of_own_profile",
+			scope:        constants.ScopeOwn,
+			ownProfileID: 5,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListInvitations", mock.Anything, specs.ListInvitationsFilter{ProfileID: 5, Page: 1, Limit: constants.DefaultInvitationsLimit}).
+					Return(specs.ListInvitationsResponse{Invitations: []specs.Invitation{}, Page: 1, Limit: constants.DefaultInvitationsLimit}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"invitations":[],"page":1,"limit":100}}`,
+		},
+		{
+			name:               "Success_for_own_scope_without_a_profile",
+			scope:              constants.ScopeOwn,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"invitations":[],"page":1,"limit":100}}`,
+		},
+		{
+			name:               "Fail_for_to_before_from",
+			query:              "?from=2024-05-02&to=2024-05-01",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request data : to must not be before from "}`,
+		},
+		{
+			name:               "Fail_for_invalid_inviter",
+			query:              "?invited_by=admin",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request format : invited_by "}`,
+		},
+		{
+			name:               "Fail_for_unknown_status",
+			query:              "?status=expired",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request format : status "}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/invitations"+tt.query, nil)
+			ctx := context.WithValue(req.Context(), constants.UserIDKey, 1.0)
+			ctx = context.WithValue(ctx, constants.ProfileIDKey, tt.ownProfileID)
+			if tt.scope != "" {
+				ctx = context.WithValue(ctx, constants.PermissionScope, tt.scope)
+			}
+			req = req.WithContext(ctx)
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.ListInvitationsHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListProfileInvitationsHandler(t *testing.T) {
+	completedAt := time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC)
+	hours := 49.5
+	invitations := []specs.Invitation{{
+		ID: 7, ProfileID: 5, Name: "Alice", Email: "alice@example.com", Status: constants.InvitationCompleted, InvitedByID: 1,
+		CreatedAt: time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC), ExpiresAt: time.Date(2024, 5, 15, 8, 30, 0, 0, time.UTC),
+		CompletedAt: &completedAt, HoursToComplete: &hours,
+	}}
+
+	mockService := new(mocks.Service)
+	mockService.On("ListProfileInvitations", mock.Anything, 5).Return(specs.ListInvitationsResponse{Invitations: invitations}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/profiles/5/invitations", nil)
+	req = mux.SetURLVars(req, map[string]string{"profile_id": "5"})
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(handler.ListProfileInvitationsHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+	expectedResponse := `{"data":{"invitations":[{"id":7,"profile_id":5,"name":"Alice","email":"alice@example.com","status":"completed","invited_by_id":1,` +
+		`"invited_by_name":null,"invited_by_email":null,"created_at":"2024-05-01T08:30:00Z","expires_at":"2024-05-15T08:30:00Z",` +
+		`"completed_at":"2024-05-03T10:00:00Z","hours_to_complete":49.5,"days_outstanding":null,"reminders_sent":0,"last_reminded_at":null,"resent_count":0}],"page":0,"limit":0}}`
+	if rr.Result().StatusCode != http.StatusOK {
+		t.Errorf("Expected %d but got %d", http.StatusOK, rr.Result().StatusCode)
+	}
+	if rr.Body.String() != expectedResponse {
+		t.Errorf("Expected response body %s but got %s", expectedResponse, rr.Body.String())
+	}
+	mockService.AssertExpectations(t)
+}
+
+func TestResendInvitationHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "Success_for_resending_invitation",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ResendInvitation", mock.Anything, 1, 5).Return(nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"message":"Invitation resent successfully to employee"}}`,
+		},
+		{
+			name: "Fail_for_profile_without_open_invitation",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ResendInvitation", mock.Anything, 1, 5).Return(errors.ErrInvitationNotFound).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"error_code":404,"error_message":"the profile has no open invitation"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/profiles/5/employee_invite/resend", nil)
+			req = mux.SetURLVars(req, map[string]string{"profile_id": "5"})
+			req = req.WithContext(context.WithValue(req.Context(), constants.UserIDKey, 1.0))
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.ResendInvitationHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
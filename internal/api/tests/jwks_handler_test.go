@@ -0,0 +1,44 @@
+package test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	jwttoken "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/jwt_token"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWKSHandler(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keySet, err := jwttoken.NewKeySet("key-1", privateKey, nil)
+	require.NoError(t, err)
+	jwttoken.SetKeySet(keySet)
+
+	handlerFunc := handler.JWKSHandler(context.Background(), new(mocks.Service))
+
+	req, err := http.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	handlerFunc(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var jwks specs.JWKS
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &jwks))
+	require.Len(t, jwks.Keys, 1)
+	assert.Equal(t, "key-1", jwks.Keys[0].Kid)
+	assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+	assert.Equal(t, "EdDSA", jwks.Keys[0].Alg)
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(publicKey), jwks.Keys[0].X)
+}
@@ -0,0 +1,192 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListNotificationsHandler(t *testing.T) {
+	profileID := 5
+	notification := specs.Notification{
+		ID: 3, UserID: 1, Kind: constants.NotificationProfileSubmitted, Title: "Alice submitted their profile",
+		Body: "The profile of Alice is complete and ready to review.", Link: "http://localhost/profiles/5", ProfileID: &profileID,
+		CreatedAt: time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name               string
+		query              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_unread_notifications",
+			query: "?unread=true&page=2&limit=1",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListNotifications", mock.Anything, 1, specs.ListNotificationsFilter{UnreadOnly: true, Page: 2, Limit: 1}).
+					Return(specs.ListNotificationsResponse{Notifications: []specs.Notification{notification}, UnreadCount: 2, Page: 2, Limit: 1}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse: `{"data":{"notifications":[{"id":3,"user_id":1,"kind":"profile_submitted","title":"Alice submitted their profile",` +
+				`"body":"The profile of Alice is complete and ready to review.","link":"http://localhost/profiles/5","profile_id":5,"read_at":null,` +
+				`"created_at":"2024-05-01T08:30:00Z"}],"unread_count":2,"page":2,"limit":1}}`,
+		},
+		{
+			name:               "Fail_for_invalid_unread",
+			query:              "?unread=maybe",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request format : unread "}`,
+		},
+		{
+			name:               "Fail_for_limit_above_maximum",
+			query:              "?limit=500",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request data : limit must not exceed 200 "}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/notifications"+tt.query, nil)
+			req = req.WithContext(context.WithValue(req.Context(), constants.UserIDKey, 1.0))
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.ListNotificationsHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMarkNotificationReadHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "Success_for_marking_notification_read",
+			setup: func(mockSvc *mocks.Service) {
+				readAt := time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC)
+				mockSvc.On("MarkNotificationRead", mock.Anything, 1, int64(3)).
+					Return(specs.Notification{ID: 3, UserID: 1, Kind: constants.NotificationIntranetSync, Title: "Intranet sync failed", ReadAt: &readAt, CreatedAt: readAt}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse: `{"data":{"id":3,"user_id":1,"kind":"intranet_sync","title":"Intranet sync failed","body":"","link":"","profile_id":null,` +
+				`"read_at":"2024-05-02T09:00:00Z","created_at":"2024-05-02T09:00:00Z"}}`,
+		},
+		{
+			name: "Fail_for_notification_of_another_user",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("MarkNotificationRead", mock.Anything, 1, int64(3)).Return(specs.Notification{}, errors.ErrNotificationNotFound).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"error_code":404,"error_message":"notification not found"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(mocks.Service)
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/notifications/3/read", nil)
+			req = mux.SetURLVars(req, map[string]string{"notification_id": "3"})
+			req = req.WithContext(context.WithValue(req.Context(), constants.UserIDKey, 1.0))
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handler.MarkNotificationReadHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStreamNotificationsHandler(t *testing.T) {
+	events := make(chan specs.NotificationEvent)
+	unsubscribed := false
+
+	mockService := new(mocks.Service)
+	mockService.On("SubscribeNotifications", 1).Return((<-chan specs.NotificationEvent)(events), func() { unsubscribed = true }).Once()
+	mockService.On("CountUnreadNotifications", mock.Anything, 1).Return(2, nil).Once()
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), constants.UserIDKey, 1.0))
+	req := httptest.NewRequest(http.MethodGet, "/api/notifications/stream", nil).WithContext(ctx)
+	rr := httptest.NewRecorder()
+
+	go func() {
+		events <- specs.NotificationEvent{
+			Notification: &specs.Notification{ID: 4, UserID: 1, Kind: constants.NotificationInvitationSent, Title: "Complete your profile", CreatedAt: time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC)},
+			UnreadCount:  3,
+		}
+		events <- specs.NotificationEvent{UnreadCount: 1}
+		cancel()
+	}()
+	http.HandlerFunc(handler.StreamNotificationsHandler(context.Background(), mockService)).ServeHTTP(rr, req)
+
+	expectedResponse := "event: unread\ndata: {\"unread_count\":2}\n\n" +
+		"event: notification\ndata: {\"notification\":{\"id\":4,\"user_id\":1,\"kind\":\"invitation_sent\",\"title\":\"Complete your profile\"," +
+		"\"body\":\"\",\"link\":\"\",\"profile_id\":null,\"read_at\":null,\"created_at\":\"2024-05-01T08:30:00Z\"},\"unread_count\":3}\n\n" +
+		"event: unread\ndata: {\"unread_count\":1}\n\n"
+	if rr.Header().Get("Content-Type") != "text/event-stream" {
+		t.Errorf("Expected content type text/event-stream but got %s", rr.Header().Get("Content-Type"))
+	}
+	if rr.Body.String() != expectedResponse {
+		t.Errorf("Expected response body %s but got %s", expectedResponse, rr.Body.String())
+	}
+	if !unsubscribed {
+		t.Errorf("Expected the stream to unsubscribe once the client went away")
+	}
+	mockService.AssertExpectations(t)
+}
+
+func TestStreamNotificationsHandlerEndsOnShutdown(t *testing.T) {
+	unsubscribed := false
+
+	mockService := new(mocks.Service)
+	mockService.On("SubscribeNotifications", 1).Return((<-chan specs.NotificationEvent)(make(chan specs.NotificationEvent)), func() { unsubscribed = true }).Once()
+	mockService.On("CountUnreadNotifications", mock.Anything, 1).Return(2, nil).Once()
+
+	serverCtx, stopServer := context.WithCancel(context.Background())
+	stopServer()
+	req := httptest.NewRequest(http.MethodGet, "/api/notifications/stream", nil).WithContext(context.WithValue(context.Background(), constants.UserIDKey, 1.0))
+	rr := httptest.NewRecorder()
+
+	http.HandlerFunc(handler.StreamNotificationsHandler(serverCtx, mockService)).ServeHTTP(rr, req)
+
+	if rr.Body.String() != "event: unread\ndata: {\"unread_count\":2}\n\n" {
+		t.Errorf("Expected only the unread count but got %s", rr.Body.String())
+	}
+	if !unsubscribed {
+		t.Errorf("Expected the stream to unsubscribe once the server shut down")
+	}
+	mockService.AssertExpectations(t)
+}
@@ -205,20 +205,45 @@ func TestGetProfileListHandler(t *testing.T) {
 
 	tests := []struct {
 		name               string
+		scope              string
+		ownProfileID       int
 		setup              func(mock *mocks.Service)
 		expectedStatusCode int
 	}{
 		{
 			name: "Success_for_listing_profiles",
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("ListProfiles", mock.Anything).Return(mockListProfile, nil).Once()
+				mockSvc.On("ListProfiles", mock.Anything, specs.ListProfilesFilter{}).Return(mockListProfile, nil).Once()
 			},
 			expectedStatusCode: http.StatusOK,
 		},
+		{
+			name:  "Success_for_listing_reportees",
+			scope: constants.ScopeReportees,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListProfiles", mock.Anything, specs.ListProfilesFilter{ManagerID: 1}).Return(mockListProfile, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:         "Success_for_listing_own_profile",
+			scope:        constants.ScopeOwn,
+			ownProfileID: 5,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListProfiles", mock.Anything, specs.ListProfilesFilter{ProfileID: 5}).Return(mockListProfile, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Success_for_listing_own_profile_without_one",
+			scope:              constants.ScopeOwn,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusOK,
+		},
 		{
 			name: "Fail_as_error_in_listprofiles",
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("ListProfiles", mock.Anything).Return(nil, errors.New("error")).Once()
+				mockSvc.On("ListProfiles", mock.Anything, specs.ListProfilesFilter{}).Return(nil, errors.New("error")).Once()
 			},
 			expectedStatusCode: http.StatusBadGateway,
 		},
@@ -231,6 +256,10 @@ func TestGetProfileListHandler(t *testing.T) {
 			req := httptest.NewRequest("GET", "/profiles", nil)
 
 			ctx := context.WithValue(req.Context(), constants.UserIDKey, 1.0)
+			ctx = context.WithValue(ctx, constants.ProfileIDKey, test.ownProfileID)
+			if test.scope != "" {
+				ctx = context.WithValue(ctx, constants.PermissionScope, test.scope)
+			}
 			req = req.WithContext(ctx)
 
 			rr := httptest.NewRecorder()
@@ -702,6 +731,22 @@ func TestUpdateSequenceHandler(t *testing.T) {
 			expectedStatusCode: http.StatusBadRequest,
 			expectedResponse:   `{"error_code":400,"error_message":"invalid user id"}`,
 		},
+		{
+			name: "Fail_for_profile_outside_own_scope",
+			input: `{
+		        "id": 1,
+		        "component": {
+		            "comp_name": "achievements",
+		            "component_priorities": {
+		                "1": 1,
+		                "2": 2
+		            }
+		        }
+		    }`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusForbidden,
+			expectedResponse:   `{"error_code":403,"error_message":"permission denied"}`,
+		},
 	}
 
 	for _, test := range tests {
@@ -712,6 +757,11 @@ func TestUpdateSequenceHandler(t *testing.T) {
 
 			if test.name != "Fail_for_missing_userID_in_context" && test.name != "Fail_for_missing_profile_id" {
 				ctx := context.WithValue(req.Context(), constants.UserIDKey, 1.0)
+				ctx = context.WithValue(ctx, constants.PermissionScope, constants.ScopeAll)
+				if test.name == "Fail_for_profile_outside_own_scope" {
+					ctx = context.WithValue(ctx, constants.PermissionScope, constants.ScopeOwn)
+					ctx = context.WithValue(ctx, constants.ProfileIDKey, 2)
+				}
 				req = req.WithContext(ctx)
 			}
 
@@ -755,7 +805,7 @@ func TestUpdateProfileStatusHandler(t *testing.T) {
 				}
 			}`,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("UpdateProfileStatus", context.Background(), TestProfileID, specs.UpdateProfileStatus{
+				mockSvc.On("UpdateProfileStatus", mock.Anything, TestProfileID, specs.UpdateProfileStatus{
 					ProfileStatus: specs.UpdateProfileStatusRequest{
 						IsCurrentEmployee: "yes",
 						IsActive:          "",
@@ -802,7 +852,7 @@ func TestUpdateProfileStatusHandler(t *testing.T) {
 				}
 			}`,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("UpdateProfileStatus", context.Background(), TestProfileID, specs.UpdateProfileStatus{
+				mockSvc.On("UpdateProfileStatus", mock.Anything, TestProfileID, specs.UpdateProfileStatus{
 					ProfileStatus: specs.UpdateProfileStatusRequest{
 						IsCurrentEmployee: "yes",
 					},
@@ -821,6 +871,11 @@ func TestUpdateProfileStatusHandler(t *testing.T) {
 
 			if test.name != "Fail_for_missing_userID_in_context" && test.name != "Fail_for_missing_profile_id" {
 				ctx := context.WithValue(req.Context(), constants.UserIDKey, 1.0)
+				ctx = context.WithValue(ctx, constants.PermissionScope, constants.ScopeAll)
+				if test.name == "Fail_for_profile_outside_own_scope" {
+					ctx = context.WithValue(ctx, constants.PermissionScope, constants.ScopeOwn)
+					ctx = context.WithValue(ctx, constants.ProfileIDKey, 2)
+				}
 				req = req.WithContext(ctx)
 			}
 
@@ -862,7 +917,7 @@ func TestResolveEmployeeHandler(t *testing.T) {
 			employeeID: "12345",
 			setVars:    true,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("ResolveEmployeeID", context.Background(), "12345").Return(42, nil).Once()
+				mockSvc.On("ResolveEmployeeID", mock.Anything, "12345").Return(42, nil).Once()
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedResponse:   `{"data":{"message":"Employee resolved successfully","profile_id":42}}`,
@@ -888,7 +943,7 @@ func TestResolveEmployeeHandler(t *testing.T) {
 			employeeID: "99999",
 			setVars:    true,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("ResolveEmployeeID", context.Background(), "99999").Return(0, errors.New("resolution failed")).Once()
+				mockSvc.On("ResolveEmployeeID", mock.Anything, "99999").Return(0, errors.New("resolution failed")).Once()
 			},
 			expectedStatusCode: http.StatusNotFound,
 			expectedResponse:   `{"error_code":404,"error_message":"no record found"}`,
@@ -964,6 +1019,14 @@ func TestGetIntranetEmployeeHandler(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusBadGateway,
 		},
+		{
+			name:       "Fail_intranet_unavailable",
+			employeeID: "EMP503",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetIntranetEmployee", mock.Anything, "EMP503").Return(specs.IntranetEmployeeResponse{}, errs.ErrIntranetUnavailable).Once()
+			},
+			expectedStatusCode: http.StatusServiceUnavailable,
+		},
 	}
 
 	for _, test := range tests {
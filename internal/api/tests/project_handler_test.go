@@ -363,7 +363,7 @@ func TestUpdateProjectHandler(t *testing.T) {
 				}
 				`,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("UpdateProject", context.Background(), TestProfileID, TestProjectID, TestUserID, mock.AnythingOfType("specs.UpdateProjectRequest")).Return(1, nil).Once()
+				mockSvc.On("UpdateProject", mock.Anything, TestProfileID, TestProjectID, TestUserID, mock.AnythingOfType("specs.UpdateProjectRequest")).Return(1, nil).Once()
 			},
 			expectedStatusCode: http.StatusOK,
 			expectedResponse:   `{"data":{"message":"Project updated successfully","profile_id":1}}`,
@@ -429,7 +429,7 @@ func TestUpdateProjectHandler(t *testing.T) {
 				}
 			}`,
 			setup: func(mockSvc *mocks.Service) {
-				mockSvc.On("UpdateProject", context.Background(), TestProfileID, TestProjectID, TestUserID, mock.AnythingOfType("specs.UpdateProjectRequest")).Return(0, errors.New("failed to update project")).Once()
+				mockSvc.On("UpdateProject", mock.Anything, TestProfileID, TestProjectID, TestUserID, mock.AnythingOfType("specs.UpdateProjectRequest")).Return(0, errors.New("failed to update project")).Once()
 			},
 			expectedStatusCode: http.StatusBadGateway,
 			expectedResponse:   `{"error_code":502,"error_message":"failed to update project"}`,
@@ -600,3 +600,143 @@ func TestDeleteProjectHandler(t *testing.T) {
 	}
 
 }
+
+func TestListIntranetProjectsHandler(t *testing.T) {
+	projectSvc := new(mocks.Service)
+	handlerFunc := handler.ListIntranetProjectsHandler(context.Background(), projectSvc)
+
+	tests := []struct {
+		name               string
+		profileID          string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:      "Success_for_listing_intranet_projects",
+			profileID: "1",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListIntranetProjectProposals", mock.Anything, 1).Return(specs.IntranetProjectProposalsResponse{
+					EmployeeID: "EMP001",
+					Proposals: []specs.IntranetProjectProposal{{Action: constants.IntranetProjectUpdate, ProjectID: 4, Name: "Payroll", Changes: []specs.IntranetProjectChange{
+						{Field: "description", OldValue: "Payroll", NewValue: "Monthly payroll"},
+					}}},
+				}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"employee_id":"EMP001","proposals":[{"action":"update","project_id":4,"name":"Payroll","changes":[{"field":"description","old_value":"Payroll","new_value":"Monthly payroll"}]}]}}`,
+		},
+		{
+			name:      "Fail_for_profile_without_employee_id",
+			profileID: "2",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListIntranetProjectProposals", mock.Anything, 2).Return(specs.IntranetProjectProposalsResponse{}, errs.ErrNoEmployeeID).Once()
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedResponse:   `{"error_code":409,"error_message":"` + errs.ErrNoEmployeeID.Error() + `"}`,
+		},
+		{
+			name:      "Fail_for_intranet_unavailable",
+			profileID: "3",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListIntranetProjectProposals", mock.Anything, 3).Return(specs.IntranetProjectProposalsResponse{}, errs.ErrIntranetUnavailable).Once()
+			},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedResponse:   `{"error_code":503,"error_message":"` + errs.ErrIntranetUnavailable.Error() + `"}`,
+		},
+		{
+			name:               "Fail_for_invalid_profile_id",
+			profileID:          "abc",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"` + errs.ErrInvalidRequestData.Error() + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(projectSvc)
+
+			req := httptest.NewRequest(http.MethodGet, "/profiles/"+tt.profileID+"/intranet_projects", nil)
+			req = mux.SetURLVars(req, map[string]string{"profile_id": tt.profileID})
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestApplyIntranetProjectsHandler(t *testing.T) {
+	projectSvc := new(mocks.Service)
+	handlerFunc := handler.ApplyIntranetProjectsHandler(context.Background(), projectSvc)
+
+	tests := []struct {
+		name               string
+		body               string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name: "Success_for_applying_intranet_projects",
+			body: `{"decisions":[{"name":"Billing","accept":true},{"name":"Search","accept":false}]}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ApplyIntranetProjectProposals", mock.Anything, 1, 1, specs.ApplyIntranetProjectsRequest{Decisions: []specs.IntranetProjectDecision{
+					{Name: "Billing", Accept: true}, {Name: "Search", Accept: false},
+				}}).Return(specs.ApplyIntranetProjectsResponse{Added: 1, Rejected: 1}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"added":1,"updated":0,"rejected":1}}`,
+		},
+		{
+			name: "Fail_for_project_not_proposed",
+			body: `{"decisions":[{"name":"Unknown","accept":true}]}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ApplyIntranetProjectProposals", mock.Anything, 1, 1, mock.Anything).Return(specs.ApplyIntranetProjectsResponse{}, errs.ErrProjectNotProposed).Once()
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedResponse:   `{"error_code":409,"error_message":"` + errs.ErrProjectNotProposed.Error() + `"}`,
+		},
+		{
+			name:               "Fail_for_duplicate_decisions",
+			body:               `{"decisions":[{"name":"Billing","accept":true},{"name":"billing","accept":false}]}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"` + errs.ErrDuplicateDecision.Error() + ` : billing "}`,
+		},
+		{
+			name:               "Fail_for_empty_decisions",
+			body:               `{"decisions":[]}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"` + errs.ErrEmptyPayload.Error() + ` : decisions "}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(projectSvc)
+
+			req := httptest.NewRequest(http.MethodPost, "/profiles/1/intranet_projects", strings.NewReader(tt.body))
+			req = mux.SetURLVars(req, map[string]string{"profile_id": "1"})
+			req = req.WithContext(context.WithValue(req.Context(), constants.UserIDKey, 1.0))
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
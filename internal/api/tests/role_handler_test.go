@@ -0,0 +1,218 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateRoleHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.CreateRoleHandler(context.Background(), mockService)
+
+	tests := []struct {
+		name               string
+		input              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_create_role",
+			input: `{"name":"sales","description":"Sales team","permissions":[{"permission":"profiles:read","scope":"all"}]}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("CreateRole", mock.Anything, specs.CreateRoleRequest{
+					Name:        "sales",
+					Description: "Sales team",
+					Permissions: []specs.RolePermission{{Permission: constants.PermProfilesRead, Scope: constants.ScopeAll}},
+				}).Return(6, nil).Once()
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedResponse:   `{"data":{"message":"Role created successfully","role_id":6}}`,
+		},
+		{
+			name:               "Fail_for_unknown_permission",
+			input:              `{"name":"sales","permissions":[{"permission":"profiles:print","scope":"all"}]}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"unknown permission : profiles:print "}`,
+		},
+		{
+			name:               "Fail_for_invalid_scope",
+			input:              `{"name":"sales","permissions":[{"permission":"profiles:read","scope":"team"}]}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid permission scope : team "}`,
+		},
+		{
+			name:               "Fail_for_invalid_role_name",
+			input:              `{"name":"Sales Team","permissions":[]}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"role name is required and may only contain lowercase letters, digits and underscores"}`,
+		},
+		{
+			name:  "Fail_for_duplicate_role",
+			input: `{"name":"viewer","permissions":[]}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("CreateRole", mock.Anything, specs.CreateRoleRequest{Name: "viewer", Permissions: []specs.RolePermission{}}).Return(0, errors.ErrDuplicateKey).Once()
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedResponse:   `{"error_code":409,"error_message":"record already exists"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/roles", bytes.NewBuffer([]byte(tt.input)))
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestDeleteRoleHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.DeleteRoleHandler(context.Background(), mockService)
+
+	tests := []struct {
+		name               string
+		roleID             string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:   "Success_for_delete_role",
+			roleID: "4",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("DeleteRole", mock.Anything, 4).Return(nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"message":"Role deleted successfully"}}`,
+		},
+		{
+			name:   "Fail_for_system_role",
+			roleID: "1",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("DeleteRole", mock.Anything, 1).Return(errors.ErrSystemRole).Once()
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedResponse:   `{"error_code":409,"error_message":"system roles cannot be modified or deleted"}`,
+		},
+		{
+			name:   "Fail_for_missing_role",
+			roleID: "9",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("DeleteRole", mock.Anything, 9).Return(errors.ErrRoleNotFound).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"error_code":404,"error_message":"role not found"}`,
+		},
+		{
+			name:               "Fail_for_invalid_role_id",
+			roleID:             "abc",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request data"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/roles/"+tt.roleID, nil)
+			req = mux.SetURLVars(req, map[string]string{"role_id": tt.roleID})
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestAssignUserRoleHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.AssignUserRoleHandler(context.Background(), mockService)
+
+	tests := []struct {
+		name               string
+		input              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_assign_role",
+			input: `{"role":"manager"}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("AssignUserRole", mock.Anything, 1, 3, specs.AssignRoleRequest{Role: "manager"}).Return(nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"message":"Role assigned successfully"}}`,
+		},
+		{
+			name:               "Fail_for_missing_role",
+			input:              `{"role":""}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"parameter missing : role "}`,
+		},
+		{
+			name:  "Fail_for_unknown_role",
+			input: `{"role":"intern"}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("AssignUserRole", mock.Anything, 1, 3, specs.AssignRoleRequest{Role: "intern"}).Return(errors.ErrRoleNotFound).Once()
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedResponse:   `{"error_code":404,"error_message":"role not found"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/users/3/role", bytes.NewBuffer([]byte(tt.input)))
+			req = req.WithContext(context.WithValue(req.Context(), constants.UserIDKey, 1.0))
+			req = mux.SetURLVars(req, map[string]string{"user_id": "3"})
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/api/handler"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListUsersHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.ListUsersHandler(context.Background(), mockService)
+	active := true
+
+	tests := []struct {
+		name               string
+		query              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_active_admins",
+			query: "?role=admin&is_active=true",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("ListUsers", mock.Anything, specs.ListUsersFilter{Role: constants.Admin, IsActive: &active}).Return(specs.ListUsersResponse{
+					Users: []specs.UserResponse{{ID: 1, Name: "Admin", Email: "admin@example.com", Role: constants.Admin, IsActive: true}},
+				}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"users":[{"id":1,"name":"Admin","email":"admin@example.com","role":"admin","is_active":true,"last_login_at":null,"created_at":"0001-01-01T00:00:00Z","updated_at":"0001-01-01T00:00:00Z"}]}}`,
+		},
+		{
+			name:               "Fail_for_invalid_is_active",
+			query:              "?is_active=maybe",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request data"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/users"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestCreateUserHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.CreateUserHandler(context.Background(), mockService)
+
+	tests := []struct {
+		name               string
+		input              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_create_user",
+			input: `{"name":"Jane","email":"Jane@Example.com","role":"recruiter"}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("CreateUser", mock.Anything, specs.CreateUserRequest{Name: "Jane", Email: "jane@example.com", Role: "recruiter"}).Return(7, nil).Once()
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedResponse:   `{"data":{"message":"User created successfully","user_id":7}}`,
+		},
+		{
+			name:               "Fail_for_invalid_email",
+			input:              `{"name":"Jane","email":"jane","role":"recruiter"}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"invalid request format : email "}`,
+		},
+		{
+			name:  "Fail_for_duplicate_email",
+			input: `{"name":"Jane","email":"jane@example.com","role":"recruiter"}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("CreateUser", mock.Anything, specs.CreateUserRequest{Name: "Jane", Email: "jane@example.com", Role: "recruiter"}).Return(0, errors.ErrDuplicateKey).Once()
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedResponse:   `{"error_code":409,"error_message":"record already exists"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/users", bytes.NewBuffer([]byte(tt.input)))
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestUpdateUserStatusHandler(t *testing.T) {
+	mockService := new(mocks.Service)
+	handlerFunc := handler.UpdateUserStatusHandler(context.Background(), mockService)
+	inactive := false
+
+	tests := []struct {
+		name               string
+		input              string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedResponse   string
+	}{
+		{
+			name:  "Success_for_deactivate_user",
+			input: `{"is_active":false}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("UpdateUserStatus", mock.Anything, 1, 3, specs.UpdateUserStatusRequest{IsActive: &inactive}).Return(nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   `{"data":{"message":"User status updated successfully","user_id":3}}`,
+		},
+		{
+			name:               "Fail_for_missing_status",
+			input:              `{}`,
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedResponse:   `{"error_code":400,"error_message":"parameter missing : is_active "}`,
+		},
+		{
+			name:  "Fail_for_last_admin",
+			input: `{"is_active":false}`,
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("UpdateUserStatus", mock.Anything, 1, 3, specs.UpdateUserStatusRequest{IsActive: &inactive}).Return(errors.ErrLastAdmin).Once()
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedResponse:   `{"error_code":409,"error_message":"` + errors.ErrLastAdmin.Error() + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup(mockService)
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/users/3/status", bytes.NewBuffer([]byte(tt.input)))
+			req = req.WithContext(context.WithValue(req.Context(), constants.UserIDKey, 1.0))
+			req = mux.SetURLVars(req, map[string]string{"user_id": "3"})
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(handlerFunc).ServeHTTP(rr, req)
+
+			if rr.Result().StatusCode != tt.expectedStatusCode {
+				t.Errorf("Expected %d but got %d", tt.expectedStatusCode, rr.Result().StatusCode)
+			}
+
+			if rr.Body.String() != tt.expectedResponse {
+				t.Errorf("Expected response body %s but got %s", tt.expectedResponse, rr.Body.String())
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/undefinedlabs/go-mpatch"
 )
 
@@ -47,7 +48,7 @@ func TestUserLoginHandler(t *testing.T) {
 				return json.Marshal(userInfo)
 			},
 			MockSetup: func(mockUserLoginService *mocks.Service, email string) {
-				mockUserLoginService.On("GenerateLoginToken", context.Background(), specs.UserInfoFilter{Email: TestEmail}).Return(specs.LoginResponse{
+				mockUserLoginService.On("GenerateLoginToken", mock.Anything, specs.UserInfoFilter{Email: TestEmail}).Return(specs.LoginResponse{
 					Token:     "valid_token",
 					ProfileID: 1,
 					Role:      "user",
@@ -108,7 +109,7 @@ func TestUserLoginHandler(t *testing.T) {
 				return json.Marshal(userInfo)
 			},
 			MockSetup: func(mockUserLoginService *mocks.Service, email string) {
-				mockUserLoginService.On("GenerateLoginToken", context.Background(), specs.UserInfoFilter{Email: TestEmail}).Return(specs.LoginResponse{}, errs.ErrNoRecordFound).Once()
+				mockUserLoginService.On("GenerateLoginToken", mock.Anything, specs.UserInfoFilter{Email: TestEmail}).Return(specs.LoginResponse{}, errs.ErrNoRecordFound).Once()
 			},
 			RequestBody:        specs.UserLoginRequest{AccessToken: "valid_access_token"},
 			ExpectedStatusCode: http.StatusUnauthorized,
@@ -122,7 +123,7 @@ func TestUserLoginHandler(t *testing.T) {
 				return json.Marshal(userInfo)
 			},
 			MockSetup: func(mockUserLoginService *mocks.Service, email string) {
-				mockUserLoginService.On("GenerateLoginToken", context.Background(), specs.UserInfoFilter{Email: TestEmail}).Return(specs.LoginResponse{}, errors.New("internal server error")).Once()
+				mockUserLoginService.On("GenerateLoginToken", mock.Anything, specs.UserInfoFilter{Email: TestEmail}).Return(specs.LoginResponse{}, errors.New("internal server error")).Once()
 			},
 			RequestBody:        specs.UserLoginRequest{AccessToken: "valid_access_token"},
 			ExpectedStatusCode: http.StatusInternalServerError,
@@ -219,7 +220,7 @@ func TestLogoutHandler(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			mockProfileService.On("RemoveToken", strings.TrimPrefix(test.AuthHeader, "Bearer ")).Return(test.MockRemoveToken(strings.TrimPrefix(test.AuthHeader, "Bearer "))).Once()
+			mockProfileService.On("RemoveToken", mock.Anything, strings.TrimPrefix(test.AuthHeader, "Bearer ")).Return(test.MockRemoveToken(strings.TrimPrefix(test.AuthHeader, "Bearer "))).Once()
 
 			req := httptest.NewRequest("POST", "/logout", nil)
 			if test.AuthHeader != "" {
@@ -21,7 +21,10 @@ type AchievementService interface {
 
 // CreateAchievement : Service layer function adds achievement details to a user profile.
 func (achSvc *service) CreateAchievement(ctx context.Context, cDetail specs.CreateAchievementRequest, profileID int, userID int) (ID int, err error) {
-	tx, _ := achSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := achSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := achSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -67,7 +70,10 @@ func (achSvc *service) CreateAchievement(ctx context.Context, cDetail specs.Crea
 
 // UpdateAchievement in the service layer update a achievements of specific profile.
 func (achSvc *service) UpdateAchievement(ctx context.Context, profileID int, achID int, userID int, req specs.UpdateAchievementRequest) (ID int, err error) {
-	tx, _ := achSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := achSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := achSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -95,7 +101,10 @@ func (achSvc *service) UpdateAchievement(ctx context.Context, profileID int, ach
 }
 
 func (achSvc *service) ListAchievements(ctx context.Context, profileID int, filter specs.ListAchievementFilter) (value []specs.AchievementResponse, err error) {
-	tx, _ := achSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := achSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return []specs.AchievementResponse{}, err
+	}
 	defer func() {
 		txErr := achSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -113,7 +122,10 @@ func (achSvc *service) ListAchievements(ctx context.Context, profileID int, filt
 }
 
 func (achSvc *service) DeleteAchievement(ctx context.Context, profileID, achievementID int) (err error) {
-	tx, _ := achSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := achSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
 	defer func() {
 		txErr := achSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"go.uber.org/zap"
+)
+
+// APIKeyService contains methods to issue the API keys used by other services and to authenticate their requests
+type APIKeyService interface {
+	ListAPIKeys(ctx context.Context) (specs.ListAPIKeysResponse, error)
+	CreateAPIKey(ctx context.Context, userID int, req specs.CreateAPIKeyRequest) (specs.APIKeySecretResponse, error)
+	RotateAPIKey(ctx context.Context, apiKeyID int) (specs.APIKeySecretResponse, error)
+	RevokeAPIKey(ctx context.Context, apiKeyID int) (err error)
+	AuthenticateAPIKey(ctx context.Context, key string, clientIP string) (specs.APIKeyPrincipal, error)
+}
+
+// ListAPIKeys returns every API key without the keys themselves
+func (apiKeySvc *service) ListAPIKeys(ctx context.Context) (values specs.ListAPIKeysResponse, err error) {
+	tx, err := apiKeySvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.ListAPIKeysResponse{}, err
+	}
+	defer func() {
+		txErr := apiKeySvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	apiKeys, err := apiKeySvc.APIKeyRepo.ListAPIKeys(ctx, tx)
+	if err != nil {
+		zap.S().Error("Unable to list API keys : ", err)
+		return specs.ListAPIKeysResponse{}, err
+	}
+
+	return specs.ListAPIKeysResponse{APIKeys: apiKeys}, nil
+}
+
+// CreateAPIKey issues a new API key. The key is returned only once; just its hash is stored.
+func (apiKeySvc *service) CreateAPIKey(ctx context.Context, userID int, req specs.CreateAPIKeyRequest) (value specs.APIKeySecretResponse, err error) {
+	tx, err := apiKeySvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.APIKeySecretResponse{}, err
+	}
+	defer func() {
+		txErr := apiKeySvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	key, prefix, keyHash, err := generateAPIKey()
+	if err != nil {
+		zap.S().Error("Unable to generate API key : ", err)
+		return specs.APIKeySecretResponse{}, err
+	}
+
+	now := helpers.GetCurrentISTTime()
+	apiKeyID, err := apiKeySvc.APIKeyRepo.CreateAPIKey(ctx, repository.APIKeyRepo{
+		Name:        req.Name,
+		Prefix:      prefix,
+		KeyHash:     keyHash,
+		Scopes:      req.Scopes,
+		AllowedIPs:  req.AllowedIPs,
+		ExpiresAt:   req.ExpiresAt,
+		CreatedByID: userID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to create API key %s : %v", req.Name, err)
+		return specs.APIKeySecretResponse{}, err
+	}
+
+	zap.S().Infof("API key %s created with id %d by user %d", req.Name, apiKeyID, userID)
+	return specs.APIKeySecretResponse{
+		Message:  "API key created successfully",
+		APIKeyID: apiKeyID,
+		Key:      key,
+	}, nil
+}
+
+// RotateAPIKey replaces the key of an API key. The previous key stops working immediately.
+func (apiKeySvc *service) RotateAPIKey(ctx context.Context, apiKeyID int) (value specs.APIKeySecretResponse, err error) {
+	tx, err := apiKeySvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.APIKeySecretResponse{}, err
+	}
+	defer func() {
+		txErr := apiKeySvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	apiKey, err := apiKeySvc.APIKeyRepo.GetAPIKey(ctx, apiKeyID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to get API key %d : %v", apiKeyID, err)
+		return specs.APIKeySecretResponse{}, err
+	}
+
+	if apiKey.RevokedAt != nil {
+		return specs.APIKeySecretResponse{}, errors.ErrAPIKeyRevoked
+	}
+
+	key, prefix, keyHash, err := generateAPIKey()
+	if err != nil {
+		zap.S().Error("Unable to generate API key : ", err)
+		return specs.APIKeySecretResponse{}, err
+	}
+
+	err = apiKeySvc.APIKeyRepo.RotateAPIKey(ctx, apiKeyID, prefix, keyHash, helpers.GetCurrentISTTime(), tx)
+	if err != nil {
+		zap.S().Errorf("Unable to rotate API key %d : %v", apiKeyID, err)
+		return specs.APIKeySecretResponse{}, err
+	}
+
+	zap.S().Infof("API key %s rotated", apiKey.Name)
+	return specs.APIKeySecretResponse{
+		Message:  "API key rotated successfully",
+		APIKeyID: apiKeyID,
+		Key:      key,
+	}, nil
+}
+
+// RevokeAPIKey permanently disables an API key
+func (apiKeySvc *service) RevokeAPIKey(ctx context.Context, apiKeyID int) (err error) {
+	ctx = helpers.WithAuditAction(ctx, constants.AuditActionStatusChange)
+
+	tx, err := apiKeySvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		txErr := apiKeySvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	apiKey, err := apiKeySvc.APIKeyRepo.GetAPIKey(ctx, apiKeyID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to get API key %d : %v", apiKeyID, err)
+		return err
+	}
+
+	if apiKey.RevokedAt != nil {
+		return errors.ErrAPIKeyRevoked
+	}
+
+	err = apiKeySvc.APIKeyRepo.RevokeAPIKey(ctx, apiKeyID, helpers.GetCurrentISTTime(), tx)
+	if err != nil {
+		zap.S().Errorf("Unable to revoke API key %d : %v", apiKeyID, err)
+		return err
+	}
+
+	zap.S().Infof("API key %s revoked", apiKey.Name)
+	return nil
+}
+
+// AuthenticateAPIKey returns the service identified by the key, provided the key is active and used from an allowed address
+func (apiKeySvc *service) AuthenticateAPIKey(ctx context.Context, key string, clientIP string) (specs.APIKeyPrincipal, error) {
+	prefix, ok := apiKeyPrefix(key)
+	if !ok {
+		return specs.APIKeyPrincipal{}, errors.ErrAPIKeyInvalid
+	}
+
+	credential, err := apiKeySvc.APIKeyRepo.GetAPIKeyCredential(ctx, prefix)
+	if err != nil {
+		if err == errors.ErrAPIKeyNotFound {
+			return specs.APIKeyPrincipal{}, errors.ErrAPIKeyInvalid
+		}
+		zap.S().Error("Unable to get API key credential : ", err)
+		return specs.APIKeyPrincipal{}, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashAPIKey(key)), []byte(credential.KeyHash)) != 1 {
+		return specs.APIKeyPrincipal{}, errors.ErrAPIKeyInvalid
+	}
+
+	now := time.Now()
+	if credential.RevokedAt != nil {
+		return specs.APIKeyPrincipal{}, errors.ErrAPIKeyRevoked
+	}
+
+	if credential.ExpiresAt != nil && !credential.ExpiresAt.After(now) {
+		return specs.APIKeyPrincipal{}, errors.ErrAPIKeyExpired
+	}
+
+	if !isIPAllowed(clientIP, credential.AllowedIPs) {
+		zap.S().Warnf("API key %s used from disallowed address %s", credential.Name, clientIP)
+		return specs.APIKeyPrincipal{}, errors.ErrAPIKeyIP
+	}
+
+	if credential.LastUsedAt == nil || now.Sub(*credential.LastUsedAt) > constants.APIKeyLastUsedInterval {
+		err = apiKeySvc.APIKeyRepo.UpdateAPIKeyLastUsed(ctx, credential.ID, now, clientIP)
+		if err != nil {
+			// failing to record the use of a key must not fail the request
+			zap.S().Errorf("Unable to record use of API key %s : %v", credential.Name, err)
+		}
+	}
+
+	return specs.APIKeyPrincipal{
+		ID:     credential.ID,
+		Name:   credential.Name,
+		Scopes: credential.Scopes,
+	}, nil
+}
+
+// generateAPIKey returns a new key of the form pbk_<prefix>_<secret> along with its prefix and hash
+func generateAPIKey() (key string, prefix string, keyHash string, err error) {
+	buf := make([]byte, 36)
+	_, err = rand.Read(buf)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	prefix = hex.EncodeToString(buf[:4])
+	key = fmt.Sprintf("%s_%s_%s", constants.APIKeyPrefix, prefix, hex.EncodeToString(buf[4:]))
+	return key, prefix, hashAPIKey(key), nil
+}
+
+// apiKeyPrefix extracts the prefix that identifies a key
+func apiKeyPrefix(key string) (string, bool) {
+	parts := strings.Split(key, "_")
+	if len(parts) != 3 || parts[0] != constants.APIKeyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// hashAPIKey returns the hex encoded SHA-256 hash of a key
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// isIPAllowed returns true if the address matches one of the allowed addresses or networks. An empty allowlist allows every address.
+func isIPAllowed(clientIP string, allowedIPs []string) bool {
+	if len(allowedIPs) == 0 {
+		return true
+	}
+
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+
+	for _, allowed := range allowedIPs {
+		if _, network, err := net.ParseCIDR(allowed); err == nil {
+			if network.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if allowedIP := net.ParseIP(allowed); allowedIP != nil && allowedIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// AuditService contains methods to query the audit log
+type AuditService interface {
+	ListAuditEvents(ctx context.Context, filter specs.ListAuditEventsFilter) (specs.ListAuditEventsResponse, error)
+}
+
+// ListAuditEvents returns a page of the audit log matching the filter
+func (auditSvc *service) ListAuditEvents(ctx context.Context, filter specs.ListAuditEventsFilter) (values specs.ListAuditEventsResponse, err error) {
+	tx, err := auditSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.ListAuditEventsResponse{}, err
+	}
+	defer func() {
+		txErr := auditSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	events, err := auditSvc.AuditRepo.ListAuditEvents(ctx, filter, tx)
+	if err != nil {
+		zap.S().Error("Unable to list audit events : ", err)
+		return specs.ListAuditEventsResponse{}, err
+	}
+
+	return specs.ListAuditEventsResponse{
+		Events: events,
+		Page:   filter.Page,
+		Limit:  filter.Limit,
+	}, nil
+}
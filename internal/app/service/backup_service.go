@@ -0,0 +1,753 @@
+package service
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"go.uber.org/zap"
+)
+
+// BackupService contains methods to back the profiles up to archives in BACKUP_DIR, to keep those archives in
+// check and to restore them
+type BackupService interface {
+	BackupAllProfiles(ctx context.Context) (specs.BackupReport, error)
+	CheckBackup(ctx context.Context, path string) (specs.BackupReport, error)
+	RestoreBackup(ctx context.Context, path string) (specs.BackupReport, error)
+	RotateBackups(ctx context.Context) ([]string, error)
+	ListBackups(ctx context.Context) (specs.ListBackupsResponse, error)
+	VerifyLatestBackup(ctx context.Context) (specs.BackupVerification, error)
+}
+
+// BackupAllProfiles writes every row of constants.BackupTables, as of the same moment, to a new archive in
+// BACKUP_DIR, along with its checksum file. The archive only appears under its name once it is complete.
+func (backupSvc *service) BackupAllProfiles(ctx context.Context) (report specs.BackupReport, err error) {
+	dir := os.Getenv(constants.BackupDirEnvVar)
+	if dir == "" {
+		return specs.BackupReport{}, errors.ErrBackupDirNotSet
+	}
+	err = os.MkdirAll(dir, constants.BackupDirMode)
+	if err != nil {
+		zap.S().Error("Unable to create the backup directory : ", err)
+		return specs.BackupReport{}, err
+	}
+
+	createdAt := time.Now()
+	name := constants.BackupFilePrefix + createdAt.Format(constants.BackupTimeFormat) + constants.BackupFileExt
+	if os.Getenv(constants.BackupCompressEnvVar) != "false" {
+		name += constants.BackupGzipExt
+	}
+	path := filepath.Join(dir, name)
+	partialPath := path + constants.BackupPartialExt
+	checksumPath := path + constants.BackupChecksumExt
+
+	file, err := os.OpenFile(partialPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, constants.BackupFileMode)
+	if err != nil {
+		zap.S().Error("Unable to create the backup archive : ", err)
+		return specs.BackupReport{}, err
+	}
+	defer func() {
+		if err != nil {
+			file.Close()
+			os.Remove(partialPath)
+			os.Remove(checksumPath)
+		}
+	}()
+
+	tx, err := backupSvc.BackupRepo.BeginSnapshot(ctx)
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+	defer func() {
+		txErr := backupSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil && err == nil {
+			err = txErr
+		}
+	}()
+
+	schemaVersion, err := backupSvc.BackupRepo.GetSchemaVersion(ctx, tx)
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+
+	hash := sha256.New()
+	buffered := bufio.NewWriter(io.MultiWriter(file, hash))
+	var out io.Writer = buffered
+	var gz *gzip.Writer
+	if filepath.Ext(name) == constants.BackupGzipExt {
+		gz = gzip.NewWriter(buffered)
+		out = gz
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+
+	err = encoder.Encode(specs.BackupHeader{
+		Format:        constants.BackupFormat,
+		Version:       constants.BackupFormatVersion,
+		CreatedAt:     createdAt.UTC(),
+		SchemaVersion: schemaVersion,
+		Tables:        constants.BackupTables,
+	})
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+
+	rowCounts := map[string]int{}
+	for _, table := range constants.BackupTables {
+		rowCounts[table], err = backupSvc.BackupRepo.ExportTableRows(ctx, table, tx, func(row json.RawMessage) error {
+			return encoder.Encode(specs.BackupEntry{Table: table, Row: row})
+		})
+		if err != nil {
+			zap.S().Errorf("Unable to back up %s : %v", table, err)
+			return specs.BackupReport{}, err
+		}
+	}
+
+	err = encoder.Encode(specs.BackupEntry{RowCounts: rowCounts})
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+	if gz != nil {
+		err = gz.Close()
+		if err != nil {
+			return specs.BackupReport{}, err
+		}
+	}
+	err = buffered.Flush()
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+	err = file.Sync()
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+	err = file.Close()
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	err = os.WriteFile(checksumPath, []byte(checksum+"  "+name+"\n"), constants.BackupFileMode)
+	if err != nil {
+		zap.S().Error("Unable to write the backup checksum : ", err)
+		return specs.BackupReport{}, err
+	}
+	err = os.Rename(partialPath, path)
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+
+	zap.S().Infow("Database backed up successfully", "fileName", path, "rowCounts", rowCounts)
+	return specs.BackupReport{
+		Path:          path,
+		SizeBytes:     info.Size(),
+		Checksum:      checksum,
+		CreatedAt:     createdAt.UTC(),
+		SchemaVersion: schemaVersion,
+		RowCounts:     rowCounts,
+	}, nil
+}
+
+// CheckBackup reads a backup archive through and returns what it holds, or an error wrapping ErrInvalidBackup if it
+// is not a complete archive. An archive with a checksum file must also match it. The database is not used.
+func (backupSvc *service) CheckBackup(ctx context.Context, path string) (specs.BackupReport, error) {
+	checksum, err := fileChecksum(path)
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+	want, err := readBackupChecksum(path)
+	if err != nil && !os.IsNotExist(err) {
+		return specs.BackupReport{}, fmt.Errorf("%w : %v", errors.ErrBackupChecksum, err)
+	}
+	if err == nil && checksum != want {
+		return specs.BackupReport{}, fmt.Errorf("%w : it is %s, the checksum file says %s", errors.ErrBackupChecksum, checksum, want)
+	}
+
+	header, rowCounts, err := readBackupArchive(path, nil, nil)
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+	report, err := backupReport(path, header, rowCounts)
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+	report.Checksum = checksum
+	return report, nil
+}
+
+// RestoreBackup loads a backup archive into a database at the same schema version whose tables are still empty.
+// Everything is loaded in one transaction, so nothing is kept unless the whole archive is valid and loads.
+func (backupSvc *service) RestoreBackup(ctx context.Context, path string) (report specs.BackupReport, err error) {
+	tx, err := backupSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+	defer func() {
+		txErr := backupSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+		}
+	}()
+
+	header, rowCounts, triggersDisabled, err := backupSvc.loadBackupArchive(ctx, path, true, tx)
+	if err != nil {
+		zap.S().Error("Unable to restore the backup : ", err)
+		return specs.BackupReport{}, err
+	}
+
+	for _, table := range header.Tables {
+		if slices.Contains(constants.BackupMergedTables, table) {
+			continue
+		}
+		err = backupSvc.BackupRepo.ResetTableIdentity(ctx, table, tx)
+		if err != nil {
+			return specs.BackupReport{}, err
+		}
+	}
+
+	report, err = backupReport(path, header, rowCounts)
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+	report.TriggersDisabled = triggersDisabled
+
+	zap.S().Infow("Backup restored successfully", "fileName", path, "rowCounts", rowCounts)
+	return report, nil
+}
+
+// RotateBackups deletes the archives in BACKUP_DIR, and their checksum files, that the retention policy set by
+// BACKUP_KEEP_DAILY, BACKUP_KEEP_WEEKLY and BACKUP_KEEP_MONTHLY does not keep. The latest archive is always kept.
+// It returns the names of the archives deleted.
+func (backupSvc *service) RotateBackups(ctx context.Context) ([]string, error) {
+	dir := os.Getenv(constants.BackupDirEnvVar)
+	if dir == "" {
+		return nil, errors.ErrBackupDirNotSet
+	}
+	archives, err := listBackupArchives(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keep := backupsToKeep(archives, backupRetentionFromEnv())
+	deleted := []string{}
+	for _, archive := range archives {
+		if keep[archive.name] {
+			continue
+		}
+		err = os.Remove(filepath.Join(dir, archive.name))
+		if err != nil {
+			zap.S().Errorf("Unable to delete the backup %s : %v", archive.name, err)
+			continue
+		}
+		err = os.Remove(filepath.Join(dir, archive.name+constants.BackupChecksumExt))
+		if err != nil && !os.IsNotExist(err) {
+			zap.S().Errorf("Unable to delete the checksum of the backup %s : %v", archive.name, err)
+		}
+		deleted = append(deleted, archive.name)
+	}
+
+	if len(deleted) > 0 {
+		zap.S().Infow("Old backups deleted", "fileNames", deleted)
+	}
+	return deleted, nil
+}
+
+// ListBackups lists the archives in BACKUP_DIR, the latest first, with their checksum and the outcome of their
+// latest verification
+func (backupSvc *service) ListBackups(ctx context.Context) (specs.ListBackupsResponse, error) {
+	dir := os.Getenv(constants.BackupDirEnvVar)
+	if dir == "" {
+		return specs.ListBackupsResponse{}, errors.ErrBackupDirNotSet
+	}
+	archives, err := listBackupArchives(dir)
+	if err != nil {
+		return specs.ListBackupsResponse{}, err
+	}
+
+	fileNames := make([]string, len(archives))
+	for i, archive := range archives {
+		fileNames[i] = archive.name
+	}
+	verifications, err := backupSvc.BackupRepo.ListLatestBackupVerifications(ctx, fileNames)
+	if err != nil {
+		return specs.ListBackupsResponse{}, err
+	}
+
+	backups := make([]specs.Backup, len(archives))
+	for i, archive := range archives {
+		backups[i] = specs.Backup{
+			FileName:           archive.name,
+			SizeBytes:          archive.size,
+			CreatedAt:          archive.createdAt,
+			VerificationStatus: constants.BackupVerificationUnverified,
+		}
+		backups[i].Checksum, _ = readBackupChecksum(filepath.Join(dir, archive.name))
+		if verification, ok := verifications[archive.name]; ok {
+			backups[i].VerificationStatus = verification.Status
+			backups[i].VerifiedAt = &verification.VerifiedAt
+			backups[i].VerificationError = verification.Error
+		}
+	}
+	return specs.ListBackupsResponse{Backups: backups}, nil
+}
+
+// VerifyLatestBackup checks the latest archive in BACKUP_DIR against its checksum and test restores it into the
+// scratch schema BackupVerifySchema, which is dropped again, and records the outcome. A verification that fails
+// is recorded and returned as failed; an error is only returned when the backup could not be verified at all.
+func (backupSvc *service) VerifyLatestBackup(ctx context.Context) (specs.BackupVerification, error) {
+	dir := os.Getenv(constants.BackupDirEnvVar)
+	if dir == "" {
+		return specs.BackupVerification{}, errors.ErrBackupDirNotSet
+	}
+	archives, err := listBackupArchives(dir)
+	if err != nil {
+		return specs.BackupVerification{}, err
+	}
+	if len(archives) == 0 {
+		return specs.BackupVerification{}, errors.ErrNoBackup
+	}
+
+	path := filepath.Join(dir, archives[0].name)
+	checksum, rowCounts, verifyErr := backupSvc.verifyBackup(ctx, path)
+
+	verification := repository.BackupVerificationRepo{
+		FileName:  archives[0].name,
+		Checksum:  checksum,
+		Status:    constants.BackupVerificationPassed,
+		RowCounts: rowCounts,
+	}
+	if verifyErr != nil {
+		verification.Status = constants.BackupVerificationFailed
+		verification.Error = verifyErr.Error()
+		verification.RowCounts = map[string]int{}
+		zap.S().Errorf("Backup %s failed verification : %v", archives[0].name, verifyErr)
+	}
+
+	created, err := backupSvc.BackupRepo.CreateBackupVerification(ctx, verification)
+	if err != nil {
+		zap.S().Error("Unable to record the backup verification : ", err)
+		return specs.BackupVerification{}, err
+	}
+	if verifyErr == nil {
+		zap.S().Infow("Backup verified successfully", "fileName", archives[0].name, "rowCounts", rowCounts)
+	}
+	return created, nil
+}
+
+// verifyBackup checks an archive against its checksum file and restores it into the scratch schema. It returns the
+// checksum of the archive and the rows restored of every table.
+func (backupSvc *service) verifyBackup(ctx context.Context, path string) (checksum string, rowCounts map[string]int, err error) {
+	checksum, err = fileChecksum(path)
+	if err != nil {
+		return "", nil, err
+	}
+	want, err := readBackupChecksum(path)
+	if err != nil {
+		return checksum, nil, fmt.Errorf("%w : %v", errors.ErrBackupChecksum, err)
+	}
+	if checksum != want {
+		return checksum, nil, fmt.Errorf("%w : it is %s, the checksum file says %s", errors.ErrBackupChecksum, checksum, want)
+	}
+
+	tx, err := backupSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return checksum, nil, err
+	}
+	defer func() {
+		txErr := backupSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+		}
+	}()
+
+	err = backupSvc.BackupRepo.CreateScratchSchema(ctx, constants.BackupVerifySchema, constants.BackupTables, tx)
+	if err != nil {
+		return checksum, nil, err
+	}
+	_, rowCounts, _, err = backupSvc.loadBackupArchive(ctx, path, false, tx)
+	if err != nil {
+		return checksum, nil, err
+	}
+	err = backupSvc.BackupRepo.DropScratchSchema(ctx, constants.BackupVerifySchema, tx)
+	if err != nil {
+		return checksum, nil, err
+	}
+	return checksum, rowCounts, nil
+}
+
+// loadBackupArchive loads an archive into the tables tx sees, after checking that the database is at the schema
+// version of the archive and the tables, other than constants.BackupMergedTables, are empty. It fails if a user is
+// left with a role that is not in the database. With disableTriggers the triggers of the tables are kept from
+// firing when the database user is allowed to; it returns whether they were.
+func (backupSvc *service) loadBackupArchive(ctx context.Context, path string, disableTriggers bool, tx pgx.Tx) (specs.BackupHeader, map[string]int, bool, error) {
+	triggersDisabled := false
+	checkTarget := func(header specs.BackupHeader) error {
+		schemaVersion, err := backupSvc.BackupRepo.GetSchemaVersion(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if schemaVersion != header.SchemaVersion {
+			return fmt.Errorf("%w : the backup is at %d and the database at %d", errors.ErrBackupSchemaMismatch, header.SchemaVersion, schemaVersion)
+		}
+
+		for _, table := range header.Tables {
+			if slices.Contains(constants.BackupMergedTables, table) {
+				continue
+			}
+			count, err := backupSvc.BackupRepo.CountTableRows(ctx, table, tx)
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				return fmt.Errorf("%w : %s has %d rows", errors.ErrRestoreTargetNotEmpty, table, count)
+			}
+		}
+
+		if !disableTriggers {
+			return nil
+		}
+		triggersDisabled, err = backupSvc.BackupRepo.DisableTriggers(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if !triggersDisabled {
+			zap.S().Warn("Restoring without superuser rights, the triggers of the restored tables will fire")
+		}
+		return nil
+	}
+	load := func(table string, rows []json.RawMessage) error {
+		return backupSvc.BackupRepo.ImportTableRows(ctx, table, rows, tx)
+	}
+
+	header, rowCounts, err := readBackupArchive(path, checkTarget, load)
+	if err != nil {
+		return specs.BackupHeader{}, nil, false, err
+	}
+
+	missingRoles, err := backupSvc.BackupRepo.ListMissingUserRoles(ctx, tx)
+	if err != nil {
+		return specs.BackupHeader{}, nil, false, err
+	}
+	if len(missingRoles) > 0 {
+		return specs.BackupHeader{}, nil, false, fmt.Errorf("%w : %s", errors.ErrBackupRolesMissing, strings.Join(missingRoles, ", "))
+	}
+	return header, rowCounts, triggersDisabled, nil
+}
+
+// readBackupArchive reads a backup archive, gzipped or not, checking it as it goes. checkHeader, if given, is called
+// with the header before any row is read, and load, if given, with the rows of a table in batches of
+// BackupImportBatch. It returns the header and the number of rows of every table, or an error wrapping
+// ErrInvalidBackup for an archive that is malformed, out of order or truncated.
+func readBackupArchive(path string, checkHeader func(header specs.BackupHeader) error, load func(table string, rows []json.RawMessage) error) (specs.BackupHeader, map[string]int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return specs.BackupHeader{}, nil, err
+	}
+	defer file.Close()
+
+	buffered := bufio.NewReader(file)
+	var in io.Reader = buffered
+	magic, err := buffered.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return specs.BackupHeader{}, nil, fmt.Errorf("%w : %v", errors.ErrInvalidBackup, err)
+		}
+		defer gz.Close()
+		in = gz
+	}
+	decoder := json.NewDecoder(in)
+
+	var header specs.BackupHeader
+	err = decoder.Decode(&header)
+	if err != nil {
+		return specs.BackupHeader{}, nil, fmt.Errorf("%w : unreadable header : %v", errors.ErrInvalidBackup, err)
+	}
+	err = checkBackupHeader(header)
+	if err != nil {
+		return specs.BackupHeader{}, nil, err
+	}
+	if checkHeader != nil {
+		err = checkHeader(header)
+		if err != nil {
+			return specs.BackupHeader{}, nil, err
+		}
+	}
+
+	rowCounts := map[string]int{}
+	var trailer map[string]int
+	tableIndex := 0
+	batch := []json.RawMessage{}
+	flush := func() error {
+		if load == nil || len(batch) == 0 {
+			batch = batch[:0]
+			return nil
+		}
+		err := load(header.Tables[tableIndex], batch)
+		batch = batch[:0]
+		return err
+	}
+
+	for {
+		var entry specs.BackupEntry
+		err = decoder.Decode(&entry)
+		if err == io.EOF {
+			return specs.BackupHeader{}, nil, fmt.Errorf("%w : the archive ends before its trailer", errors.ErrInvalidBackup)
+		}
+		if err != nil {
+			return specs.BackupHeader{}, nil, fmt.Errorf("%w : unreadable line : %v", errors.ErrInvalidBackup, err)
+		}
+
+		if entry.RowCounts != nil {
+			err = flush()
+			if err != nil {
+				return specs.BackupHeader{}, nil, err
+			}
+			err = checkBackupTrailer(header, entry.RowCounts, rowCounts)
+			if err != nil {
+				return specs.BackupHeader{}, nil, err
+			}
+			if decoder.More() {
+				return specs.BackupHeader{}, nil, fmt.Errorf("%w : data after the trailer", errors.ErrInvalidBackup)
+			}
+			trailer = entry.RowCounts
+			break
+		}
+
+		index := slices.Index(header.Tables, entry.Table)
+		if index < 0 {
+			return specs.BackupHeader{}, nil, fmt.Errorf("%w : row of unknown table %q", errors.ErrInvalidBackup, entry.Table)
+		}
+		if index < tableIndex {
+			return specs.BackupHeader{}, nil, fmt.Errorf("%w : row of %s after the rows of %s", errors.ErrInvalidBackup, entry.Table, header.Tables[tableIndex])
+		}
+		if len(entry.Row) == 0 || entry.Row[0] != '{' {
+			return specs.BackupHeader{}, nil, fmt.Errorf("%w : row %d of %s is not an object", errors.ErrInvalidBackup, rowCounts[entry.Table]+1, entry.Table)
+		}
+		if index > tableIndex {
+			err = flush()
+			if err != nil {
+				return specs.BackupHeader{}, nil, err
+			}
+			tableIndex = index
+		}
+
+		batch = append(batch, entry.Row)
+		rowCounts[entry.Table]++
+		if len(batch) >= constants.BackupImportBatch {
+			err = flush()
+			if err != nil {
+				return specs.BackupHeader{}, nil, err
+			}
+		}
+	}
+
+	return header, trailer, nil
+}
+
+// checkBackupTrailer checks that the rows read of every table are as many as the trailer says were written
+func checkBackupTrailer(header specs.BackupHeader, written map[string]int, read map[string]int) error {
+	if len(written) != len(header.Tables) {
+		return fmt.Errorf("%w : the trailer counts %d tables, the header lists %d", errors.ErrInvalidBackup, len(written), len(header.Tables))
+	}
+	for _, table := range header.Tables {
+		count, ok := written[table]
+		if !ok || count != read[table] {
+			return fmt.Errorf("%w : %s has %d rows, the trailer says %d", errors.ErrInvalidBackup, table, read[table], count)
+		}
+	}
+	return nil
+}
+
+// checkBackupHeader checks that an archive is a backup in a format this version can read, of known tables only
+func checkBackupHeader(header specs.BackupHeader) error {
+	if header.Format != constants.BackupFormat {
+		return fmt.Errorf("%w : not a %s archive", errors.ErrInvalidBackup, constants.BackupFormat)
+	}
+	if header.Version < 1 || header.Version > constants.BackupFormatVersion {
+		return fmt.Errorf("%w : format version %d is not supported", errors.ErrInvalidBackup, header.Version)
+	}
+	if len(header.Tables) == 0 {
+		return fmt.Errorf("%w : no tables", errors.ErrInvalidBackup)
+	}
+	for i, table := range header.Tables {
+		if !slices.Contains(constants.BackupTables, table) || slices.Index(header.Tables, table) != i {
+			return fmt.Errorf("%w : unexpected table %q", errors.ErrInvalidBackup, table)
+		}
+	}
+	return nil
+}
+
+// backupReport describes an archive that has been read through
+func backupReport(path string, header specs.BackupHeader, rowCounts map[string]int) (specs.BackupReport, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return specs.BackupReport{}, err
+	}
+
+	return specs.BackupReport{
+		Path:          path,
+		SizeBytes:     info.Size(),
+		CreatedAt:     header.CreatedAt,
+		SchemaVersion: header.SchemaVersion,
+		RowCounts:     rowCounts,
+	}, nil
+}
+
+// backupArchive is an archive in BACKUP_DIR
+type backupArchive struct {
+	name      string
+	size      int64
+	createdAt time.Time
+}
+
+// listBackupArchives lists the complete archives in dir, the latest first, going by the time in their name. A
+// directory that does not exist yet has none.
+func listBackupArchives(dir string) ([]backupArchive, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return []backupArchive{}, nil
+	}
+	if err != nil {
+		zap.S().Error("Unable to read the backup directory : ", err)
+		return nil, err
+	}
+
+	archives := []backupArchive{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.Type().IsRegular() || !strings.HasPrefix(name, constants.BackupFilePrefix) {
+			continue
+		}
+		stamp := strings.TrimPrefix(name, constants.BackupFilePrefix)
+		stamp, ok := strings.CutSuffix(strings.TrimSuffix(stamp, constants.BackupGzipExt), constants.BackupFileExt)
+		if !ok {
+			continue
+		}
+		createdAt, err := time.ParseInLocation(constants.BackupTimeFormat, stamp, time.Local)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		archives = append(archives, backupArchive{name: name, size: info.Size(), createdAt: createdAt})
+	}
+
+	slices.SortFunc(archives, func(a, b backupArchive) int {
+		return b.createdAt.Compare(a.createdAt)
+	})
+	return archives, nil
+}
+
+// backupRetention is how many days, weeks and months to keep the latest archive of
+type backupRetention struct {
+	daily   int
+	weekly  int
+	monthly int
+}
+
+// backupRetentionFromEnv reads the retention policy from BACKUP_KEEP_DAILY, BACKUP_KEEP_WEEKLY and
+// BACKUP_KEEP_MONTHLY
+func backupRetentionFromEnv() backupRetention {
+	count := func(envVar string, defaultCount int32) int {
+		value := helpers.ConvertStringToIntWithDefault(envVar, defaultCount)
+		if value < 0 {
+			value = defaultCount
+		}
+		return int(value)
+	}
+	return backupRetention{
+		daily:   count(constants.BackupKeepDailyEnvVar, constants.DefaultBackupKeepDaily),
+		weekly:  count(constants.BackupKeepWeeklyEnvVar, constants.DefaultBackupKeepWeekly),
+		monthly: count(constants.BackupKeepMonthlyEnvVar, constants.DefaultBackupKeepMonthly),
+	}
+}
+
+// backupsToKeep returns the names of the archives, listed the latest first, that the retention policy keeps: the
+// latest of each of the last days, weeks and months that have one, and the latest overall
+func backupsToKeep(archives []backupArchive, retention backupRetention) map[string]bool {
+	keep := map[string]bool{}
+	if len(archives) == 0 {
+		return keep
+	}
+	keep[archives[0].name] = true
+
+	periods := []struct {
+		count  int
+		period func(t time.Time) string
+	}{
+		{retention.daily, func(t time.Time) string { return t.Format(time.DateOnly) }},
+		{retention.weekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+		{retention.monthly, func(t time.Time) string { return t.Format("2006-01") }},
+	}
+	for _, p := range periods {
+		seen := map[string]bool{}
+		for _, archive := range archives {
+			if len(seen) >= p.count {
+				break
+			}
+			period := p.period(archive.createdAt)
+			if seen[period] {
+				continue
+			}
+			seen[period] = true
+			keep[archive.name] = true
+		}
+	}
+	return keep
+}
+
+// readBackupChecksum reads the checksum of an archive from its checksum file
+func readBackupChecksum(path string) (string, error) {
+	content, err := os.ReadFile(path + constants.BackupChecksumExt)
+	if err != nil {
+		return "", err
+	}
+	fields := strings.Fields(string(content))
+	if len(fields) == 0 || len(fields[0]) != sha256.Size*2 {
+		return "", fmt.Errorf("malformed checksum file %s", filepath.Base(path)+constants.BackupChecksumExt)
+	}
+	return strings.ToLower(fields[0]), nil
+}
+
+// fileChecksum returns the SHA-256 checksum of a file, in hex
+func fileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -21,7 +21,10 @@ type CertificateService interface {
 
 // CreateCerticate : Service layer function adds certicates details to a user profile.
 func (certificateSvc *service) CreateCertificate(ctx context.Context, cDetail specs.CreateCertificateRequest, profileID int, userID int) (ID int, err error) {
-	tx, _ := certificateSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := certificateSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := certificateSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -70,7 +73,10 @@ func (certificateSvc *service) CreateCertificate(ctx context.Context, cDetail sp
 
 // UpdateCertificate in the service layer update a certificates of specific profile.
 func (certificateSvc *service) UpdateCertificate(ctx context.Context, profileID int, certID int, userID int, req specs.UpdateCertificateRequest) (ID int, err error) {
-	tx, _ := certificateSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := certificateSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := certificateSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -102,7 +108,10 @@ func (certificateSvc *service) UpdateCertificate(ctx context.Context, profileID
 }
 
 func (certificateSvc *service) ListCertificates(ctx context.Context, profileID int, filter specs.ListCertificateFilter) (value []specs.CertificateResponse, err error) {
-	tx, _ := certificateSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := certificateSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return []specs.CertificateResponse{}, err
+	}
 	defer func() {
 		txErr := certificateSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -121,7 +130,10 @@ func (certificateSvc *service) ListCertificates(ctx context.Context, profileID i
 }
 
 func (certificateSvc *service) DeleteCertificate(ctx context.Context, profileID, certificateID int) (err error) {
-	tx, _ := certificateSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := certificateSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
 	defer func() {
 		txErr := certificateSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -21,7 +21,10 @@ type EducationService interface {
 
 // CreateEducation : Service layer function adds education details to a user profile.
 func (eduSvc *service) CreateEducation(ctx context.Context, eduDetail specs.CreateEducationRequest, profileID int, userID int) (ID int, err error) {
-	tx, _ := eduSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := eduSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := eduSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -69,7 +72,10 @@ func (eduSvc *service) CreateEducation(ctx context.Context, eduDetail specs.Crea
 
 // ListEducations in the service layer retrieves a education of specific profile.
 func (eduSvc *service) ListEducations(ctx context.Context, id int, filter specs.ListEducationsFilter) (value []specs.EducationResponse, err error) {
-	tx, _ := eduSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := eduSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return []specs.EducationResponse{}, err
+	}
 	defer func() {
 		txErr := eduSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -88,7 +94,10 @@ func (eduSvc *service) ListEducations(ctx context.Context, id int, filter specs.
 
 // UpdateEducation in the service layer update a education of specific profile.
 func (eduSvc *service) UpdateEducation(ctx context.Context, profileID int, eduID int, userID int, req specs.UpdateEducationRequest) (ID int, err error) {
-	tx, _ := eduSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := eduSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := eduSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -118,7 +127,10 @@ func (eduSvc *service) UpdateEducation(ctx context.Context, profileID int, eduID
 }
 
 func (eduSvc *service) DeleteEducation(ctx context.Context, profileID, educationID int) (err error) {
-	tx, _ := eduSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := eduSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
 	defer func() {
 		txErr := eduSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/notifier"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"go.uber.org/zap"
+)
+
+// EmailOutboxService contains methods to send the emails queued in the outbox and to look after them
+type EmailOutboxService interface {
+	SendOutboxEmails(ctx context.Context) (int, error)
+	ListOutboxEmails(ctx context.Context, filter specs.ListOutboxEmailsFilter) (specs.ListOutboxEmailsResponse, error)
+	GetOutboxEmail(ctx context.Context, id int64) (specs.OutboxEmail, error)
+	ResendOutboxEmail(ctx context.Context, id int64) (specs.OutboxEmail, error)
+}
+
+// SendOutboxEmails sends the emails that are due: new emails the server did not get to, failed emails whose retry
+// is due and emails abandoned while being sent. It returns how many it tried to send.
+func (outboxSvc *service) SendOutboxEmails(ctx context.Context) (int, error) {
+	emails, err := outboxSvc.EmailOutboxRepo.ClaimDueOutboxEmails(ctx, constants.EmailOutboxBatchSize, time.Now().UTC().Add(constants.EmailSendingLease))
+	if err != nil {
+		zap.S().Error("Unable to claim due emails : ", err)
+		return 0, err
+	}
+
+	for _, email := range emails {
+		outboxSvc.deliverOutboxEmail(ctx, email)
+	}
+	if len(emails) > 0 {
+		zap.S().Infof("Tried to send %d due emails", len(emails))
+	}
+	return len(emails), nil
+}
+
+// ListOutboxEmails returns the latest emails in the outbox, newest first
+func (outboxSvc *service) ListOutboxEmails(ctx context.Context, filter specs.ListOutboxEmailsFilter) (specs.ListOutboxEmailsResponse, error) {
+	emails, err := outboxSvc.EmailOutboxRepo.ListOutboxEmails(ctx, filter)
+	if err != nil {
+		zap.S().Error("Unable to list emails : ", err)
+		return specs.ListOutboxEmailsResponse{}, err
+	}
+
+	return specs.ListOutboxEmailsResponse{Emails: emails}, nil
+}
+
+// GetOutboxEmail returns an email in the outbox
+func (outboxSvc *service) GetOutboxEmail(ctx context.Context, id int64) (specs.OutboxEmail, error) {
+	email, err := outboxSvc.EmailOutboxRepo.GetOutboxEmail(ctx, id)
+	if err != nil {
+		zap.S().Errorf("Unable to get email %d : %v", id, err)
+		return specs.OutboxEmail{}, err
+	}
+
+	return email, nil
+}
+
+// ResendOutboxEmail makes a sent, failed or dead email pending again, with its attempts reset, and sends it in the
+// background.
+func (outboxSvc *service) ResendOutboxEmail(ctx context.Context, id int64) (specs.OutboxEmail, error) {
+	email, err := outboxSvc.EmailOutboxRepo.GetOutboxEmail(ctx, id)
+	if err != nil {
+		zap.S().Errorf("Unable to get email %d : %v", id, err)
+		return specs.OutboxEmail{}, err
+	}
+
+	requeued, err := outboxSvc.EmailOutboxRepo.RequeueOutboxEmail(ctx, id)
+	if err != nil {
+		zap.S().Errorf("Unable to requeue email %d : %v", id, err)
+		return specs.OutboxEmail{}, err
+	}
+	if !requeued {
+		return specs.OutboxEmail{}, errors.ErrEmailNotResendable
+	}
+
+	zap.S().Infof("Resending %s email %d to %s", email.Kind, id, email.Recipient)
+	email.Status = constants.EmailPending
+	email.Attempts = 0
+	email.LastError = ""
+	email.NextAttemptAt = time.Now().UTC()
+
+	outboxSvc.sendQueuedEmails(ctx, id)
+	return email, nil
+}
+
+// queueEmail renders the latest template of a kind of email for a recipient and adds the email to the outbox in tx,
+// so that it only goes out if tx commits. Once it has, the email should be handed to sendQueuedEmails; if it is
+// not, or sending fails, the outbox job sends it later.
+func (outboxSvc *service) queueEmail(ctx context.Context, kind string, to string, data specs.EmailTemplateData, profileID *int, tx pgx.Tx) (int64, error) {
+	msg, err := outboxSvc.renderEmail(ctx, kind, to, data)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := outboxSvc.EmailOutboxRepo.QueueEmail(ctx, repository.OutboxEmailRepo{
+		Kind:      kind,
+		Recipient: msg.To,
+		Subject:   msg.Subject,
+		Body:      msg.Body,
+		TextBody:  msg.TextBody,
+		ProfileID: profileID,
+	}, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to queue %s email for %s : %v", kind, msg.To, err)
+		return 0, err
+	}
+	return id, nil
+}
+
+// sendQueuedEmails sends queued emails in the background, outliving the request that queued them
+func (outboxSvc *service) sendQueuedEmails(ctx context.Context, ids ...int64) {
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		for _, id := range ids {
+			outboxSvc.sendOutboxEmail(ctx, id)
+		}
+	}()
+}
+
+// sendOutboxEmail claims and sends one email, unless it is no longer due
+func (outboxSvc *service) sendOutboxEmail(ctx context.Context, id int64) {
+	email, err := outboxSvc.EmailOutboxRepo.ClaimOutboxEmail(ctx, id, time.Now().UTC().Add(constants.EmailSendingLease))
+	if err != nil {
+		if err != errors.ErrNoRecordFound {
+			zap.S().Errorf("Unable to claim email %d : %v", id, err)
+		}
+		return
+	}
+
+	outboxSvc.deliverOutboxEmail(ctx, email)
+}
+
+// deliverOutboxEmail sends a claimed email and records the outcome. A failed email is retried later with a growing
+// backoff until it has been tried EMAIL_MAX_ATTEMPTS times, after which it is dead.
+func (outboxSvc *service) deliverOutboxEmail(ctx context.Context, email specs.OutboxEmail) {
+	err := outboxSvc.Notifier.Send(ctx, notifier.Message{To: email.Recipient, Subject: email.Subject, Body: email.Body, TextBody: email.TextBody})
+
+	now := time.Now().UTC()
+	outcome := repository.OutboxEmailResultRepo{Status: constants.EmailSent, NextAttemptAt: now, SentAt: &now}
+	if err != nil {
+		outcome.SentAt = nil
+		outcome.LastError = err.Error()
+		if email.Attempts >= emailMaxAttempts() {
+			outcome.Status = constants.EmailDead
+			zap.S().Errorf("Giving up on %s email %d to %s after %d attempts : %v", email.Kind, email.ID, email.Recipient, email.Attempts, err)
+		} else {
+			outcome.Status = constants.EmailFailed
+			outcome.NextAttemptAt = now.Add(helpers.RetryBackoff(email.Attempts, constants.EmailRetryBackoff, constants.MaxEmailRetryBackoff))
+			zap.S().Warnf("Unable to send %s email %d to %s, retrying at %s : %v", email.Kind, email.ID, email.Recipient, outcome.NextAttemptAt, err)
+		}
+	}
+
+	finished, err := outboxSvc.EmailOutboxRepo.FinishOutboxEmail(ctx, email.ID, email.Attempts, outcome)
+	if err != nil {
+		zap.S().Errorf("Unable to record the outcome of email %d : %v", email.ID, err)
+		return
+	}
+	if !finished {
+		zap.S().Warnf("Email %d was claimed again before the outcome of attempt %d was recorded", email.ID, email.Attempts)
+	}
+}
+
+// emailMaxAttempts returns the number of times an email is tried before it is dead
+func emailMaxAttempts() int {
+	return int(helpers.ConvertStringToIntWithDefault(constants.EmailMaxAttemptsEnvVar, constants.DefaultEmailMaxAttempts))
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/notifier"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"go.uber.org/zap"
+)
+
+// EmailTemplateService contains methods to view, edit and preview the templates emails are rendered with
+type EmailTemplateService interface {
+	ListEmailTemplates(ctx context.Context) (specs.ListEmailTemplatesResponse, error)
+	GetEmailTemplate(ctx context.Context, kind string) (specs.EmailTemplate, error)
+	ListEmailTemplateVersions(ctx context.Context, kind string) (specs.ListEmailTemplatesResponse, error)
+	UpdateEmailTemplate(ctx context.Context, kind string, userID int, req specs.UpdateEmailTemplateRequest) (specs.EmailTemplate, error)
+	PreviewEmailTemplate(ctx context.Context, kind string, req specs.PreviewEmailTemplateRequest) (specs.EmailPreviewResponse, error)
+}
+
+// sampleEmailData is what templates are checked and previewed with when no profile is given
+var sampleEmailData = specs.EmailTemplateData{
+	Name:                 "Jane Doe",
+	Email:                "jane.doe@example.com",
+	EmployeeName:         "John Smith",
+	ProfileID:            1,
+	LastUpdatedAt:        "01 Jan 2024",
+	LatestProjectEndedAt: "30 Jun 2023",
+	Digest: &specs.ProfileDigest{
+		From:               "01 Jul 2024",
+		To:                 "08 Jul 2024",
+		StaleProfiles:      []specs.DigestProfile{{ProfileID: 1, Name: "John Smith", Email: "john.smith@example.com", Note: "last updated 01 Jan 2024"}},
+		IncompleteProfiles: []specs.DigestProfile{{ProfileID: 2, Name: "Jane Doe", Email: "jane.doe@example.com", Note: "invited 01 Jul 2024, expires 15 Jul 2024"}},
+		CompletedProfiles:  []specs.DigestProfile{{ProfileID: 3, Name: "Sam Lee", Email: "sam.lee@example.com", Note: "completed 03 Jul 2024"}},
+	},
+}
+
+// ListEmailTemplates returns the latest version of the template of every kind of email
+func (templateSvc *service) ListEmailTemplates(ctx context.Context) (specs.ListEmailTemplatesResponse, error) {
+	templates, err := templateSvc.EmailTemplateRepo.ListEmailTemplates(ctx)
+	if err != nil {
+		zap.S().Error("Unable to list email templates : ", err)
+		return specs.ListEmailTemplatesResponse{}, err
+	}
+
+	return specs.ListEmailTemplatesResponse{Templates: templates}, nil
+}
+
+// GetEmailTemplate returns the latest version of the template of a kind of email
+func (templateSvc *service) GetEmailTemplate(ctx context.Context, kind string) (specs.EmailTemplate, error) {
+	if !constants.EmailKinds[kind] {
+		return specs.EmailTemplate{}, errors.ErrEmailTemplateNotFound
+	}
+
+	template, err := templateSvc.EmailTemplateRepo.GetEmailTemplate(ctx, kind)
+	if err != nil {
+		zap.S().Errorf("Unable to get the %s email template : %v", kind, err)
+		return specs.EmailTemplate{}, err
+	}
+	return template, nil
+}
+
+// ListEmailTemplateVersions returns every version of the template of a kind of email, latest first
+func (templateSvc *service) ListEmailTemplateVersions(ctx context.Context, kind string) (specs.ListEmailTemplatesResponse, error) {
+	if !constants.EmailKinds[kind] {
+		return specs.ListEmailTemplatesResponse{}, errors.ErrEmailTemplateNotFound
+	}
+
+	templates, err := templateSvc.EmailTemplateRepo.ListEmailTemplateVersions(ctx, kind)
+	if err != nil {
+		zap.S().Errorf("Unable to list the versions of the %s email template : %v", kind, err)
+		return specs.ListEmailTemplatesResponse{}, err
+	}
+	return specs.ListEmailTemplatesResponse{Templates: templates}, nil
+}
+
+// UpdateEmailTemplate saves a new version of the template of a kind of email, which is used for every email of
+// the kind from then on. The template is rendered with sample data first, so that one that does not parse or
+// uses unknown fields is refused with ErrInvalidEmailTemplate.
+func (templateSvc *service) UpdateEmailTemplate(ctx context.Context, kind string, userID int, req specs.UpdateEmailTemplateRequest) (specs.EmailTemplate, error) {
+	if !constants.EmailKinds[kind] {
+		return specs.EmailTemplate{}, errors.ErrEmailTemplateNotFound
+	}
+
+	template := specs.EmailTemplate{Kind: kind, Subject: req.Subject, HTMLBody: req.HTMLBody, TextBody: req.TextBody}
+	_, err := renderEmailTemplate(template, withEmailLinks(sampleEmailData))
+	if err != nil {
+		return specs.EmailTemplate{}, err
+	}
+
+	created, err := templateSvc.EmailTemplateRepo.CreateEmailTemplateVersion(ctx, repository.EmailTemplateRepo{
+		Kind:        kind,
+		Subject:     req.Subject,
+		HTMLBody:    req.HTMLBody,
+		TextBody:    req.TextBody,
+		CreatedByID: userID,
+	})
+	if err != nil {
+		zap.S().Errorf("Unable to save the %s email template : %v", kind, err)
+		return specs.EmailTemplate{}, err
+	}
+
+	zap.S().Infof("Version %d of the %s email template saved by user %d", created.Version, kind, userID)
+	return created, nil
+}
+
+// PreviewEmailTemplate renders the latest template of a kind of email, or the parts of a template given in req, for
+// the profile in req or else a sample profile. Nothing is saved or sent.
+func (templateSvc *service) PreviewEmailTemplate(ctx context.Context, kind string, req specs.PreviewEmailTemplateRequest) (specs.EmailPreviewResponse, error) {
+	template, err := templateSvc.GetEmailTemplate(ctx, kind)
+	if err != nil {
+		return specs.EmailPreviewResponse{}, err
+	}
+	if req.Subject != "" {
+		template.Subject = req.Subject
+	}
+	if req.HTMLBody != "" {
+		template.HTMLBody = req.HTMLBody
+	}
+	if req.TextBody != "" {
+		template.TextBody = req.TextBody
+	}
+
+	data := sampleEmailData
+	if req.ProfileID != 0 {
+		profile, err := templateSvc.GetProfile(ctx, req.ProfileID)
+		if err != nil {
+			return specs.EmailPreviewResponse{}, err
+		}
+		data = specs.EmailTemplateData{Name: profile.Name, Email: profile.Email, EmployeeName: profile.Name, ProfileID: profile.ProfileID, Digest: sampleEmailData.Digest}
+	}
+	data = withEmailLinks(data)
+
+	msg, err := renderEmailTemplate(template, data)
+	if err != nil {
+		return specs.EmailPreviewResponse{}, err
+	}
+	return specs.EmailPreviewResponse{Subject: msg.Subject, HTMLBody: msg.Body, TextBody: msg.TextBody, Data: data}, nil
+}
+
+// renderEmail renders the latest template of a kind of email for a recipient
+func (templateSvc *service) renderEmail(ctx context.Context, kind string, to string, data specs.EmailTemplateData) (notifier.Message, error) {
+	template, err := templateSvc.EmailTemplateRepo.GetEmailTemplate(ctx, kind)
+	if err != nil {
+		zap.S().Errorf("Unable to get the %s email template : %v", kind, err)
+		return notifier.Message{}, err
+	}
+
+	msg, err := renderEmailTemplate(template, withEmailLinks(data))
+	if err != nil {
+		zap.S().Errorf("Unable to render version %d of the %s email template : %v", template.Version, kind, err)
+		return notifier.Message{}, err
+	}
+	msg.To = to
+	return msg, nil
+}
+
+// withEmailLinks fills in the links to the Profile Builder UI that templates may use
+func withEmailLinks(data specs.EmailTemplateData) specs.EmailTemplateData {
+	if data.ProfileID != 0 {
+		data.ProfileLink = helpers.ProfileLink(data.ProfileID)
+	}
+	data.LoginLink = helpers.LoginLink()
+	return data
+}
+
+// renderEmailTemplate renders the subject and plain-text body as text and the HTML body as HTML, so that values
+// such as names are escaped in it. Errors wrap ErrInvalidEmailTemplate.
+func renderEmailTemplate(template specs.EmailTemplate, data specs.EmailTemplateData) (notifier.Message, error) {
+	subject, err := renderText("subject", template.Subject, data)
+	if err != nil {
+		return notifier.Message{}, err
+	}
+
+	textBody, err := renderText("text_body", template.TextBody, data)
+	if err != nil {
+		return notifier.Message{}, err
+	}
+
+	tmpl, err := htmltemplate.New("html_body").Parse(template.HTMLBody)
+	if err != nil {
+		return notifier.Message{}, fmt.Errorf("%w : %v", errors.ErrInvalidEmailTemplate, err)
+	}
+	var htmlBody bytes.Buffer
+	err = tmpl.Execute(&htmlBody, data)
+	if err != nil {
+		return notifier.Message{}, fmt.Errorf("%w : %v", errors.ErrInvalidEmailTemplate, err)
+	}
+
+	// a subject is a single header line
+	subject = strings.Join(strings.Fields(subject), " ")
+	return notifier.Message{Subject: subject, Body: htmlBody.String(), TextBody: textBody}, nil
+}
+
+// renderText renders a plain-text part of an email template
+func renderText(name string, text string, data specs.EmailTemplateData) (string, error) {
+	tmpl, err := texttemplate.New(name).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("%w : %v", errors.ErrInvalidEmailTemplate, err)
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, data)
+	if err != nil {
+		return "", fmt.Errorf("%w : %v", errors.ErrInvalidEmailTemplate, err)
+	}
+	return buf.String(), nil
+}
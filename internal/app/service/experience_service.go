@@ -21,7 +21,10 @@ type ExperienceService interface {
 
 // CreateExperience : Service layer function adds experiences details to a user profile.
 func (expSvc *service) CreateExperience(ctx context.Context, expDetail specs.CreateExperienceRequest, profileID int, userID int) (ID int, err error) {
-	tx, _ := expSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := expSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := expSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -69,7 +72,10 @@ func (expSvc *service) CreateExperience(ctx context.Context, expDetail specs.Cre
 
 // ListExperiences in the service layer retrieves a experiences of specific profile.
 func (expSvc *service) ListExperiences(ctx context.Context, id int, filter specs.ListExperiencesFilter) (values []specs.ExperienceResponse, err error) {
-	tx, _ := expSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := expSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return []specs.ExperienceResponse{}, err
+	}
 	defer func() {
 		txErr := expSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -88,7 +94,10 @@ func (expSvc *service) ListExperiences(ctx context.Context, id int, filter specs
 
 // UpdateExperience in the service layer update a experience of specific profile.
 func (expSvc *service) UpdateExperience(ctx context.Context, profileID int, expID int, userID int, req specs.UpdateExperienceRequest) (ID int, err error) {
-	tx, _ := expSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := expSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := expSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -118,7 +127,10 @@ func (expSvc *service) UpdateExperience(ctx context.Context, profileID int, expI
 }
 
 func (expSvc *service) DeleteExperience(ctx context.Context, profileID, experienceID int) (err error) {
-	tx, _ := expSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := expSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
 	defer func() {
 		txErr := expSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
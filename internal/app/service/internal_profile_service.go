@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// InternalProfileService contains the read-only profile methods served to other services
+type InternalProfileService interface {
+	GetInternalProfile(ctx context.Context, employeeID string) (specs.InternalProfileV1, error)
+	ResolveEmployeeIDs(ctx context.Context, req specs.ResolveEmployeesRequest) (specs.ResolveEmployeesResponseV1, error)
+	ListProfileChanges(ctx context.Context, filter specs.ListProfileChangesFilter) (specs.ProfileChangesResponseV1, error)
+}
+
+// GetInternalProfile returns the full profile of an employee along with all of its sections
+func (internalSvc *service) GetInternalProfile(ctx context.Context, employeeID string) (value specs.InternalProfileV1, err error) {
+	tx, err := internalSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.InternalProfileV1{}, err
+	}
+	defer func() {
+		txErr := internalSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	profileID, err := internalSvc.ProfileRepo.GetProfileIDByEmployeeID(ctx, employeeID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to resolve employee ID %s : %v", employeeID, err)
+		return specs.InternalProfileV1{}, err
+	}
+
+	profile, err := internalSvc.ProfileRepo.GetProfile(ctx, profileID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to get profile %d : %v", profileID, err)
+		return specs.InternalProfileV1{}, err
+	}
+
+	value = specs.InternalProfileV1{
+		ProfileID:         profileID,
+		EmployeeID:        profile.EmployeeID,
+		Name:              profile.Name,
+		Email:             profile.Email,
+		Gender:            profile.Gender,
+		Mobile:            profile.Mobile,
+		Designation:       profile.Designation,
+		Description:       profile.Description,
+		Title:             profile.Title,
+		YearsOfExperience: profile.YearsOfExperience,
+		PrimarySkills:     profile.PrimarySkills,
+		SecondarySkills:   profile.SecondarySkills,
+		GithubLink:        profile.GithubLink,
+		LinkedinLink:      profile.LinkedinLink,
+		CareerObjectives:  profile.CareerObjectives,
+		Educations:        []specs.InternalEducationV1{},
+		Certificates:      []specs.InternalCertificateV1{},
+		Projects:          []specs.InternalProjectV1{},
+		Experiences:       []specs.InternalExperienceV1{},
+		Achievements:      []specs.InternalAchievementV1{},
+	}
+	if profile.JoshJoiningDate.Valid {
+		value.JoshJoiningDate = &profile.JoshJoiningDate.String
+	}
+
+	educations, err := internalSvc.EducationRepo.ListEducations(ctx, profileID, specs.ListEducationsFilter{}, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to list educations of profile %d : %v", profileID, err)
+		return specs.InternalProfileV1{}, err
+	}
+	for _, education := range educations {
+		value.Educations = append(value.Educations, specs.InternalEducationV1{
+			ID:               education.ID,
+			Degree:           education.Degree,
+			UniversityName:   education.UniversityName,
+			Place:            education.Place,
+			PercentageOrCgpa: education.PercentageOrCgpa,
+			PassingYear:      education.PassingYear,
+		})
+	}
+
+	certificates, err := internalSvc.CertificateRepo.ListCertificates(ctx, profileID, specs.ListCertificateFilter{}, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to list certificates of profile %d : %v", profileID, err)
+		return specs.InternalProfileV1{}, err
+	}
+	for _, certificate := range certificates {
+		value.Certificates = append(value.Certificates, specs.InternalCertificateV1{
+			ID:               certificate.ID,
+			Name:             certificate.Name,
+			OrganizationName: certificate.OrganizationName,
+			Description:      certificate.Description,
+			IssuedDate:       certificate.IssuedDate,
+			FromDate:         certificate.FromDate,
+			ToDate:           certificate.ToDate,
+		})
+	}
+
+	projects, err := internalSvc.ProjectRepo.ListProjects(ctx, profileID, specs.ListProjectsFilter{}, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to list projects of profile %d : %v", profileID, err)
+		return specs.InternalProfileV1{}, err
+	}
+	for _, project := range projects {
+		value.Projects = append(value.Projects, specs.InternalProjectV1{
+			ID:               project.ID,
+			Name:             project.Name,
+			Description:      project.Description,
+			Role:             project.Role,
+			Responsibilities: project.Responsibilities,
+			Technologies:     project.Technologies,
+			TechWorkedOn:     project.TechWorkedOn,
+			WorkingStartDate: project.WorkingStartDate,
+			WorkingEndDate:   project.WorkingEndDate,
+			Duration:         project.Duration,
+		})
+	}
+
+	experiences, err := internalSvc.ExperienceRepo.ListExperiences(ctx, profileID, specs.ListExperiencesFilter{}, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to list experiences of profile %d : %v", profileID, err)
+		return specs.InternalProfileV1{}, err
+	}
+	for _, experience := range experiences {
+		value.Experiences = append(value.Experiences, specs.InternalExperienceV1{
+			ID:          experience.ID,
+			Designation: experience.Designation,
+			CompanyName: experience.CompanyName,
+			FromDate:    experience.FromDate,
+			ToDate:      experience.ToDate,
+		})
+	}
+
+	achievements, err := internalSvc.AchievementRepo.ListAchievements(ctx, profileID, specs.ListAchievementFilter{}, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to list achievements of profile %d : %v", profileID, err)
+		return specs.InternalProfileV1{}, err
+	}
+	for _, achievement := range achievements {
+		value.Achievements = append(value.Achievements, specs.InternalAchievementV1{
+			ID:          achievement.ID,
+			Name:        achievement.Name,
+			Description: achievement.Description,
+		})
+	}
+
+	return value, nil
+}
+
+// ResolveEmployeeIDs resolves many employee ids to their profile ids, in the order they were requested
+func (internalSvc *service) ResolveEmployeeIDs(ctx context.Context, req specs.ResolveEmployeesRequest) (value specs.ResolveEmployeesResponseV1, err error) {
+	tx, err := internalSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.ResolveEmployeesResponseV1{}, err
+	}
+	defer func() {
+		txErr := internalSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	profileIDs, err := internalSvc.ProfileRepo.GetProfileIDsByEmployeeIDs(ctx, req.EmployeeIDs, tx)
+	if err != nil {
+		zap.S().Error("Unable to resolve employee IDs : ", err)
+		return specs.ResolveEmployeesResponseV1{}, err
+	}
+
+	value = specs.ResolveEmployeesResponseV1{
+		Profiles: []specs.ResolvedEmployeeV1{},
+		NotFound: []string{},
+	}
+	for _, employeeID := range req.EmployeeIDs {
+		profileID, ok := profileIDs[employeeID]
+		if !ok {
+			value.NotFound = append(value.NotFound, employeeID)
+			continue
+		}
+		value.Profiles = append(value.Profiles, specs.ResolvedEmployeeV1{EmployeeID: employeeID, ProfileID: profileID})
+	}
+
+	return value, nil
+}
+
+// ListProfileChanges returns a page of the profiles changed or deleted after the position in the filter. Changes
+// younger than ProfileChangesLag are left for a later page, so the position returned never passes a change that has
+// yet to commit.
+func (internalSvc *service) ListProfileChanges(ctx context.Context, filter specs.ListProfileChangesFilter) (value specs.ProfileChangesResponseV1, err error) {
+	tx, err := internalSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.ProfileChangesResponseV1{}, err
+	}
+	defer func() {
+		txErr := internalSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	// one extra change is fetched to tell whether another page follows
+	pageFilter := filter
+	pageFilter.Limit = filter.Limit + 1
+	pageFilter.Lag = constants.ProfileChangesLag
+	changes, err := internalSvc.ProfileRepo.ListProfileChanges(ctx, pageFilter, tx)
+	if err != nil {
+		zap.S().Error("Unable to list profile changes : ", err)
+		return specs.ProfileChangesResponseV1{}, err
+	}
+
+	value = specs.ProfileChangesResponseV1{
+		Changes:     changes,
+		NextSince:   filter.Since,
+		NextAfterID: filter.AfterID,
+	}
+	if len(changes) > filter.Limit {
+		value.Changes = changes[:filter.Limit]
+		value.HasMore = true
+	}
+	if len(value.Changes) > 0 {
+		last := value.Changes[len(value.Changes)-1]
+		value.NextSince = last.ChangedAt
+		value.NextAfterID = last.ProfileID
+	}
+
+	return value, nil
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// IntranetCacheService contains methods to inspect and invalidate the cache of intranet lookups
+type IntranetCacheService interface {
+	GetIntranetCacheStats(ctx context.Context) specs.IntranetCacheStats
+	InvalidateIntranetCache(ctx context.Context, employeeID string)
+}
+
+// GetIntranetCacheStats reports how often intranet lookups were answered from cache
+func (cacheSvc *service) GetIntranetCacheStats(ctx context.Context) specs.IntranetCacheStats {
+	cachingClient, ok := cacheSvc.IntranetClient.(intranet.CachingClient)
+	if !ok {
+		return specs.IntranetCacheStats{}
+	}
+	return cachingClient.Stats()
+}
+
+// InvalidateIntranetCache forgets the cached lookups of an employee, or every cached lookup when employeeID is empty
+func (cacheSvc *service) InvalidateIntranetCache(ctx context.Context, employeeID string) {
+	cachingClient, ok := cacheSvc.IntranetClient.(intranet.CachingClient)
+	if !ok {
+		return
+	}
+
+	if employeeID == "" {
+		cachingClient.InvalidateAll()
+		zap.S().Info("Intranet cache cleared")
+		return
+	}
+	cachingClient.Invalidate(employeeID)
+	zap.S().Info("Intranet cache invalidated for employee id : ", employeeID)
+}
@@ -0,0 +1,856 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"go.uber.org/zap"
+)
+
+// IntranetSyncService contains methods to keep profiles in step with the intranet
+type IntranetSyncService interface {
+	SyncEmployees(ctx context.Context, opts specs.SyncEmployeesOptions) (specs.SyncEmployeesReport, error)
+	ListProfileSyncChanges(ctx context.Context, profileID int) (specs.ListProfileSyncChangesResponse, error)
+	RunEmployeeSync(ctx context.Context, trigger string, triggeredByID int, opts specs.SyncEmployeesOptions) (specs.SyncRun, specs.SyncEmployeesReport, error)
+	StartEmployeeSync(ctx context.Context, trigger string, triggeredByID int, opts specs.SyncEmployeesOptions) (specs.SyncRun, error)
+	ListSyncRuns(ctx context.Context, limit int) (specs.ListSyncRunsResponse, error)
+	GetSyncRun(ctx context.Context, runID int64) (specs.SyncRun, error)
+}
+
+// intranetSyncField describes how a profile field is compared with, and taken from, an intranet employee
+type intranetSyncField struct {
+	column        string
+	profileValue  func(profile specs.ResponseProfile) string
+	intranetValue func(employee specs.IntranetEmployee) string
+	columnValue   func(employee specs.IntranetEmployee) interface{}
+}
+
+// intranetSyncFields lists every profile field that can be synced from the intranet
+var intranetSyncFields = []intranetSyncField{
+	{
+		column:        "name",
+		profileValue:  func(profile specs.ResponseProfile) string { return profile.Name },
+		intranetValue: func(employee specs.IntranetEmployee) string { return employee.Name },
+		columnValue:   func(employee specs.IntranetEmployee) interface{} { return employee.Name },
+	},
+	{
+		column:        "gender",
+		profileValue:  func(profile specs.ResponseProfile) string { return profile.Gender },
+		intranetValue: func(employee specs.IntranetEmployee) string { return employee.Gender },
+		columnValue:   func(employee specs.IntranetEmployee) interface{} { return employee.Gender },
+	},
+	{
+		column:        "mobile",
+		profileValue:  func(profile specs.ResponseProfile) string { return profile.Mobile },
+		intranetValue: func(employee specs.IntranetEmployee) string { return employee.MobileNumber },
+		columnValue:   func(employee specs.IntranetEmployee) interface{} { return employee.MobileNumber },
+	},
+	{
+		column:        "designation",
+		profileValue:  func(profile specs.ResponseProfile) string { return profile.Designation },
+		intranetValue: func(employee specs.IntranetEmployee) string { return employee.Designation },
+		columnValue:   func(employee specs.IntranetEmployee) interface{} { return employee.Designation },
+	},
+	{
+		column:        "josh_joining_date",
+		profileValue:  func(profile specs.ResponseProfile) string { return profile.JoshJoiningDate.String },
+		intranetValue: func(employee specs.IntranetEmployee) string { return employee.JoshDOJ },
+		columnValue:   func(employee specs.IntranetEmployee) interface{} { return employee.JoshDOJ },
+	},
+	{
+		column:       "years_of_experience",
+		profileValue: func(profile specs.ResponseProfile) string { return formatYearsOfExperience(profile.YearsOfExperience) },
+		intranetValue: func(employee specs.IntranetEmployee) string {
+			return formatYearsOfExperience(employee.YearsOfExperience)
+		},
+		columnValue: func(employee specs.IntranetEmployee) interface{} { return employee.YearsOfExperience },
+	},
+	{
+		column:       "primary_skills",
+		profileValue: func(profile specs.ResponseProfile) string { return strings.Join(profile.PrimarySkills, ", ") },
+		intranetValue: func(employee specs.IntranetEmployee) string {
+			return strings.Join(splitIntranetSkills(employee.PrimarySkill), ", ")
+		},
+		columnValue: func(employee specs.IntranetEmployee) interface{} { return splitIntranetSkills(employee.PrimarySkill) },
+	},
+	{
+		column:       "secondary_skills",
+		profileValue: func(profile specs.ResponseProfile) string { return strings.Join(profile.SecondarySkills, ", ") },
+		intranetValue: func(employee specs.IntranetEmployee) string {
+			return strings.Join(splitIntranetSkills(employee.SecondarySkill), ", ")
+		},
+		columnValue: func(employee specs.IntranetEmployee) interface{} { return splitIntranetSkills(employee.SecondarySkill) },
+	},
+	{
+		column:        "linkedin_link",
+		profileValue:  func(profile specs.ResponseProfile) string { return profile.LinkedinLink },
+		intranetValue: func(employee specs.IntranetEmployee) string { return employee.LinkedinURL },
+		columnValue:   func(employee specs.IntranetEmployee) interface{} { return employee.LinkedinURL },
+	},
+	{
+		column:        "github_link",
+		profileValue:  func(profile specs.ResponseProfile) string { return profile.GithubLink },
+		intranetValue: func(employee specs.IntranetEmployee) string { return employee.GithubURL },
+		columnValue:   func(employee specs.IntranetEmployee) interface{} { return employee.GithubURL },
+	},
+}
+
+// employeeSyncRun holds the state of one run of the intranet employee sync while it reads the pages of employees
+type employeeSyncRun struct {
+	opts              specs.SyncEmployeesOptions
+	policies          map[string]string
+	employmentByEmail map[string]specs.ProfileEmployment
+	only              map[string]bool
+	seenEmployeeIDs   map[string]bool
+	activeEmails      map[string]bool
+	activeEmployeeIDs map[string]bool
+	exitedEmails      map[string]bool
+	exitedEmployeeIDs map[string]bool
+	report            *specs.SyncEmployeesReport
+}
+
+// includes reports whether the employee with the given email or id is part of the run (every employee when no --only
+// filter is set)
+func (run *employeeSyncRun) includes(email, employeeID string) bool {
+	return len(run.only) == 0 || run.only[strings.ToLower(email)] || run.only[strings.ToLower(employeeID)]
+}
+
+// SyncEmployees fetches the employees from the Intranet API, a page at a time, and updates the matching profiles,
+// found by email, according to the sync policy of each field. Every change is recorded along with the policy that
+// allowed it. Profiles of employees the intranet no longer lists, or marks as exited, are marked as former
+// employees, and re-joiners are marked as current again. An incremental run only fetches the employees changed
+// since the last successful one. A dry run plans the same changes without writing them. The report lists the
+// outcome for each employee.
+func (syncSvc *service) SyncEmployees(ctx context.Context, opts specs.SyncEmployeesOptions) (report specs.SyncEmployeesReport, err error) {
+	report = specs.SyncEmployeesReport{DryRun: opts.DryRun, Employees: []specs.SyncEmployeeResult{}}
+	startedAt := time.Now().UTC()
+
+	policies, err := loadIntranetSyncPolicies()
+	if err != nil {
+		zap.S().Error("SyncEmployees: invalid sync policies: ", err)
+		return report, err
+	}
+
+	listOpts := specs.ListIntranetEmployeesOptions{}
+	if opts.Incremental {
+		watermark, watermarkErr := syncSvc.IntranetSyncRepo.GetSyncWatermark(ctx, constants.SyncStateIntranetEmployees)
+		switch watermarkErr {
+		case nil:
+			listOpts.UpdatedSince = &watermark
+			report.UpdatedSince = &watermark
+		case errors.ErrNoRecordFound:
+			zap.S().Info("SyncEmployees: no earlier sync has completed, fetching every employee")
+		default:
+			zap.S().Error("SyncEmployees: failed to read the sync watermark: ", watermarkErr)
+			return report, watermarkErr
+		}
+	}
+
+	employments, err := syncSvc.ProfileRepo.ListProfileEmployments(ctx)
+	if err != nil {
+		zap.S().Error("SyncEmployees: failed to list profile employments: ", err)
+		return report, err
+	}
+
+	run := &employeeSyncRun{
+		opts:              opts,
+		policies:          policies,
+		employmentByEmail: make(map[string]specs.ProfileEmployment, len(employments)),
+		only:              map[string]bool{},
+		seenEmployeeIDs:   map[string]bool{},
+		activeEmails:      map[string]bool{},
+		activeEmployeeIDs: map[string]bool{},
+		exitedEmails:      map[string]bool{},
+		exitedEmployeeIDs: map[string]bool{},
+		report:            &report,
+	}
+	for _, employment := range employments {
+		run.employmentByEmail[strings.ToLower(employment.Email)] = employment
+	}
+	for _, value := range opts.Only {
+		run.only[strings.ToLower(strings.TrimSpace(value))] = true
+	}
+
+	ctx = helpers.WithAuditAction(ctx, constants.AuditActionIntranetSync)
+	fetched := 0
+	err = syncSvc.IntranetClient.ListEmployees(ctx, listOpts, func(page []specs.IntranetEmployee) error {
+		fetched += len(page)
+		for _, emp := range page {
+			syncSvc.syncListedEmployee(ctx, run, emp)
+		}
+		return nil
+	})
+	if err != nil {
+		zap.S().Error("SyncEmployees: failed to fetch employees from Intranet API: ", err)
+		return report, err
+	}
+
+	zap.S().Infof("SyncEmployees: fetched %d employees from Intranet API", fetched)
+
+	syncSvc.syncLeavers(ctx, run, employments, listOpts.UpdatedSince != nil)
+
+	// a later incremental run must not miss employees that were only partly synced by this one, nor leavers that
+	// were not looked for
+	if opts.DryRun || len(run.only) > 0 || report.HasErrors() || report.HasDuplicateEmployeeIDs() || report.LeaverCheckSkipped != "" {
+		return report, nil
+	}
+
+	err = syncSvc.IntranetSyncRepo.SetSyncWatermark(ctx, constants.SyncStateIntranetEmployees, startedAt)
+	if err != nil {
+		zap.S().Error("SyncEmployees: failed to store the sync watermark: ", err)
+		return report, err
+	}
+
+	return report, nil
+}
+
+// syncListedEmployee syncs the profile of an employee listed by the intranet and adds the outcome to the report.
+// Employees marked as exited are only noted, to be handled as leavers.
+func (syncSvc *service) syncListedEmployee(ctx context.Context, run *employeeSyncRun, emp specs.IntranetEmployee) {
+	if emp.IsExited() {
+		run.exitedEmails[strings.ToLower(emp.Email)] = true
+		run.exitedEmployeeIDs[emp.EmployeeID] = true
+		return
+	}
+	run.activeEmails[strings.ToLower(emp.Email)] = true
+	run.activeEmployeeIDs[emp.EmployeeID] = true
+
+	if !run.includes(emp.Email, emp.EmployeeID) {
+		return
+	}
+
+	result := specs.SyncEmployeeResult{EmployeeID: emp.EmployeeID, Email: emp.Email, Changes: []specs.SyncFieldChange{}}
+	if emp.EmployeeID != "" && run.seenEmployeeIDs[emp.EmployeeID] {
+		zap.S().Errorf("SyncEmployees: employee id %s is listed more than once by the intranet, skipping %s", emp.EmployeeID, emp.Email)
+		result.Status = constants.SyncStatusSkipped
+		result.SkipReason = constants.SyncSkipDuplicateEmployeeID
+		run.report.Add(result)
+		return
+	}
+	run.seenEmployeeIDs[emp.EmployeeID] = true
+
+	employment := run.employmentByEmail[strings.ToLower(emp.Email)]
+	result, err := syncSvc.syncEmployee(ctx, emp, employment, run.policies, run.opts.DryRun, result)
+	if err != nil {
+		result.Status = constants.SyncStatusSkipped
+		result.Employment = ""
+		switch err {
+		case errors.ErrNoRecordFound:
+			zap.S().Infof("SyncEmployees: no profile found for email %s, skipping", emp.Email)
+			result.SkipReason = constants.SyncSkipNoProfile
+		case errors.ErrDuplicateEmployeeID:
+			zap.S().Errorf("SyncEmployees: employee id %s already belongs to another profile, skipping %s", emp.EmployeeID, emp.Email)
+			result.SkipReason = constants.SyncSkipDuplicateEmployeeID
+		default:
+			zap.S().Errorf("SyncEmployees: failed to sync employee %s with email %s: %v", emp.EmployeeID, emp.Email, err)
+			result.SkipReason = constants.SyncSkipUpdateError
+			result.Error = err.Error()
+		}
+	}
+	run.report.Add(result)
+}
+
+// syncLeavers marks the profiles of employees who have left as former employees. On an incremental run only the
+// employees marked as exited are leavers, since everyone else is missing from the list.
+func (syncSvc *service) syncLeavers(ctx context.Context, run *employeeSyncRun, employments []specs.ProfileEmployment, incremental bool) {
+	leavers, current := []specs.ProfileEmployment{}, 0
+	for _, employment := range employments {
+		if employment.IsCurrentEmployee != 1 || employment.EmployeeID == nil {
+			continue
+		}
+		current++
+
+		email := strings.ToLower(employment.Email)
+		exited := run.exitedEmails[email] || run.exitedEmployeeIDs[*employment.EmployeeID]
+		listed := run.activeEmails[email] || run.activeEmployeeIDs[*employment.EmployeeID]
+		if listed || (incremental && !exited) || !run.includes(employment.Email, *employment.EmployeeID) {
+			continue
+		}
+		leavers = append(leavers, employment)
+	}
+
+	switch {
+	case !incremental && len(run.activeEmails) == 0:
+		run.report.LeaverCheckSkipped = "the intranet listed no current employees"
+	case len(leavers) > constants.MinLeaversChecked && float64(len(leavers)) > constants.MaxLeaverFraction*float64(current):
+		run.report.LeaverCheckSkipped = fmt.Sprintf("%d of %d current employees would be marked as leavers", len(leavers), current)
+	}
+	if run.report.LeaverCheckSkipped != "" {
+		zap.S().Errorf("SyncEmployees: not looking for leavers because %s", run.report.LeaverCheckSkipped)
+		return
+	}
+
+	for _, leaver := range leavers {
+		result, err := syncSvc.markLeaver(ctx, leaver, run.opts)
+		if err != nil {
+			zap.S().Errorf("SyncEmployees: failed to mark profile %d of %s as a leaver: %v", leaver.ProfileID, leaver.Email, err)
+			result.Status = constants.SyncStatusSkipped
+			result.Employment = ""
+			result.SkipReason = constants.SyncSkipUpdateError
+			result.Error = err.Error()
+		}
+		run.report.Add(result)
+	}
+}
+
+// syncEmployee applies the intranet record of one employee to their profile, or only plans the changes on a dry run.
+// A profile marked as a former employee is marked as current again, along with its employee login.
+func (syncSvc *service) syncEmployee(ctx context.Context, emp specs.IntranetEmployee, employment specs.ProfileEmployment, policies map[string]string, dryRun bool, result specs.SyncEmployeeResult) (_ specs.SyncEmployeeResult, err error) {
+	tx, err := syncSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return result, err
+	}
+	defer func() {
+		txErr := syncSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	profileID, err := syncSvc.ProfileRepo.GetProfileIDByEmail(ctx, emp.Email, tx)
+	if err != nil {
+		return result, err
+	}
+	result.ProfileID = profileID
+
+	profile, err := syncSvc.ProfileRepo.GetProfile(ctx, profileID, tx)
+	if err != nil {
+		return result, err
+	}
+
+	fields, changes := planProfileSync(profile, emp, policies)
+	rejoined := employment.ProfileID == profileID && employment.IsCurrentEmployee == 0
+	if rejoined {
+		result.Employment = constants.EmploymentRejoined
+		fields, changes = planEmploymentChange(fields, changes, employment, emp.EmployeeID, 1, employment.IsActive == 0, "the employee is listed by the intranet again")
+	}
+
+	for _, change := range changes {
+		result.Changes = append(result.Changes, specs.SyncFieldChange{
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+			Policy:   change.Policy,
+			Reason:   change.Reason,
+		})
+	}
+
+	if len(changes) == 0 {
+		zap.S().Debugf("SyncEmployees: profile of employee %s is up to date", emp.EmployeeID)
+		result.Status = constants.SyncStatusUnchanged
+		return result, nil
+	}
+
+	if _, ok := fields["employee_id"]; ok {
+		ownerID, lookupErr := syncSvc.ProfileRepo.GetProfileIDByEmployeeID(ctx, emp.EmployeeID, tx)
+		if lookupErr == nil && ownerID != profileID {
+			return result, errors.ErrDuplicateEmployeeID
+		}
+		if lookupErr != nil && lookupErr != errors.ErrNoRecordFound {
+			return result, lookupErr
+		}
+	}
+
+	if dryRun {
+		result.Status = constants.SyncStatusWouldUpdate
+		return result, nil
+	}
+
+	err = syncSvc.ProfileRepo.UpdateProfileSyncFields(ctx, profileID, fields, helpers.GetTodaysDate(), tx)
+	if err != nil {
+		if err == errors.ErrDuplicateKey {
+			err = errors.ErrDuplicateEmployeeID
+		}
+		return result, err
+	}
+
+	err = syncSvc.IntranetSyncRepo.CreateProfileSyncChanges(ctx, changes, tx)
+	if err != nil {
+		return result, err
+	}
+
+	if rejoined {
+		err = syncSvc.setEmployeeLoginActive(ctx, emp.Email, true, tx)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	zap.S().Infof("SyncEmployees: updated %d fields for employee %s", len(changes), emp.EmployeeID)
+	result.Status = constants.SyncStatusUpdated
+	return result, nil
+}
+
+// markLeaver marks the profile of an employee who has left as a former employee, deactivating it if asked to,
+// deactivates their employee login and tells the admin who owns the profile. A dry run only plans the changes.
+func (syncSvc *service) markLeaver(ctx context.Context, leaver specs.ProfileEmployment, opts specs.SyncEmployeesOptions) (result specs.SyncEmployeeResult, err error) {
+	result = specs.SyncEmployeeResult{
+		EmployeeID: *leaver.EmployeeID,
+		Email:      leaver.Email,
+		ProfileID:  leaver.ProfileID,
+		Employment: constants.EmploymentLeft,
+		Changes:    []specs.SyncFieldChange{},
+	}
+
+	fields, changes := planEmploymentChange(map[string]interface{}{}, []repository.ProfileSyncChangeRepo{}, leaver, *leaver.EmployeeID, 0, opts.DeactivateLeavers && leaver.IsActive == 1, "the employee is no longer listed by the intranet or has exited")
+	for _, change := range changes {
+		result.Changes = append(result.Changes, specs.SyncFieldChange{
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+			Policy:   change.Policy,
+			Reason:   change.Reason,
+		})
+	}
+
+	if opts.DryRun {
+		result.Status = constants.SyncStatusWouldUpdate
+		return result, nil
+	}
+
+	var emailID int64
+	tx, err := syncSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return result, err
+	}
+	defer func() {
+		txErr := syncSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil && emailID != 0 {
+			syncSvc.sendQueuedEmails(ctx, emailID)
+		}
+	}()
+
+	err = syncSvc.ProfileRepo.UpdateProfileSyncFields(ctx, leaver.ProfileID, fields, helpers.GetTodaysDate(), tx)
+	if err != nil {
+		return result, err
+	}
+
+	err = syncSvc.IntranetSyncRepo.CreateProfileSyncChanges(ctx, changes, tx)
+	if err != nil {
+		return result, err
+	}
+
+	err = syncSvc.setEmployeeLoginActive(ctx, leaver.Email, false, tx)
+	if err != nil {
+		return result, err
+	}
+
+	emailID, err = syncSvc.queueLeaverNotification(ctx, leaver, tx)
+	if err != nil {
+		return result, err
+	}
+
+	zap.S().Infof("SyncEmployees: marked profile %d of %s as a former employee", leaver.ProfileID, leaver.Email)
+	result.Status = constants.SyncStatusUpdated
+	return result, nil
+}
+
+// planEmploymentChange adds the change of a profile to or from being a current employee to the planned changes,
+// also activating or deactivating the profile when setActive is true
+func planEmploymentChange(fields map[string]interface{}, changes []repository.ProfileSyncChangeRepo, employment specs.ProfileEmployment, employeeID string, isCurrentEmployee int, setActive bool, reason string) (map[string]interface{}, []repository.ProfileSyncChangeRepo) {
+	fields["is_current_employee"] = isCurrentEmployee
+	changes = append(changes, repository.ProfileSyncChangeRepo{
+		ProfileID:  employment.ProfileID,
+		EmployeeID: employeeID,
+		Field:      "is_current_employee",
+		OldValue:   strconv.Itoa(employment.IsCurrentEmployee),
+		NewValue:   strconv.Itoa(isCurrentEmployee),
+		Policy:     constants.SyncPolicyIntranetWins,
+		Reason:     reason,
+	})
+
+	if setActive {
+		fields["is_active"] = isCurrentEmployee
+		changes = append(changes, repository.ProfileSyncChangeRepo{
+			ProfileID:  employment.ProfileID,
+			EmployeeID: employeeID,
+			Field:      "is_active",
+			OldValue:   strconv.Itoa(employment.IsActive),
+			NewValue:   strconv.Itoa(isCurrentEmployee),
+			Policy:     constants.SyncPolicyIntranetWins,
+			Reason:     reason,
+		})
+	}
+
+	return fields, changes
+}
+
+// setEmployeeLoginActive activates or deactivates the employee login with the given email, if there is one.
+// Admins and other roles are left alone. A deactivated user is signed out of every session.
+func (syncSvc *service) setEmployeeLoginActive(ctx context.Context, email string, isActive bool, tx pgx.Tx) error {
+	user, err := syncSvc.UserLoginRepo.GetUserInfo(ctx, specs.UserInfoFilter{Email: email})
+	if err != nil {
+		if err == errors.ErrNoRecordFound {
+			return nil
+		}
+		return err
+	}
+
+	if user.Role != constants.Employee || user.IsActive == isActive {
+		return nil
+	}
+
+	err = syncSvc.UserLoginRepo.UpdateUserStatus(ctx, int(user.ID), isActive, helpers.GetCurrentISTTime(), tx)
+	if err != nil {
+		return err
+	}
+
+	if !isActive {
+		helpers.RevokeUserTokens(user.ID)
+	}
+	return nil
+}
+
+// queueLeaverNotification queues an email to the admin who created the profile of a leaver and returns its id. A
+// profile whose owner is no longer found is marked without notifying anyone.
+func (syncSvc *service) queueLeaverNotification(ctx context.Context, leaver specs.ProfileEmployment, tx pgx.Tx) (int64, error) {
+	owner, err := syncSvc.UserLoginRepo.GetUserInfo(ctx, specs.UserInfoFilter{ID: leaver.CreatedByID})
+	if err != nil {
+		zap.S().Errorf("SyncEmployees: unable to find the owner of profile %d to notify: %v", leaver.ProfileID, err)
+		return 0, nil
+	}
+
+	return syncSvc.queueEmail(ctx, constants.EmailKindLeaverNotification, owner.Email, specs.EmailTemplateData{
+		Name:         owner.Name,
+		Email:        owner.Email,
+		EmployeeName: leaver.Name,
+		ProfileID:    leaver.ProfileID,
+	}, &leaver.ProfileID, tx)
+}
+
+// planProfileSync decides which profile fields to take from the intranet. It returns the new column values
+// and a record of each change along with the policy that allowed it.
+func planProfileSync(profile specs.ResponseProfile, emp specs.IntranetEmployee, policies map[string]string) (map[string]interface{}, []repository.ProfileSyncChangeRepo) {
+	fields := map[string]interface{}{}
+	changes := []repository.ProfileSyncChangeRepo{}
+
+	var currentEmployeeID string
+	if profile.EmployeeID != nil {
+		currentEmployeeID = *profile.EmployeeID
+	}
+	if emp.EmployeeID != "" && emp.EmployeeID != currentEmployeeID {
+		fields["employee_id"] = emp.EmployeeID
+		changes = append(changes, repository.ProfileSyncChangeRepo{
+			ProfileID:  profile.ProfileID,
+			EmployeeID: emp.EmployeeID,
+			Field:      "employee_id",
+			OldValue:   currentEmployeeID,
+			NewValue:   emp.EmployeeID,
+			Policy:     constants.SyncPolicyIntranetWins,
+			Reason:     "the employee id always comes from the intranet",
+		})
+	}
+
+	for _, field := range intranetSyncFields {
+		policy := policies[field.column]
+		profileValue := field.profileValue(profile)
+		intranetValue := field.intranetValue(emp)
+		if intranetValue == "" || intranetValue == profileValue {
+			continue
+		}
+
+		var reason string
+		switch {
+		case policy == constants.SyncPolicyIntranetWins:
+			reason = "the intranet value differs and the intranet wins"
+		case policy == constants.SyncPolicyFillIfEmpty && profileValue == "":
+			reason = "the profile value was empty"
+		default:
+			continue
+		}
+
+		fields[field.column] = field.columnValue(emp)
+		changes = append(changes, repository.ProfileSyncChangeRepo{
+			ProfileID:  profile.ProfileID,
+			EmployeeID: emp.EmployeeID,
+			Field:      field.column,
+			OldValue:   profileValue,
+			NewValue:   intranetValue,
+			Policy:     policy,
+			Reason:     reason,
+		})
+	}
+
+	return fields, changes
+}
+
+// loadIntranetSyncPolicies returns the default sync policies with the overrides from INTRANET_SYNC_POLICIES applied
+func loadIntranetSyncPolicies() (map[string]string, error) {
+	policies := make(map[string]string, len(constants.IntranetSyncPolicies))
+	for field, policy := range constants.IntranetSyncPolicies {
+		policies[field] = policy
+	}
+
+	overrides := strings.TrimSpace(os.Getenv(constants.IntranetSyncPoliciesEnvVar))
+	if overrides == "" {
+		return policies, nil
+	}
+
+	for _, override := range strings.Split(overrides, ",") {
+		field, policy, found := strings.Cut(strings.TrimSpace(override), "=")
+		field, policy = strings.TrimSpace(field), strings.TrimSpace(policy)
+		if _, known := policies[field]; !found || !known {
+			return nil, fmt.Errorf("%w : unknown field in %q", errors.ErrInvalidSyncPolicy, override)
+		}
+
+		switch policy {
+		case constants.SyncPolicyIntranetWins, constants.SyncPolicyProfileWins, constants.SyncPolicyFillIfEmpty:
+			policies[field] = policy
+		default:
+			return nil, fmt.Errorf("%w : unknown policy in %q", errors.ErrInvalidSyncPolicy, override)
+		}
+	}
+
+	return policies, nil
+}
+
+// ListProfileSyncChanges returns the changes made to a profile by the intranet sync
+func (syncSvc *service) ListProfileSyncChanges(ctx context.Context, profileID int) (values specs.ListProfileSyncChangesResponse, err error) {
+	tx, err := syncSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.ListProfileSyncChangesResponse{}, err
+	}
+	defer func() {
+		txErr := syncSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	changes, err := syncSvc.IntranetSyncRepo.ListProfileSyncChanges(ctx, profileID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to list sync changes of profile %d : %v", profileID, err)
+		return specs.ListProfileSyncChangesResponse{}, err
+	}
+
+	return specs.ListProfileSyncChangesResponse{Changes: changes}, nil
+}
+
+// RunEmployeeSync records a new sync run, runs the employee sync and records its outcome in the run history. It
+// returns ErrSyncInProgress without syncing if another run has not finished.
+func (syncSvc *service) RunEmployeeSync(ctx context.Context, trigger string, triggeredByID int, opts specs.SyncEmployeesOptions) (specs.SyncRun, specs.SyncEmployeesReport, error) {
+	run, err := syncSvc.beginSyncRun(ctx, trigger, triggeredByID, opts)
+	if err != nil {
+		return specs.SyncRun{}, specs.SyncEmployeesReport{}, err
+	}
+
+	return syncSvc.completeSyncRun(ctx, run, opts)
+}
+
+// StartEmployeeSync records a new sync run and runs the employee sync in the background, returning the run as
+// started. It returns ErrSyncInProgress without syncing if another run has not finished.
+func (syncSvc *service) StartEmployeeSync(ctx context.Context, trigger string, triggeredByID int, opts specs.SyncEmployeesOptions) (specs.SyncRun, error) {
+	run, err := syncSvc.beginSyncRun(ctx, trigger, triggeredByID, opts)
+	if err != nil {
+		return specs.SyncRun{}, err
+	}
+
+	// the run outlives the request that started it
+	go syncSvc.completeBackgroundSyncRun(context.WithoutCancel(ctx), run, opts)
+	return run, nil
+}
+
+// completeBackgroundSyncRun completes a sync run started in the background. A panic fails the run rather than
+// taking the server down with it.
+func (syncSvc *service) completeBackgroundSyncRun(ctx context.Context, run specs.SyncRun, opts specs.SyncEmployeesOptions) {
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+		zap.S().Errorf("Sync run %d panicked : %v\n%s", run.ID, recovered, debug.Stack())
+		err := syncSvc.IntranetSyncRepo.FinishSyncRun(ctx, run.ID, repository.SyncRunResultRepo{
+			Status:     constants.SyncRunFailed,
+			FinishedAt: time.Now().UTC(),
+			Error:      fmt.Sprintf("panic: %v", recovered),
+		})
+		if err != nil {
+			zap.S().Errorf("Unable to record the outcome of sync run %d : %v", run.ID, err)
+		}
+	}()
+
+	syncSvc.completeSyncRun(ctx, run, opts)
+}
+
+// beginSyncRun records the start of a sync run, first failing runs that have been running for too long to still
+// be alive.
+func (syncSvc *service) beginSyncRun(ctx context.Context, trigger string, triggeredByID int, opts specs.SyncEmployeesOptions) (specs.SyncRun, error) {
+	reason := fmt.Sprintf("abandoned: still running after %s", constants.SyncRunStaleAfter)
+	failed, err := syncSvc.IntranetSyncRepo.FailStaleSyncRuns(ctx, time.Now().UTC().Add(-constants.SyncRunStaleAfter), reason)
+	if err != nil {
+		zap.S().Error("Unable to fail stale sync runs : ", err)
+		return specs.SyncRun{}, err
+	}
+	if failed > 0 {
+		zap.S().Warnf("Marked %d stale sync runs as failed", failed)
+	}
+
+	run := specs.SyncRun{
+		Trigger:           trigger,
+		Incremental:       opts.Incremental,
+		DeactivateLeavers: opts.DeactivateLeavers,
+		Status:            constants.SyncRunRunning,
+		StartedAt:         time.Now().UTC(),
+	}
+	if triggeredByID > 0 {
+		run.TriggeredByID = &triggeredByID
+	}
+
+	run.ID, err = syncSvc.IntranetSyncRepo.CreateSyncRun(ctx, repository.SyncRunRepo{
+		Trigger:           run.Trigger,
+		TriggeredByID:     run.TriggeredByID,
+		Incremental:       run.Incremental,
+		DeactivateLeavers: run.DeactivateLeavers,
+		StartedAt:         run.StartedAt,
+	})
+	if err != nil {
+		if err == errors.ErrSyncInProgress {
+			zap.S().Infof("Not starting a %s sync run, another run has not finished", trigger)
+			return specs.SyncRun{}, err
+		}
+		zap.S().Error("Unable to create sync run : ", err)
+		return specs.SyncRun{}, err
+	}
+
+	zap.S().Infof("Started %s sync run %d", trigger, run.ID)
+	return run, nil
+}
+
+// completeSyncRun runs the employee sync for a started run and records its outcome.
+func (syncSvc *service) completeSyncRun(ctx context.Context, run specs.SyncRun, opts specs.SyncEmployeesOptions) (specs.SyncRun, specs.SyncEmployeesReport, error) {
+	report, err := syncSvc.SyncEmployees(ctx, opts)
+
+	finishedAt := time.Now().UTC()
+	run.Status = constants.SyncRunSucceeded
+	run.FinishedAt = &finishedAt
+	run.Updated = report.Updated
+	run.Unchanged = report.Unchanged
+	run.Skipped = report.Skipped
+	run.Left = report.Left
+	run.Rejoined = report.Rejoined
+	run.Errors = report.Errors()
+	if err != nil {
+		run.Status = constants.SyncRunFailed
+		run.Error = err.Error()
+	}
+
+	finishErr := syncSvc.IntranetSyncRepo.FinishSyncRun(ctx, run.ID, repository.SyncRunResultRepo{
+		Status:     run.Status,
+		FinishedAt: finishedAt,
+		Updated:    run.Updated,
+		Unchanged:  run.Unchanged,
+		Skipped:    run.Skipped,
+		Leavers:    run.Left,
+		Rejoiners:  run.Rejoined,
+		Errors:     run.Errors,
+		Error:      run.Error,
+	})
+	if finishErr != nil {
+		zap.S().Errorf("Unable to record the outcome of sync run %d : %v", run.ID, finishErr)
+		if err == nil {
+			err = finishErr
+		}
+	}
+
+	zap.S().Infof("Sync run %d %s. Updated: %d, Unchanged: %d, Skipped: %d, Left: %d, Rejoined: %d, Errors: %d", run.ID, run.Status,
+		run.Updated, run.Unchanged, run.Skipped, run.Left, run.Rejoined, run.Errors)
+	if !opts.DryRun && (run.Status == constants.SyncRunFailed || run.Updated+run.Left+run.Rejoined > 0) {
+		syncSvc.notifySyncRun(ctx, run)
+	}
+	return run, report, err
+}
+
+// notifySyncRun notifies the users who run the intranet sync of a run that changed profiles or failed. A
+// notification that cannot be created is only logged, the run itself having been recorded.
+func (syncSvc *service) notifySyncRun(ctx context.Context, run specs.SyncRun) {
+	notification := repository.NotificationRepo{
+		Permission: constants.PermIntranetSync,
+		Kind:       constants.NotificationIntranetSync,
+		Title:      fmt.Sprintf("Intranet sync updated %d profiles", run.Updated),
+		Body: fmt.Sprintf("Sync run %d: %d updated, %d left, %d rejoined, %d errors.", run.ID, run.Updated, run.Left,
+			run.Rejoined, run.Errors),
+	}
+	if run.Status == constants.SyncRunFailed {
+		notification.Title = "Intranet sync failed"
+		notification.Body = fmt.Sprintf("Sync run %d failed: %s", run.ID, run.Error)
+	}
+
+	var notifications []specs.Notification
+	tx, err := syncSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		zap.S().Errorf("Unable to notify of sync run %d : %v", run.ID, err)
+		return
+	}
+	defer func() {
+		txErr := syncSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			zap.S().Errorf("Unable to notify of sync run %d : %v", run.ID, txErr)
+			return
+		}
+		if err == nil {
+			syncSvc.publishNotifications(ctx, notifications)
+		}
+	}()
+
+	notifications, err = syncSvc.notify(ctx, notification, tx)
+}
+
+// ListSyncRuns returns the latest intranet sync runs, newest first
+func (syncSvc *service) ListSyncRuns(ctx context.Context, limit int) (specs.ListSyncRunsResponse, error) {
+	runs, err := syncSvc.IntranetSyncRepo.ListSyncRuns(ctx, limit)
+	if err != nil {
+		zap.S().Error("Unable to list sync runs : ", err)
+		return specs.ListSyncRunsResponse{}, err
+	}
+
+	return specs.ListSyncRunsResponse{Runs: runs}, nil
+}
+
+// GetSyncRun returns an intranet sync run
+func (syncSvc *service) GetSyncRun(ctx context.Context, runID int64) (specs.SyncRun, error) {
+	run, err := syncSvc.IntranetSyncRepo.GetSyncRun(ctx, runID)
+	if err != nil {
+		zap.S().Errorf("Unable to get sync run %d : %v", runID, err)
+		return specs.SyncRun{}, err
+	}
+
+	return run, nil
+}
+
+// splitIntranetSkills splits the comma separated skills sent by the intranet
+func splitIntranetSkills(skills string) []string {
+	if skills == "" {
+		return []string{}
+	}
+
+	values := []string{}
+	for _, skill := range strings.Split(skills, ",") {
+		values = append(values, strings.TrimSpace(skill))
+	}
+	return values
+}
+
+// formatYearsOfExperience formats years of experience for comparison, treating zero as not set
+func formatYearsOfExperience(years float64) string {
+	if years == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(years, 'f', -1, 64)
+}
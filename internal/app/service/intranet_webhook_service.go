@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"go.uber.org/zap"
+)
+
+// IntranetWebhookService contains methods to receive and process the employee lifecycle events pushed by the intranet
+type IntranetWebhookService interface {
+	ReceiveIntranetWebhook(ctx context.Context, event specs.IntranetWebhookEvent, payload []byte) (specs.ReceiveIntranetWebhookResponse, error)
+	ProcessIntranetWebhookEvents(ctx context.Context) (int, error)
+	ListIntranetWebhookEvents(ctx context.Context, filter specs.ListIntranetWebhookEventsFilter) (specs.ListIntranetWebhookEventsResponse, error)
+	GetIntranetWebhookEvent(ctx context.Context, id int64) (specs.IntranetWebhookEventRecord, error)
+	RetryIntranetWebhookEvent(ctx context.Context, id int64) (specs.IntranetWebhookEventRecord, error)
+}
+
+// ReceiveIntranetWebhook stores an intranet event and processes it in the background. An event already received
+// is acknowledged as a duplicate and not processed again.
+func (webhookSvc *service) ReceiveIntranetWebhook(ctx context.Context, event specs.IntranetWebhookEvent, payload []byte) (specs.ReceiveIntranetWebhookResponse, error) {
+	id, created, err := webhookSvc.IntranetWebhookRepo.CreateWebhookEvent(ctx, repository.IntranetWebhookEventRepo{
+		EventID:    event.ID,
+		EventType:  event.Type,
+		EmployeeID: event.EmployeeID,
+		Payload:    payload,
+	})
+	if err != nil {
+		zap.S().Errorf("Unable to store intranet event %s : %v", event.ID, err)
+		return specs.ReceiveIntranetWebhookResponse{}, err
+	}
+
+	resp := specs.ReceiveIntranetWebhookResponse{EventID: event.ID, Status: constants.WebhookEventPending, Duplicate: !created}
+	if !created {
+		zap.S().Infof("Intranet event %s was already received, not processing it again", event.ID)
+		return resp, nil
+	}
+
+	zap.S().Infof("Received intranet event %s of type %s for employee %s", event.ID, event.Type, event.EmployeeID)
+	// processing outlives the request that delivered the event; the retry job picks the event up if it is lost
+	go webhookSvc.processWebhookEvent(context.WithoutCancel(ctx), id)
+	return resp, nil
+}
+
+// ProcessIntranetWebhookEvents processes the events that are due: new events the server did not get to, failed
+// events whose retry is due and events abandoned while being processed. It returns how many it processed.
+func (webhookSvc *service) ProcessIntranetWebhookEvents(ctx context.Context) (int, error) {
+	events, err := webhookSvc.IntranetWebhookRepo.ClaimDueWebhookEvents(ctx, constants.WebhookEventsBatchSize, time.Now().UTC().Add(constants.WebhookProcessingLease))
+	if err != nil {
+		zap.S().Error("Unable to claim due intranet events : ", err)
+		return 0, err
+	}
+
+	for _, event := range events {
+		webhookSvc.completeWebhookEvent(ctx, event)
+	}
+	if len(events) > 0 {
+		zap.S().Infof("Processed %d due intranet events", len(events))
+	}
+	return len(events), nil
+}
+
+// ListIntranetWebhookEvents returns the latest intranet events, newest first
+func (webhookSvc *service) ListIntranetWebhookEvents(ctx context.Context, filter specs.ListIntranetWebhookEventsFilter) (specs.ListIntranetWebhookEventsResponse, error) {
+	events, err := webhookSvc.IntranetWebhookRepo.ListWebhookEvents(ctx, filter)
+	if err != nil {
+		zap.S().Error("Unable to list intranet events : ", err)
+		return specs.ListIntranetWebhookEventsResponse{}, err
+	}
+
+	return specs.ListIntranetWebhookEventsResponse{Events: events}, nil
+}
+
+// GetIntranetWebhookEvent returns a stored intranet event
+func (webhookSvc *service) GetIntranetWebhookEvent(ctx context.Context, id int64) (specs.IntranetWebhookEventRecord, error) {
+	event, err := webhookSvc.IntranetWebhookRepo.GetWebhookEvent(ctx, id)
+	if err != nil {
+		zap.S().Errorf("Unable to get intranet event %d : %v", id, err)
+		return specs.IntranetWebhookEventRecord{}, err
+	}
+
+	return event, nil
+}
+
+// RetryIntranetWebhookEvent makes a failed or dead event pending again, with its attempts reset, and processes it
+// in the background.
+func (webhookSvc *service) RetryIntranetWebhookEvent(ctx context.Context, id int64) (specs.IntranetWebhookEventRecord, error) {
+	event, err := webhookSvc.IntranetWebhookRepo.GetWebhookEvent(ctx, id)
+	if err != nil {
+		zap.S().Errorf("Unable to get intranet event %d : %v", id, err)
+		return specs.IntranetWebhookEventRecord{}, err
+	}
+
+	reset, err := webhookSvc.IntranetWebhookRepo.ResetWebhookEvent(ctx, id)
+	if err != nil {
+		zap.S().Errorf("Unable to reset intranet event %d : %v", id, err)
+		return specs.IntranetWebhookEventRecord{}, err
+	}
+	if !reset {
+		return specs.IntranetWebhookEventRecord{}, errors.ErrWebhookEventNotRetryable
+	}
+
+	zap.S().Infof("Retrying intranet event %s after %d attempts", event.EventID, event.Attempts)
+	event.Status = constants.WebhookEventPending
+	event.Attempts = 0
+	event.NextAttemptAt = time.Now().UTC()
+
+	go webhookSvc.processWebhookEvent(context.WithoutCancel(ctx), id)
+	return event, nil
+}
+
+// processWebhookEvent claims and processes one event, unless it is no longer due
+func (webhookSvc *service) processWebhookEvent(ctx context.Context, id int64) {
+	event, err := webhookSvc.IntranetWebhookRepo.ClaimWebhookEvent(ctx, id, time.Now().UTC().Add(constants.WebhookProcessingLease))
+	if err != nil {
+		if err != errors.ErrNoRecordFound {
+			zap.S().Errorf("Unable to claim intranet event %d : %v", id, err)
+		}
+		return
+	}
+
+	webhookSvc.completeWebhookEvent(ctx, event)
+}
+
+// completeWebhookEvent applies a claimed event and records the outcome. A failed event is retried later with a
+// growing backoff until it has been tried INTRANET_WEBHOOK_MAX_ATTEMPTS times, after which it is dead.
+func (webhookSvc *service) completeWebhookEvent(ctx context.Context, event specs.IntranetWebhookEventRecord) {
+	result, err := webhookSvc.applyWebhookEvent(ctx, event)
+
+	now := time.Now().UTC()
+	outcome := repository.IntranetWebhookResultRepo{Status: constants.WebhookEventProcessed, NextAttemptAt: now}
+	if err == nil {
+		outcome.ProcessedAt = &now
+		outcome.Result, err = json.Marshal(result)
+	}
+	if err != nil {
+		outcome.Status = constants.WebhookEventFailed
+		outcome.LastError = err.Error()
+		outcome.NextAttemptAt = now.Add(helpers.RetryBackoff(event.Attempts, constants.WebhookRetryBackoff, constants.MaxWebhookRetryBackoff))
+		if event.Attempts >= webhookMaxAttempts() {
+			outcome.Status = constants.WebhookEventDead
+			outcome.NextAttemptAt = now
+		}
+	}
+
+	finishErr := webhookSvc.IntranetWebhookRepo.FinishWebhookEvent(ctx, event.ID, outcome)
+	if finishErr != nil {
+		zap.S().Errorf("Unable to record the outcome of intranet event %s : %v", event.EventID, finishErr)
+		return
+	}
+
+	switch outcome.Status {
+	case constants.WebhookEventProcessed:
+		zap.S().Infof("Processed intranet event %s for employee %s: %s", event.EventID, event.EmployeeID, result.Status)
+	case constants.WebhookEventDead:
+		zap.S().Errorf("Intranet event %s failed %d times and will not be retried: %v", event.EventID, event.Attempts, err)
+	default:
+		zap.S().Warnf("Intranet event %s failed on attempt %d, retrying at %s: %v", event.EventID, event.Attempts, outcome.NextAttemptAt, err)
+	}
+}
+
+// applyWebhookEvent brings the profile of the event's employee in step with the intranet, in the same way the
+// employee sync does. The employee is fetched afresh, so the event type only matters when the intranet no longer
+// knows the employee: that makes them a leaver after an exited event, but is an error after any other event.
+func (webhookSvc *service) applyWebhookEvent(ctx context.Context, event specs.IntranetWebhookEventRecord) (specs.SyncEmployeeResult, error) {
+	ctx = helpers.WithAuditAction(ctx, constants.AuditActionIntranetEvent)
+	result := specs.SyncEmployeeResult{EmployeeID: event.EmployeeID, Changes: []specs.SyncFieldChange{}}
+
+	// the event says the employee changed, so a cached lookup is out of date
+	webhookSvc.InvalidateIntranetCache(ctx, event.EmployeeID)
+	emp, err := webhookSvc.IntranetClient.GetEmployeeByID(ctx, event.EmployeeID)
+	gone := err == errors.ErrNoRecordFound && event.EventType == constants.IntranetEventEmployeeExited
+	if err != nil && !gone {
+		return result, err
+	}
+
+	employments, err := webhookSvc.ProfileRepo.ListProfileEmployments(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	if gone || emp.IsExited() {
+		for _, employment := range employments {
+			if employment.EmployeeID == nil || *employment.EmployeeID != event.EmployeeID {
+				continue
+			}
+			if employment.IsCurrentEmployee != 1 {
+				result.Email = employment.Email
+				result.ProfileID = employment.ProfileID
+				result.Status = constants.SyncStatusUnchanged
+				return result, nil
+			}
+			deactivateLeavers := os.Getenv(constants.IntranetSyncDeactivateLeaversEnvVar) == "true"
+			return webhookSvc.markLeaver(ctx, employment, specs.SyncEmployeesOptions{DeactivateLeavers: deactivateLeavers})
+		}
+
+		result.Status = constants.SyncStatusSkipped
+		result.SkipReason = constants.SyncSkipNoProfile
+		return result, nil
+	}
+
+	policies, err := loadIntranetSyncPolicies()
+	if err != nil {
+		return result, err
+	}
+
+	var employment specs.ProfileEmployment
+	for _, candidate := range employments {
+		if strings.EqualFold(candidate.Email, emp.Email) {
+			employment = candidate
+			break
+		}
+	}
+
+	result.EmployeeID = emp.EmployeeID
+	result.Email = emp.Email
+	result, err = webhookSvc.syncEmployee(ctx, *emp, employment, policies, false, result)
+	switch err {
+	case nil:
+		return result, nil
+	case errors.ErrNoRecordFound:
+		result.Status = constants.SyncStatusSkipped
+		result.SkipReason = constants.SyncSkipNoProfile
+		return result, nil
+	case errors.ErrDuplicateEmployeeID:
+		result.Status = constants.SyncStatusSkipped
+		result.SkipReason = constants.SyncSkipDuplicateEmployeeID
+		return result, nil
+	}
+	return result, err
+}
+
+// webhookMaxAttempts returns the number of times an event is tried before it is dead
+func webhookMaxAttempts() int {
+	return int(helpers.ConvertStringToIntWithDefault(constants.IntranetWebhookMaxAttemptsEnvVar, constants.DefaultWebhookMaxAttempts))
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"go.uber.org/zap"
+)
+
+// InvitationService contains methods to look after employee invitations until they are completed
+type InvitationService interface {
+	ListInvitations(ctx context.Context, filter specs.ListInvitationsFilter) (specs.ListInvitationsResponse, error)
+	ListProfileInvitations(ctx context.Context, profileID int) (specs.ListInvitationsResponse, error)
+	ResendInvitation(ctx context.Context, userID int, profileID int) error
+	SendInvitationReminders(ctx context.Context) (int, error)
+}
+
+// ListInvitations returns a page of the invitations matching the filter, the latest sent first
+func (invitationSvc *service) ListInvitations(ctx context.Context, filter specs.ListInvitationsFilter) (specs.ListInvitationsResponse, error) {
+	invitations, err := invitationSvc.UserEmailRepo.ListInvitations(ctx, filter)
+	if err != nil {
+		zap.S().Error("Unable to list invitations : ", err)
+		return specs.ListInvitationsResponse{}, err
+	}
+
+	return specs.ListInvitationsResponse{
+		Invitations: invitations,
+		Page:        filter.Page,
+		Limit:       filter.Limit,
+	}, nil
+}
+
+// ListProfileInvitations returns every invitation sent for a profile, the latest first
+func (invitationSvc *service) ListProfileInvitations(ctx context.Context, profileID int) (specs.ListInvitationsResponse, error) {
+	invitations, err := invitationSvc.UserEmailRepo.ListInvitations(ctx, specs.ListInvitationsFilter{ProfileID: profileID})
+	if err != nil {
+		zap.S().Errorf("Unable to list the invitations of profile %d : %v", profileID, err)
+		return specs.ListInvitationsResponse{}, err
+	}
+
+	return specs.ListInvitationsResponse{Invitations: invitations}, nil
+}
+
+// ResendInvitation emails the invitation of a profile again with a new expiry, whether or not it has expired.
+// It returns ErrInvitationNotFound if the profile has no open invitation.
+func (invitationSvc *service) ResendInvitation(ctx context.Context, userID int, profileID int) (err error) {
+	ctx = helpers.WithAuditAction(ctx, constants.AuditActionInvitation)
+	var emailID int64
+	tx, err := invitationSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		txErr := invitationSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil {
+			invitationSvc.sendQueuedEmails(ctx, emailID)
+		}
+	}()
+
+	invitation, err := invitationSvc.UserEmailRepo.GetPendingInvitation(ctx, profileID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to get the invitation of profile %d : %v", profileID, err)
+		return err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(invitationExpiry())
+	err = invitationSvc.UserEmailRepo.RenewInvitation(ctx, profileID, repository.RenewInvitationRepo{
+		ExpiresAt:     expiresAt,
+		RemindersSent: invitationRemindersDue(expiresAt, now),
+		UpdatedAt:     helpers.GetCurrentISTTime(),
+		UpdatedByID:   userID,
+	}, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to renew the invitation of profile %d : %v", profileID, err)
+		return err
+	}
+
+	emailID, err = invitationSvc.queueEmail(ctx, constants.EmailKindEmployeeInvitation, invitation.Email, specs.EmailTemplateData{
+		Name:      invitation.Name,
+		Email:     invitation.Email,
+		ProfileID: profileID,
+		ExpiresAt: formatInvitationExpiry(expiresAt),
+	}, &profileID, tx)
+	if err != nil {
+		return err
+	}
+
+	zap.S().Infof("Invitation of profile %d resent by user %d, expiring at %s", profileID, userID, expiresAt)
+	return nil
+}
+
+// SendInvitationReminders emails a reminder for every open invitation that has reached one of the
+// INVITATION_REMINDER_DAYS before its expiry since it was last reminded. Reminders missed, e.g. while the server was
+// down, are not caught up on: an invitation gets at most one reminder per run. It returns how many were sent.
+func (invitationSvc *service) SendInvitationReminders(ctx context.Context) (int, error) {
+	invitations, err := invitationSvc.UserEmailRepo.ListPendingInvitations(ctx)
+	if err != nil {
+		zap.S().Error("Unable to list invitations to remind : ", err)
+		return 0, err
+	}
+
+	now := time.Now()
+	sent := 0
+	for _, invitation := range invitations {
+		due := invitationRemindersDue(invitation.ExpiresAt, now)
+		if due <= invitation.RemindersSent {
+			continue
+		}
+
+		reminded, err := invitationSvc.remindInvitation(ctx, invitation, due)
+		if err != nil {
+			zap.S().Errorf("Unable to remind profile %d of its invitation : %v", invitation.ProfileID, err)
+			continue
+		}
+		if reminded {
+			sent++
+		}
+	}
+
+	if sent > 0 {
+		zap.S().Infof("Sent %d invitation reminders", sent)
+	}
+	return sent, nil
+}
+
+// remindInvitation records that remindersSent reminders have been sent for an invitation and queues the reminder,
+// reporting whether it did. No reminder is queued when another run has already recorded it.
+func (invitationSvc *service) remindInvitation(ctx context.Context, invitation specs.PendingInvitation, remindersSent int) (reminded bool, err error) {
+	var emailID int64
+	tx, err := invitationSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		txErr := invitationSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil && reminded {
+			invitationSvc.sendQueuedEmails(ctx, emailID)
+		}
+	}()
+
+	reminded, err = invitationSvc.UserEmailRepo.RecordInvitationReminder(ctx, invitation.ProfileID, remindersSent, tx)
+	if err != nil || !reminded {
+		return false, err
+	}
+
+	emailID, err = invitationSvc.queueEmail(ctx, constants.EmailKindInvitationReminder, invitation.Email, specs.EmailTemplateData{
+		Name:      invitation.Name,
+		Email:     invitation.Email,
+		ProfileID: invitation.ProfileID,
+		ExpiresAt: formatInvitationExpiry(invitation.ExpiresAt),
+	}, &invitation.ProfileID, tx)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// invitationExpiry returns how long an invitation is open for once it is sent
+func invitationExpiry() time.Duration {
+	days := helpers.ConvertStringToIntWithDefault(constants.InvitationExpiryDaysEnvVar, constants.DefaultInvitationExpiryDays)
+	if days < 1 {
+		days = constants.DefaultInvitationExpiryDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// invitationReminderDays returns the days before expiry on which reminders are sent, furthest from expiry first.
+// Values that are not positive numbers are ignored.
+func invitationReminderDays() []int {
+	value, ok := os.LookupEnv(constants.InvitationReminderDaysEnvVar)
+	if !ok {
+		value = constants.DefaultInvitationReminderDays
+	}
+
+	days := []int{}
+	for _, field := range strings.Split(value, ",") {
+		day, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || day < 1 {
+			continue
+		}
+		days = append(days, day)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(days)))
+	return days
+}
+
+// invitationRemindersDue returns how many reminders of an invitation expiring at expiresAt are due at now
+func invitationRemindersDue(expiresAt time.Time, now time.Time) int {
+	due := 0
+	for _, day := range invitationReminderDays() {
+		if !now.Before(expiresAt.Add(-time.Duration(day) * 24 * time.Hour)) {
+			due++
+		}
+	}
+	return due
+}
+
+// formatInvitationExpiry writes the expiry of an invitation the way emails show it, in IST
+func formatInvitationExpiry(expiresAt time.Time) string {
+	loc, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		return expiresAt.Format(constants.InvitationExpiryFormat)
+	}
+	return expiresAt.In(loc).Format(constants.InvitationExpiryFormat)
+}
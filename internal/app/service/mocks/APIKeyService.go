@@ -0,0 +1,160 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// APIKeyService is an autogenerated mock type for the APIKeyService type
+type APIKeyService struct {
+	mock.Mock
+}
+
+// AuthenticateAPIKey provides a mock function with given fields: ctx, key, clientIP
+func (_m *APIKeyService) AuthenticateAPIKey(ctx context.Context, key string, clientIP string) (specs.APIKeyPrincipal, error) {
+	ret := _m.Called(ctx, key, clientIP)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthenticateAPIKey")
+	}
+
+	var r0 specs.APIKeyPrincipal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (specs.APIKeyPrincipal, error)); ok {
+		return rf(ctx, key, clientIP)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) specs.APIKeyPrincipal); ok {
+		r0 = rf(ctx, key, clientIP)
+	} else {
+		r0 = ret.Get(0).(specs.APIKeyPrincipal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, key, clientIP)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, userID, req
+func (_m *APIKeyService) CreateAPIKey(ctx context.Context, userID int, req specs.CreateAPIKeyRequest) (specs.APIKeySecretResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 specs.APIKeySecretResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.CreateAPIKeyRequest) (specs.APIKeySecretResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.CreateAPIKeyRequest) specs.APIKeySecretResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		r0 = ret.Get(0).(specs.APIKeySecretResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, specs.CreateAPIKeyRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAPIKeys provides a mock function with given fields: ctx
+func (_m *APIKeyService) ListAPIKeys(ctx context.Context) (specs.ListAPIKeysResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 specs.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.ListAPIKeysResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.ListAPIKeysResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.ListAPIKeysResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeAPIKey provides a mock function with given fields: ctx, apiKeyID
+func (_m *APIKeyService) RevokeAPIKey(ctx context.Context, apiKeyID int) error {
+	ret := _m.Called(ctx, apiKeyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, apiKeyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RotateAPIKey provides a mock function with given fields: ctx, apiKeyID
+func (_m *APIKeyService) RotateAPIKey(ctx context.Context, apiKeyID int) (specs.APIKeySecretResponse, error) {
+	ret := _m.Called(ctx, apiKeyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateAPIKey")
+	}
+
+	var r0 specs.APIKeySecretResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.APIKeySecretResponse, error)); ok {
+		return rf(ctx, apiKeyID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.APIKeySecretResponse); ok {
+		r0 = rf(ctx, apiKeyID)
+	} else {
+		r0 = ret.Get(0).(specs.APIKeySecretResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, apiKeyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAPIKeyService creates a new instance of APIKeyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyService {
+	mock := &APIKeyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,200 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// BackupService is an autogenerated mock type for the BackupService type
+type BackupService struct {
+	mock.Mock
+}
+
+// BackupAllProfiles provides a mock function with given fields: ctx
+func (_m *BackupService) BackupAllProfiles(ctx context.Context) (specs.BackupReport, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackupAllProfiles")
+	}
+
+	var r0 specs.BackupReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.BackupReport, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.BackupReport); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.BackupReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckBackup provides a mock function with given fields: ctx, path
+func (_m *BackupService) CheckBackup(ctx context.Context, path string) (specs.BackupReport, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBackup")
+	}
+
+	var r0 specs.BackupReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (specs.BackupReport, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) specs.BackupReport); ok {
+		r0 = rf(ctx, path)
+	} else {
+		r0 = ret.Get(0).(specs.BackupReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackups provides a mock function with given fields: ctx
+func (_m *BackupService) ListBackups(ctx context.Context) (specs.ListBackupsResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBackups")
+	}
+
+	var r0 specs.ListBackupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.ListBackupsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.ListBackupsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.ListBackupsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreBackup provides a mock function with given fields: ctx, path
+func (_m *BackupService) RestoreBackup(ctx context.Context, path string) (specs.BackupReport, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreBackup")
+	}
+
+	var r0 specs.BackupReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (specs.BackupReport, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) specs.BackupReport); ok {
+		r0 = rf(ctx, path)
+	} else {
+		r0 = ret.Get(0).(specs.BackupReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RotateBackups provides a mock function with given fields: ctx
+func (_m *BackupService) RotateBackups(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateBackups")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyLatestBackup provides a mock function with given fields: ctx
+func (_m *BackupService) VerifyLatestBackup(ctx context.Context) (specs.BackupVerification, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyLatestBackup")
+	}
+
+	var r0 specs.BackupVerification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.BackupVerification, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.BackupVerification); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.BackupVerification)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBackupService creates a new instance of BackupService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBackupService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BackupService {
+	mock := &BackupService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,142 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// EmailOutboxService is an autogenerated mock type for the EmailOutboxService type
+type EmailOutboxService struct {
+	mock.Mock
+}
+
+// GetOutboxEmail provides a mock function with given fields: ctx, id
+func (_m *EmailOutboxService) GetOutboxEmail(ctx context.Context, id int64) (specs.OutboxEmail, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutboxEmail")
+	}
+
+	var r0 specs.OutboxEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.OutboxEmail, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.OutboxEmail); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(specs.OutboxEmail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOutboxEmails provides a mock function with given fields: ctx, filter
+func (_m *EmailOutboxService) ListOutboxEmails(ctx context.Context, filter specs.ListOutboxEmailsFilter) (specs.ListOutboxEmailsResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOutboxEmails")
+	}
+
+	var r0 specs.ListOutboxEmailsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListOutboxEmailsFilter) (specs.ListOutboxEmailsResponse, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListOutboxEmailsFilter) specs.ListOutboxEmailsResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ListOutboxEmailsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListOutboxEmailsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResendOutboxEmail provides a mock function with given fields: ctx, id
+func (_m *EmailOutboxService) ResendOutboxEmail(ctx context.Context, id int64) (specs.OutboxEmail, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendOutboxEmail")
+	}
+
+	var r0 specs.OutboxEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.OutboxEmail, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.OutboxEmail); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(specs.OutboxEmail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendOutboxEmails provides a mock function with given fields: ctx
+func (_m *EmailOutboxService) SendOutboxEmails(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendOutboxEmails")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEmailOutboxService creates a new instance of EmailOutboxService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailOutboxService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailOutboxService {
+	mock := &EmailOutboxService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,170 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// EmailTemplateService is an autogenerated mock type for the EmailTemplateService type
+type EmailTemplateService struct {
+	mock.Mock
+}
+
+// GetEmailTemplate provides a mock function with given fields: ctx, kind
+func (_m *EmailTemplateService) GetEmailTemplate(ctx context.Context, kind string) (specs.EmailTemplate, error) {
+	ret := _m.Called(ctx, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmailTemplate")
+	}
+
+	var r0 specs.EmailTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (specs.EmailTemplate, error)); ok {
+		return rf(ctx, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) specs.EmailTemplate); ok {
+		r0 = rf(ctx, kind)
+	} else {
+		r0 = ret.Get(0).(specs.EmailTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEmailTemplateVersions provides a mock function with given fields: ctx, kind
+func (_m *EmailTemplateService) ListEmailTemplateVersions(ctx context.Context, kind string) (specs.ListEmailTemplatesResponse, error) {
+	ret := _m.Called(ctx, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmailTemplateVersions")
+	}
+
+	var r0 specs.ListEmailTemplatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (specs.ListEmailTemplatesResponse, error)); ok {
+		return rf(ctx, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) specs.ListEmailTemplatesResponse); ok {
+		r0 = rf(ctx, kind)
+	} else {
+		r0 = ret.Get(0).(specs.ListEmailTemplatesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEmailTemplates provides a mock function with given fields: ctx
+func (_m *EmailTemplateService) ListEmailTemplates(ctx context.Context) (specs.ListEmailTemplatesResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmailTemplates")
+	}
+
+	var r0 specs.ListEmailTemplatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.ListEmailTemplatesResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.ListEmailTemplatesResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.ListEmailTemplatesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewEmailTemplate provides a mock function with given fields: ctx, kind, req
+func (_m *EmailTemplateService) PreviewEmailTemplate(ctx context.Context, kind string, req specs.PreviewEmailTemplateRequest) (specs.EmailPreviewResponse, error) {
+	ret := _m.Called(ctx, kind, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewEmailTemplate")
+	}
+
+	var r0 specs.EmailPreviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, specs.PreviewEmailTemplateRequest) (specs.EmailPreviewResponse, error)); ok {
+		return rf(ctx, kind, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, specs.PreviewEmailTemplateRequest) specs.EmailPreviewResponse); ok {
+		r0 = rf(ctx, kind, req)
+	} else {
+		r0 = ret.Get(0).(specs.EmailPreviewResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, specs.PreviewEmailTemplateRequest) error); ok {
+		r1 = rf(ctx, kind, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEmailTemplate provides a mock function with given fields: ctx, kind, userID, req
+func (_m *EmailTemplateService) UpdateEmailTemplate(ctx context.Context, kind string, userID int, req specs.UpdateEmailTemplateRequest) (specs.EmailTemplate, error) {
+	ret := _m.Called(ctx, kind, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmailTemplate")
+	}
+
+	var r0 specs.EmailTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.UpdateEmailTemplateRequest) (specs.EmailTemplate, error)); ok {
+		return rf(ctx, kind, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.UpdateEmailTemplateRequest) specs.EmailTemplate); ok {
+		r0 = rf(ctx, kind, userID, req)
+	} else {
+		r0 = ret.Get(0).(specs.EmailTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, specs.UpdateEmailTemplateRequest) error); ok {
+		r1 = rf(ctx, kind, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEmailTemplateService creates a new instance of EmailTemplateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailTemplateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailTemplateService {
+	mock := &EmailTemplateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,114 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// InternalProfileService is an autogenerated mock type for the InternalProfileService type
+type InternalProfileService struct {
+	mock.Mock
+}
+
+// GetInternalProfile provides a mock function with given fields: ctx, employeeID
+func (_m *InternalProfileService) GetInternalProfile(ctx context.Context, employeeID string) (specs.InternalProfileV1, error) {
+	ret := _m.Called(ctx, employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInternalProfile")
+	}
+
+	var r0 specs.InternalProfileV1
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (specs.InternalProfileV1, error)); ok {
+		return rf(ctx, employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) specs.InternalProfileV1); ok {
+		r0 = rf(ctx, employeeID)
+	} else {
+		r0 = ret.Get(0).(specs.InternalProfileV1)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProfileChanges provides a mock function with given fields: ctx, filter
+func (_m *InternalProfileService) ListProfileChanges(ctx context.Context, filter specs.ListProfileChangesFilter) (specs.ProfileChangesResponseV1, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfileChanges")
+	}
+
+	var r0 specs.ProfileChangesResponseV1
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListProfileChangesFilter) (specs.ProfileChangesResponseV1, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListProfileChangesFilter) specs.ProfileChangesResponseV1); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ProfileChangesResponseV1)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListProfileChangesFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResolveEmployeeIDs provides a mock function with given fields: ctx, req
+func (_m *InternalProfileService) ResolveEmployeeIDs(ctx context.Context, req specs.ResolveEmployeesRequest) (specs.ResolveEmployeesResponseV1, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveEmployeeIDs")
+	}
+
+	var r0 specs.ResolveEmployeesResponseV1
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ResolveEmployeesRequest) (specs.ResolveEmployeesResponseV1, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ResolveEmployeesRequest) specs.ResolveEmployeesResponseV1); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(specs.ResolveEmployeesResponseV1)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ResolveEmployeesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewInternalProfileService creates a new instance of InternalProfileService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInternalProfileService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InternalProfileService {
+	mock := &InternalProfileService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// IntranetCacheService is an autogenerated mock type for the IntranetCacheService type
+type IntranetCacheService struct {
+	mock.Mock
+}
+
+// GetIntranetCacheStats provides a mock function with given fields: ctx
+func (_m *IntranetCacheService) GetIntranetCacheStats(ctx context.Context) specs.IntranetCacheStats {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIntranetCacheStats")
+	}
+
+	var r0 specs.IntranetCacheStats
+	if rf, ok := ret.Get(0).(func(context.Context) specs.IntranetCacheStats); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.IntranetCacheStats)
+	}
+
+	return r0
+}
+
+// InvalidateIntranetCache provides a mock function with given fields: ctx, employeeID
+func (_m *IntranetCacheService) InvalidateIntranetCache(ctx context.Context, employeeID string) {
+	_m.Called(ctx, employeeID)
+}
+
+// NewIntranetCacheService creates a new instance of IntranetCacheService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIntranetCacheService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IntranetCacheService {
+	mock := &IntranetCacheService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,205 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// IntranetSyncService is an autogenerated mock type for the IntranetSyncService type
+type IntranetSyncService struct {
+	mock.Mock
+}
+
+// GetSyncRun provides a mock function with given fields: ctx, runID
+func (_m *IntranetSyncService) GetSyncRun(ctx context.Context, runID int64) (specs.SyncRun, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSyncRun")
+	}
+
+	var r0 specs.SyncRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.SyncRun, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.SyncRun); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Get(0).(specs.SyncRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProfileSyncChanges provides a mock function with given fields: ctx, profileID
+func (_m *IntranetSyncService) ListProfileSyncChanges(ctx context.Context, profileID int) (specs.ListProfileSyncChangesResponse, error) {
+	ret := _m.Called(ctx, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfileSyncChanges")
+	}
+
+	var r0 specs.ListProfileSyncChangesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.ListProfileSyncChangesResponse, error)); ok {
+		return rf(ctx, profileID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.ListProfileSyncChangesResponse); ok {
+		r0 = rf(ctx, profileID)
+	} else {
+		r0 = ret.Get(0).(specs.ListProfileSyncChangesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSyncRuns provides a mock function with given fields: ctx, limit
+func (_m *IntranetSyncService) ListSyncRuns(ctx context.Context, limit int) (specs.ListSyncRunsResponse, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSyncRuns")
+	}
+
+	var r0 specs.ListSyncRunsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.ListSyncRunsResponse, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.ListSyncRunsResponse); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(specs.ListSyncRunsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunEmployeeSync provides a mock function with given fields: ctx, trigger, triggeredByID, opts
+func (_m *IntranetSyncService) RunEmployeeSync(ctx context.Context, trigger string, triggeredByID int, opts specs.SyncEmployeesOptions) (specs.SyncRun, specs.SyncEmployeesReport, error) {
+	ret := _m.Called(ctx, trigger, triggeredByID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunEmployeeSync")
+	}
+
+	var r0 specs.SyncRun
+	var r1 specs.SyncEmployeesReport
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.SyncEmployeesOptions) (specs.SyncRun, specs.SyncEmployeesReport, error)); ok {
+		return rf(ctx, trigger, triggeredByID, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.SyncEmployeesOptions) specs.SyncRun); ok {
+		r0 = rf(ctx, trigger, triggeredByID, opts)
+	} else {
+		r0 = ret.Get(0).(specs.SyncRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, specs.SyncEmployeesOptions) specs.SyncEmployeesReport); ok {
+		r1 = rf(ctx, trigger, triggeredByID, opts)
+	} else {
+		r1 = ret.Get(1).(specs.SyncEmployeesReport)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, specs.SyncEmployeesOptions) error); ok {
+		r2 = rf(ctx, trigger, triggeredByID, opts)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// StartEmployeeSync provides a mock function with given fields: ctx, trigger, triggeredByID, opts
+func (_m *IntranetSyncService) StartEmployeeSync(ctx context.Context, trigger string, triggeredByID int, opts specs.SyncEmployeesOptions) (specs.SyncRun, error) {
+	ret := _m.Called(ctx, trigger, triggeredByID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartEmployeeSync")
+	}
+
+	var r0 specs.SyncRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.SyncEmployeesOptions) (specs.SyncRun, error)); ok {
+		return rf(ctx, trigger, triggeredByID, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.SyncEmployeesOptions) specs.SyncRun); ok {
+		r0 = rf(ctx, trigger, triggeredByID, opts)
+	} else {
+		r0 = ret.Get(0).(specs.SyncRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, specs.SyncEmployeesOptions) error); ok {
+		r1 = rf(ctx, trigger, triggeredByID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SyncEmployees provides a mock function with given fields: ctx, opts
+func (_m *IntranetSyncService) SyncEmployees(ctx context.Context, opts specs.SyncEmployeesOptions) (specs.SyncEmployeesReport, error) {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncEmployees")
+	}
+
+	var r0 specs.SyncEmployeesReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.SyncEmployeesOptions) (specs.SyncEmployeesReport, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.SyncEmployeesOptions) specs.SyncEmployeesReport); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		r0 = ret.Get(0).(specs.SyncEmployeesReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.SyncEmployeesOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIntranetSyncService creates a new instance of IntranetSyncService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIntranetSyncService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IntranetSyncService {
+	mock := &IntranetSyncService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,170 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// IntranetWebhookService is an autogenerated mock type for the IntranetWebhookService type
+type IntranetWebhookService struct {
+	mock.Mock
+}
+
+// GetIntranetWebhookEvent provides a mock function with given fields: ctx, id
+func (_m *IntranetWebhookService) GetIntranetWebhookEvent(ctx context.Context, id int64) (specs.IntranetWebhookEventRecord, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIntranetWebhookEvent")
+	}
+
+	var r0 specs.IntranetWebhookEventRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.IntranetWebhookEventRecord, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.IntranetWebhookEventRecord); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(specs.IntranetWebhookEventRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListIntranetWebhookEvents provides a mock function with given fields: ctx, filter
+func (_m *IntranetWebhookService) ListIntranetWebhookEvents(ctx context.Context, filter specs.ListIntranetWebhookEventsFilter) (specs.ListIntranetWebhookEventsResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIntranetWebhookEvents")
+	}
+
+	var r0 specs.ListIntranetWebhookEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListIntranetWebhookEventsFilter) (specs.ListIntranetWebhookEventsResponse, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListIntranetWebhookEventsFilter) specs.ListIntranetWebhookEventsResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ListIntranetWebhookEventsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListIntranetWebhookEventsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProcessIntranetWebhookEvents provides a mock function with given fields: ctx
+func (_m *IntranetWebhookService) ProcessIntranetWebhookEvents(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessIntranetWebhookEvents")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReceiveIntranetWebhook provides a mock function with given fields: ctx, event, payload
+func (_m *IntranetWebhookService) ReceiveIntranetWebhook(ctx context.Context, event specs.IntranetWebhookEvent, payload []byte) (specs.ReceiveIntranetWebhookResponse, error) {
+	ret := _m.Called(ctx, event, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveIntranetWebhook")
+	}
+
+	var r0 specs.ReceiveIntranetWebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.IntranetWebhookEvent, []byte) (specs.ReceiveIntranetWebhookResponse, error)); ok {
+		return rf(ctx, event, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.IntranetWebhookEvent, []byte) specs.ReceiveIntranetWebhookResponse); ok {
+		r0 = rf(ctx, event, payload)
+	} else {
+		r0 = ret.Get(0).(specs.ReceiveIntranetWebhookResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.IntranetWebhookEvent, []byte) error); ok {
+		r1 = rf(ctx, event, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetryIntranetWebhookEvent provides a mock function with given fields: ctx, id
+func (_m *IntranetWebhookService) RetryIntranetWebhookEvent(ctx context.Context, id int64) (specs.IntranetWebhookEventRecord, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryIntranetWebhookEvent")
+	}
+
+	var r0 specs.IntranetWebhookEventRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.IntranetWebhookEventRecord, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.IntranetWebhookEventRecord); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(specs.IntranetWebhookEventRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIntranetWebhookService creates a new instance of IntranetWebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIntranetWebhookService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IntranetWebhookService {
+	mock := &IntranetWebhookService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,132 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// InvitationService is an autogenerated mock type for the InvitationService type
+type InvitationService struct {
+	mock.Mock
+}
+
+// ListInvitations provides a mock function with given fields: ctx, filter
+func (_m *InvitationService) ListInvitations(ctx context.Context, filter specs.ListInvitationsFilter) (specs.ListInvitationsResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvitations")
+	}
+
+	var r0 specs.ListInvitationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListInvitationsFilter) (specs.ListInvitationsResponse, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListInvitationsFilter) specs.ListInvitationsResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ListInvitationsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListInvitationsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProfileInvitations provides a mock function with given fields: ctx, profileID
+func (_m *InvitationService) ListProfileInvitations(ctx context.Context, profileID int) (specs.ListInvitationsResponse, error) {
+	ret := _m.Called(ctx, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfileInvitations")
+	}
+
+	var r0 specs.ListInvitationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.ListInvitationsResponse, error)); ok {
+		return rf(ctx, profileID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.ListInvitationsResponse); ok {
+		r0 = rf(ctx, profileID)
+	} else {
+		r0 = ret.Get(0).(specs.ListInvitationsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResendInvitation provides a mock function with given fields: ctx, userID, profileID
+func (_m *InvitationService) ResendInvitation(ctx context.Context, userID int, profileID int) error {
+	ret := _m.Called(ctx, userID, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, userID, profileID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendInvitationReminders provides a mock function with given fields: ctx
+func (_m *InvitationService) SendInvitationReminders(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendInvitationReminders")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewInvitationService creates a new instance of InvitationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInvitationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InvitationService {
+	mock := &InvitationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,174 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// NotificationService is an autogenerated mock type for the NotificationService type
+type NotificationService struct {
+	mock.Mock
+}
+
+// CountUnreadNotifications provides a mock function with given fields: ctx, userID
+func (_m *NotificationService) CountUnreadNotifications(ctx context.Context, userID int) (int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnreadNotifications")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListNotifications provides a mock function with given fields: ctx, userID, filter
+func (_m *NotificationService) ListNotifications(ctx context.Context, userID int, filter specs.ListNotificationsFilter) (specs.ListNotificationsResponse, error) {
+	ret := _m.Called(ctx, userID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotifications")
+	}
+
+	var r0 specs.ListNotificationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListNotificationsFilter) (specs.ListNotificationsResponse, error)); ok {
+		return rf(ctx, userID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListNotificationsFilter) specs.ListNotificationsResponse); ok {
+		r0 = rf(ctx, userID, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ListNotificationsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListNotificationsFilter) error); ok {
+		r1 = rf(ctx, userID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAllNotificationsRead provides a mock function with given fields: ctx, userID
+func (_m *NotificationService) MarkAllNotificationsRead(ctx context.Context, userID int) (specs.MarkAllNotificationsReadResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllNotificationsRead")
+	}
+
+	var r0 specs.MarkAllNotificationsReadResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.MarkAllNotificationsReadResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.MarkAllNotificationsReadResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(specs.MarkAllNotificationsReadResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkNotificationRead provides a mock function with given fields: ctx, userID, notificationID
+func (_m *NotificationService) MarkNotificationRead(ctx context.Context, userID int, notificationID int64) (specs.Notification, error) {
+	ret := _m.Called(ctx, userID, notificationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNotificationRead")
+	}
+
+	var r0 specs.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int64) (specs.Notification, error)); ok {
+		return rf(ctx, userID, notificationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int64) specs.Notification); ok {
+		r0 = rf(ctx, userID, notificationID)
+	} else {
+		r0 = ret.Get(0).(specs.Notification)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int64) error); ok {
+		r1 = rf(ctx, userID, notificationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeNotifications provides a mock function with given fields: userID
+func (_m *NotificationService) SubscribeNotifications(userID int) (<-chan specs.NotificationEvent, func()) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeNotifications")
+	}
+
+	var r0 <-chan specs.NotificationEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func(int) (<-chan specs.NotificationEvent, func())); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) <-chan specs.NotificationEvent); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan specs.NotificationEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) func()); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// NewNotificationService creates a new instance of NotificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationService {
+	mock := &NotificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -15,6 +15,34 @@ type ProjectService struct {
 	mock.Mock
 }
 
+// ApplyIntranetProjectProposals provides a mock function with given fields: ctx, profileID, userID, req
+func (_m *ProjectService) ApplyIntranetProjectProposals(ctx context.Context, profileID int, userID int, req specs.ApplyIntranetProjectsRequest) (specs.ApplyIntranetProjectsResponse, error) {
+	ret := _m.Called(ctx, profileID, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyIntranetProjectProposals")
+	}
+
+	var r0 specs.ApplyIntranetProjectsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, specs.ApplyIntranetProjectsRequest) (specs.ApplyIntranetProjectsResponse, error)); ok {
+		return rf(ctx, profileID, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, specs.ApplyIntranetProjectsRequest) specs.ApplyIntranetProjectsResponse); ok {
+		r0 = rf(ctx, profileID, userID, req)
+	} else {
+		r0 = ret.Get(0).(specs.ApplyIntranetProjectsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, specs.ApplyIntranetProjectsRequest) error); ok {
+		r1 = rf(ctx, profileID, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateProject provides a mock function with given fields: ctx, projDetail, profileID, userID
 func (_m *ProjectService) CreateProject(ctx context.Context, projDetail specs.CreateProjectRequest, profileID int, userID int) (int, error) {
 	ret := _m.Called(ctx, projDetail, profileID, userID)
@@ -61,6 +89,34 @@ func (_m *ProjectService) DeleteProject(ctx context.Context, profileID int, proj
 	return r0
 }
 
+// ListIntranetProjectProposals provides a mock function with given fields: ctx, profileID
+func (_m *ProjectService) ListIntranetProjectProposals(ctx context.Context, profileID int) (specs.IntranetProjectProposalsResponse, error) {
+	ret := _m.Called(ctx, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIntranetProjectProposals")
+	}
+
+	var r0 specs.IntranetProjectProposalsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.IntranetProjectProposalsResponse, error)); ok {
+		return rf(ctx, profileID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.IntranetProjectProposalsResponse); ok {
+		r0 = rf(ctx, profileID)
+	} else {
+		r0 = ret.Get(0).(specs.IntranetProjectProposalsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListProjects provides a mock function with given fields: ctx, profileID, filter
 func (_m *ProjectService) ListProjects(ctx context.Context, profileID int, filter specs.ListProjectsFilter) ([]specs.ProjectResponse, error) {
 	ret := _m.Called(ctx, profileID, filter)
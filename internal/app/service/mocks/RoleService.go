@@ -0,0 +1,232 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// RoleService is an autogenerated mock type for the RoleService type
+type RoleService struct {
+	mock.Mock
+}
+
+// AssignProfileManager provides a mock function with given fields: ctx, profileID, req
+func (_m *RoleService) AssignProfileManager(ctx context.Context, profileID int, req specs.AssignManagerRequest) error {
+	ret := _m.Called(ctx, profileID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignProfileManager")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.AssignManagerRequest) error); ok {
+		r0 = rf(ctx, profileID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssignUserRole provides a mock function with given fields: ctx, actorID, userID, req
+func (_m *RoleService) AssignUserRole(ctx context.Context, actorID int, userID int, req specs.AssignRoleRequest) error {
+	ret := _m.Called(ctx, actorID, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignUserRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, specs.AssignRoleRequest) error); ok {
+		r0 = rf(ctx, actorID, userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateRole provides a mock function with given fields: ctx, req
+func (_m *RoleService) CreateRole(ctx context.Context, req specs.CreateRoleRequest) (int, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRole")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.CreateRoleRequest) (int, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.CreateRoleRequest) int); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.CreateRoleRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRole provides a mock function with given fields: ctx, roleID
+func (_m *RoleService) DeleteRole(ctx context.Context, roleID int) error {
+	ret := _m.Called(ctx, roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, roleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetPermissionScope provides a mock function with given fields: ctx, role, permission
+func (_m *RoleService) GetPermissionScope(ctx context.Context, role string, permission string) (string, error) {
+	ret := _m.Called(ctx, role, permission)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPermissionScope")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, role, permission)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, role, permission)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, role, permission)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsReportee provides a mock function with given fields: ctx, managerID, profileID
+func (_m *RoleService) IsReportee(ctx context.Context, managerID int, profileID int) (bool, error) {
+	ret := _m.Called(ctx, managerID, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsReportee")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (bool, error)); ok {
+		return rf(ctx, managerID, profileID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) bool); ok {
+		r0 = rf(ctx, managerID, profileID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, managerID, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPermissions provides a mock function with given fields: ctx
+func (_m *RoleService) ListPermissions(ctx context.Context) specs.ListPermissionsResponse {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPermissions")
+	}
+
+	var r0 specs.ListPermissionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context) specs.ListPermissionsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.ListPermissionsResponse)
+	}
+
+	return r0
+}
+
+// ListRoles provides a mock function with given fields: ctx
+func (_m *RoleService) ListRoles(ctx context.Context) (specs.ListRolesResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 specs.ListRolesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.ListRolesResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.ListRolesResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.ListRolesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRole provides a mock function with given fields: ctx, roleID, req
+func (_m *RoleService) UpdateRole(ctx context.Context, roleID int, req specs.UpdateRoleRequest) error {
+	ret := _m.Called(ctx, roleID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.UpdateRoleRequest) error); ok {
+		r0 = rf(ctx, roleID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRoleService creates a new instance of RoleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRoleService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RoleService {
+	mock := &RoleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
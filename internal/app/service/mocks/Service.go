@@ -15,17 +15,63 @@ type Service struct {
 	mock.Mock
 }
 
-// BackupAllProfiles provides a mock function with no fields
-func (_m *Service) BackupAllProfiles() error {
-	ret := _m.Called()
+// ApplyIntranetProjectProposals provides a mock function with given fields: ctx, profileID, userID, req
+func (_m *Service) ApplyIntranetProjectProposals(ctx context.Context, profileID int, userID int, req specs.ApplyIntranetProjectsRequest) (specs.ApplyIntranetProjectsResponse, error) {
+	ret := _m.Called(ctx, profileID, userID, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BackupAllProfiles")
+		panic("no return value specified for ApplyIntranetProjectProposals")
+	}
+
+	var r0 specs.ApplyIntranetProjectsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, specs.ApplyIntranetProjectsRequest) (specs.ApplyIntranetProjectsResponse, error)); ok {
+		return rf(ctx, profileID, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, specs.ApplyIntranetProjectsRequest) specs.ApplyIntranetProjectsResponse); ok {
+		r0 = rf(ctx, profileID, userID, req)
+	} else {
+		r0 = ret.Get(0).(specs.ApplyIntranetProjectsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, specs.ApplyIntranetProjectsRequest) error); ok {
+		r1 = rf(ctx, profileID, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssignProfileManager provides a mock function with given fields: ctx, profileID, req
+func (_m *Service) AssignProfileManager(ctx context.Context, profileID int, req specs.AssignManagerRequest) error {
+	ret := _m.Called(ctx, profileID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignProfileManager")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.AssignManagerRequest) error); ok {
+		r0 = rf(ctx, profileID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssignUserRole provides a mock function with given fields: ctx, actorID, userID, req
+func (_m *Service) AssignUserRole(ctx context.Context, actorID int, userID int, req specs.AssignRoleRequest) error {
+	ret := _m.Called(ctx, actorID, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignUserRole")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, specs.AssignRoleRequest) error); ok {
+		r0 = rf(ctx, actorID, userID, req)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -33,6 +79,146 @@ func (_m *Service) BackupAllProfiles() error {
 	return r0
 }
 
+// AuthenticateAPIKey provides a mock function with given fields: ctx, key, clientIP
+func (_m *Service) AuthenticateAPIKey(ctx context.Context, key string, clientIP string) (specs.APIKeyPrincipal, error) {
+	ret := _m.Called(ctx, key, clientIP)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthenticateAPIKey")
+	}
+
+	var r0 specs.APIKeyPrincipal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (specs.APIKeyPrincipal, error)); ok {
+		return rf(ctx, key, clientIP)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) specs.APIKeyPrincipal); ok {
+		r0 = rf(ctx, key, clientIP)
+	} else {
+		r0 = ret.Get(0).(specs.APIKeyPrincipal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, key, clientIP)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BackupAllProfiles provides a mock function with given fields: ctx
+func (_m *Service) BackupAllProfiles(ctx context.Context) (specs.BackupReport, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackupAllProfiles")
+	}
+
+	var r0 specs.BackupReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.BackupReport, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.BackupReport); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.BackupReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckBackup provides a mock function with given fields: ctx, path
+func (_m *Service) CheckBackup(ctx context.Context, path string) (specs.BackupReport, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckBackup")
+	}
+
+	var r0 specs.BackupReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (specs.BackupReport, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) specs.BackupReport); ok {
+		r0 = rf(ctx, path)
+	} else {
+		r0 = ret.Get(0).(specs.BackupReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountUnreadNotifications provides a mock function with given fields: ctx, userID
+func (_m *Service) CountUnreadNotifications(ctx context.Context, userID int) (int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnreadNotifications")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, userID, req
+func (_m *Service) CreateAPIKey(ctx context.Context, userID int, req specs.CreateAPIKeyRequest) (specs.APIKeySecretResponse, error) {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 specs.APIKeySecretResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.CreateAPIKeyRequest) (specs.APIKeySecretResponse, error)); ok {
+		return rf(ctx, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.CreateAPIKeyRequest) specs.APIKeySecretResponse); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		r0 = ret.Get(0).(specs.APIKeySecretResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, specs.CreateAPIKeyRequest) error); ok {
+		r1 = rf(ctx, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAchievement provides a mock function with given fields: ctx, cDetail, profileID, userID
 func (_m *Service) CreateAchievement(ctx context.Context, cDetail specs.CreateAchievementRequest, profileID int, userID int) (int, error) {
 	ret := _m.Called(ctx, cDetail, profileID, userID)
@@ -145,6 +331,34 @@ func (_m *Service) CreateExperience(ctx context.Context, expDetail specs.CreateE
 	return r0, r1
 }
 
+// CreateFullProfile provides a mock function with given fields: ctx, req, userID
+func (_m *Service) CreateFullProfile(ctx context.Context, req specs.CreateFullProfileRequest, userID int) (int, error) {
+	ret := _m.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFullProfile")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.CreateFullProfileRequest, int) (int, error)); ok {
+		return rf(ctx, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.CreateFullProfileRequest, int) int); ok {
+		r0 = rf(ctx, req, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.CreateFullProfileRequest, int) error); ok {
+		r1 = rf(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateProfile provides a mock function with given fields: ctx, profileDetail, userID
 func (_m *Service) CreateProfile(ctx context.Context, profileDetail specs.CreateProfileRequest, userID int) (int, error) {
 	ret := _m.Called(ctx, profileDetail, userID)
@@ -201,6 +415,62 @@ func (_m *Service) CreateProject(ctx context.Context, projDetail specs.CreatePro
 	return r0, r1
 }
 
+// CreateRole provides a mock function with given fields: ctx, req
+func (_m *Service) CreateRole(ctx context.Context, req specs.CreateRoleRequest) (int, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRole")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.CreateRoleRequest) (int, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.CreateRoleRequest) int); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.CreateRoleRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateUser provides a mock function with given fields: ctx, req
+func (_m *Service) CreateUser(ctx context.Context, req specs.CreateUserRequest) (int, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.CreateUserRequest) (int, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.CreateUserRequest) int); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.CreateUserRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteAchievement provides a mock function with given fields: ctx, profileID, achievementID
 func (_m *Service) DeleteAchievement(ctx context.Context, profileID int, achievementID int) error {
 	ret := _m.Called(ctx, profileID, achievementID)
@@ -309,6 +579,42 @@ func (_m *Service) DeleteProject(ctx context.Context, profileID int, projectID i
 	return r0
 }
 
+// DeleteRole provides a mock function with given fields: ctx, roleID
+func (_m *Service) DeleteRole(ctx context.Context, roleID int) error {
+	ret := _m.Called(ctx, roleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, roleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUser provides a mock function with given fields: ctx, actorID, userID
+func (_m *Service) DeleteUser(ctx context.Context, actorID int, userID int) error {
+	ret := _m.Called(ctx, actorID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, actorID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GenerateLoginToken provides a mock function with given fields: ctx, filter
 func (_m *Service) GenerateLoginToken(ctx context.Context, filter specs.UserInfoFilter) (specs.LoginResponse, error) {
 	ret := _m.Called(ctx, filter)
@@ -337,6 +643,80 @@ func (_m *Service) GenerateLoginToken(ctx context.Context, filter specs.UserInfo
 	return r0, r1
 }
 
+// GetEmailTemplate provides a mock function with given fields: ctx, kind
+func (_m *Service) GetEmailTemplate(ctx context.Context, kind string) (specs.EmailTemplate, error) {
+	ret := _m.Called(ctx, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmailTemplate")
+	}
+
+	var r0 specs.EmailTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (specs.EmailTemplate, error)); ok {
+		return rf(ctx, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) specs.EmailTemplate); ok {
+		r0 = rf(ctx, kind)
+	} else {
+		r0 = ret.Get(0).(specs.EmailTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInternalProfile provides a mock function with given fields: ctx, employeeID
+func (_m *Service) GetInternalProfile(ctx context.Context, employeeID string) (specs.InternalProfileV1, error) {
+	ret := _m.Called(ctx, employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInternalProfile")
+	}
+
+	var r0 specs.InternalProfileV1
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (specs.InternalProfileV1, error)); ok {
+		return rf(ctx, employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) specs.InternalProfileV1); ok {
+		r0 = rf(ctx, employeeID)
+	} else {
+		r0 = ret.Get(0).(specs.InternalProfileV1)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIntranetCacheStats provides a mock function with given fields: ctx
+func (_m *Service) GetIntranetCacheStats(ctx context.Context) specs.IntranetCacheStats {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIntranetCacheStats")
+	}
+
+	var r0 specs.IntranetCacheStats
+	if rf, ok := ret.Get(0).(func(context.Context) specs.IntranetCacheStats); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.IntranetCacheStats)
+	}
+
+	return r0
+}
+
 // GetIntranetEmployee provides a mock function with given fields: ctx, employeeID
 func (_m *Service) GetIntranetEmployee(ctx context.Context, employeeID string) (specs.IntranetEmployeeResponse, error) {
 	ret := _m.Called(ctx, employeeID)
@@ -365,26 +745,1207 @@ func (_m *Service) GetIntranetEmployee(ctx context.Context, employeeID string) (
 	return r0, r1
 }
 
-// GetProfile provides a mock function with given fields: ctx, id
-func (_m *Service) GetProfile(ctx context.Context, id int) (specs.ResponseProfile, error) {
+// GetIntranetWebhookEvent provides a mock function with given fields: ctx, id
+func (_m *Service) GetIntranetWebhookEvent(ctx context.Context, id int64) (specs.IntranetWebhookEventRecord, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIntranetWebhookEvent")
+	}
+
+	var r0 specs.IntranetWebhookEventRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.IntranetWebhookEventRecord, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.IntranetWebhookEventRecord); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(specs.IntranetWebhookEventRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutboxEmail provides a mock function with given fields: ctx, id
+func (_m *Service) GetOutboxEmail(ctx context.Context, id int64) (specs.OutboxEmail, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutboxEmail")
+	}
+
+	var r0 specs.OutboxEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.OutboxEmail, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.OutboxEmail); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(specs.OutboxEmail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPermissionScope provides a mock function with given fields: ctx, role, permission
+func (_m *Service) GetPermissionScope(ctx context.Context, role string, permission string) (string, error) {
+	ret := _m.Called(ctx, role, permission)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPermissionScope")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, role, permission)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, role, permission)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, role, permission)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProfile provides a mock function with given fields: ctx, id
+func (_m *Service) GetProfile(ctx context.Context, id int) (specs.ResponseProfile, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfile")
+	}
+
+	var r0 specs.ResponseProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.ResponseProfile, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.ResponseProfile); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(specs.ResponseProfile)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSyncRun provides a mock function with given fields: ctx, runID
+func (_m *Service) GetSyncRun(ctx context.Context, runID int64) (specs.SyncRun, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSyncRun")
+	}
+
+	var r0 specs.SyncRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.SyncRun, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.SyncRun); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Get(0).(specs.SyncRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUser provides a mock function with given fields: ctx, userID
+func (_m *Service) GetUser(ctx context.Context, userID int) (specs.UserResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 specs.UserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.UserResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.UserResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(specs.UserResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InvalidateIntranetCache provides a mock function with given fields: ctx, employeeID
+func (_m *Service) InvalidateIntranetCache(ctx context.Context, employeeID string) {
+	_m.Called(ctx, employeeID)
+}
+
+// InviteAdmin provides a mock function with given fields: ctx, userID, req
+func (_m *Service) InviteAdmin(ctx context.Context, userID int, req specs.AdminInviteRequest) error {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteAdmin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.AdminInviteRequest) error); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IsReportee provides a mock function with given fields: ctx, managerID, profileID
+func (_m *Service) IsReportee(ctx context.Context, managerID int, profileID int) (bool, error) {
+	ret := _m.Called(ctx, managerID, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsReportee")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (bool, error)); ok {
+		return rf(ctx, managerID, profileID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) bool); ok {
+		r0 = rf(ctx, managerID, profileID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, managerID, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAPIKeys provides a mock function with given fields: ctx
+func (_m *Service) ListAPIKeys(ctx context.Context) (specs.ListAPIKeysResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 specs.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.ListAPIKeysResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.ListAPIKeysResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.ListAPIKeysResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAchievements provides a mock function with given fields: ctx, profileID, filter
+func (_m *Service) ListAchievements(ctx context.Context, profileID int, filter specs.ListAchievementFilter) ([]specs.AchievementResponse, error) {
+	ret := _m.Called(ctx, profileID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAchievements")
+	}
+
+	var r0 []specs.AchievementResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListAchievementFilter) ([]specs.AchievementResponse, error)); ok {
+		return rf(ctx, profileID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListAchievementFilter) []specs.AchievementResponse); ok {
+		r0 = rf(ctx, profileID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.AchievementResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListAchievementFilter) error); ok {
+		r1 = rf(ctx, profileID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuditEvents provides a mock function with given fields: ctx, filter
+func (_m *Service) ListAuditEvents(ctx context.Context, filter specs.ListAuditEventsFilter) (specs.ListAuditEventsResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEvents")
+	}
+
+	var r0 specs.ListAuditEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListAuditEventsFilter) (specs.ListAuditEventsResponse, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListAuditEventsFilter) specs.ListAuditEventsResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ListAuditEventsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListAuditEventsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBackups provides a mock function with given fields: ctx
+func (_m *Service) ListBackups(ctx context.Context) (specs.ListBackupsResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBackups")
+	}
+
+	var r0 specs.ListBackupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.ListBackupsResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.ListBackupsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.ListBackupsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCertificates provides a mock function with given fields: ctx, profileID, fitler
+func (_m *Service) ListCertificates(ctx context.Context, profileID int, fitler specs.ListCertificateFilter) ([]specs.CertificateResponse, error) {
+	ret := _m.Called(ctx, profileID, fitler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCertificates")
+	}
+
+	var r0 []specs.CertificateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListCertificateFilter) ([]specs.CertificateResponse, error)); ok {
+		return rf(ctx, profileID, fitler)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListCertificateFilter) []specs.CertificateResponse); ok {
+		r0 = rf(ctx, profileID, fitler)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.CertificateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListCertificateFilter) error); ok {
+		r1 = rf(ctx, profileID, fitler)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEducations provides a mock function with given fields: ctx, id, filter
+func (_m *Service) ListEducations(ctx context.Context, id int, filter specs.ListEducationsFilter) ([]specs.EducationResponse, error) {
+	ret := _m.Called(ctx, id, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEducations")
+	}
+
+	var r0 []specs.EducationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListEducationsFilter) ([]specs.EducationResponse, error)); ok {
+		return rf(ctx, id, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListEducationsFilter) []specs.EducationResponse); ok {
+		r0 = rf(ctx, id, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.EducationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListEducationsFilter) error); ok {
+		r1 = rf(ctx, id, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEmailTemplateVersions provides a mock function with given fields: ctx, kind
+func (_m *Service) ListEmailTemplateVersions(ctx context.Context, kind string) (specs.ListEmailTemplatesResponse, error) {
+	ret := _m.Called(ctx, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmailTemplateVersions")
+	}
+
+	var r0 specs.ListEmailTemplatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (specs.ListEmailTemplatesResponse, error)); ok {
+		return rf(ctx, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) specs.ListEmailTemplatesResponse); ok {
+		r0 = rf(ctx, kind)
+	} else {
+		r0 = ret.Get(0).(specs.ListEmailTemplatesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEmailTemplates provides a mock function with given fields: ctx
+func (_m *Service) ListEmailTemplates(ctx context.Context) (specs.ListEmailTemplatesResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmailTemplates")
+	}
+
+	var r0 specs.ListEmailTemplatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.ListEmailTemplatesResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.ListEmailTemplatesResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.ListEmailTemplatesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExperiences provides a mock function with given fields: ctx, id, filter
+func (_m *Service) ListExperiences(ctx context.Context, id int, filter specs.ListExperiencesFilter) ([]specs.ExperienceResponse, error) {
+	ret := _m.Called(ctx, id, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExperiences")
+	}
+
+	var r0 []specs.ExperienceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListExperiencesFilter) ([]specs.ExperienceResponse, error)); ok {
+		return rf(ctx, id, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListExperiencesFilter) []specs.ExperienceResponse); ok {
+		r0 = rf(ctx, id, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.ExperienceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListExperiencesFilter) error); ok {
+		r1 = rf(ctx, id, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListIntranetProjectProposals provides a mock function with given fields: ctx, profileID
+func (_m *Service) ListIntranetProjectProposals(ctx context.Context, profileID int) (specs.IntranetProjectProposalsResponse, error) {
+	ret := _m.Called(ctx, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIntranetProjectProposals")
+	}
+
+	var r0 specs.IntranetProjectProposalsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.IntranetProjectProposalsResponse, error)); ok {
+		return rf(ctx, profileID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.IntranetProjectProposalsResponse); ok {
+		r0 = rf(ctx, profileID)
+	} else {
+		r0 = ret.Get(0).(specs.IntranetProjectProposalsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListIntranetWebhookEvents provides a mock function with given fields: ctx, filter
+func (_m *Service) ListIntranetWebhookEvents(ctx context.Context, filter specs.ListIntranetWebhookEventsFilter) (specs.ListIntranetWebhookEventsResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIntranetWebhookEvents")
+	}
+
+	var r0 specs.ListIntranetWebhookEventsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListIntranetWebhookEventsFilter) (specs.ListIntranetWebhookEventsResponse, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListIntranetWebhookEventsFilter) specs.ListIntranetWebhookEventsResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ListIntranetWebhookEventsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListIntranetWebhookEventsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInvitations provides a mock function with given fields: ctx, filter
+func (_m *Service) ListInvitations(ctx context.Context, filter specs.ListInvitationsFilter) (specs.ListInvitationsResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvitations")
+	}
+
+	var r0 specs.ListInvitationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListInvitationsFilter) (specs.ListInvitationsResponse, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListInvitationsFilter) specs.ListInvitationsResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ListInvitationsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListInvitationsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListNotifications provides a mock function with given fields: ctx, userID, filter
+func (_m *Service) ListNotifications(ctx context.Context, userID int, filter specs.ListNotificationsFilter) (specs.ListNotificationsResponse, error) {
+	ret := _m.Called(ctx, userID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotifications")
+	}
+
+	var r0 specs.ListNotificationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListNotificationsFilter) (specs.ListNotificationsResponse, error)); ok {
+		return rf(ctx, userID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListNotificationsFilter) specs.ListNotificationsResponse); ok {
+		r0 = rf(ctx, userID, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ListNotificationsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListNotificationsFilter) error); ok {
+		r1 = rf(ctx, userID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOutboxEmails provides a mock function with given fields: ctx, filter
+func (_m *Service) ListOutboxEmails(ctx context.Context, filter specs.ListOutboxEmailsFilter) (specs.ListOutboxEmailsResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOutboxEmails")
+	}
+
+	var r0 specs.ListOutboxEmailsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListOutboxEmailsFilter) (specs.ListOutboxEmailsResponse, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListOutboxEmailsFilter) specs.ListOutboxEmailsResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ListOutboxEmailsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListOutboxEmailsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPermissions provides a mock function with given fields: ctx
+func (_m *Service) ListPermissions(ctx context.Context) specs.ListPermissionsResponse {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPermissions")
+	}
+
+	var r0 specs.ListPermissionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context) specs.ListPermissionsResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.ListPermissionsResponse)
+	}
+
+	return r0
+}
+
+// ListProfileChanges provides a mock function with given fields: ctx, filter
+func (_m *Service) ListProfileChanges(ctx context.Context, filter specs.ListProfileChangesFilter) (specs.ProfileChangesResponseV1, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfileChanges")
+	}
+
+	var r0 specs.ProfileChangesResponseV1
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListProfileChangesFilter) (specs.ProfileChangesResponseV1, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListProfileChangesFilter) specs.ProfileChangesResponseV1); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ProfileChangesResponseV1)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListProfileChangesFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProfileInvitations provides a mock function with given fields: ctx, profileID
+func (_m *Service) ListProfileInvitations(ctx context.Context, profileID int) (specs.ListInvitationsResponse, error) {
+	ret := _m.Called(ctx, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfileInvitations")
+	}
+
+	var r0 specs.ListInvitationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.ListInvitationsResponse, error)); ok {
+		return rf(ctx, profileID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.ListInvitationsResponse); ok {
+		r0 = rf(ctx, profileID)
+	} else {
+		r0 = ret.Get(0).(specs.ListInvitationsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProfileSyncChanges provides a mock function with given fields: ctx, profileID
+func (_m *Service) ListProfileSyncChanges(ctx context.Context, profileID int) (specs.ListProfileSyncChangesResponse, error) {
+	ret := _m.Called(ctx, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfileSyncChanges")
+	}
+
+	var r0 specs.ListProfileSyncChangesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.ListProfileSyncChangesResponse, error)); ok {
+		return rf(ctx, profileID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.ListProfileSyncChangesResponse); ok {
+		r0 = rf(ctx, profileID)
+	} else {
+		r0 = ret.Get(0).(specs.ListProfileSyncChangesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProfiles provides a mock function with given fields: ctx, filter
+func (_m *Service) ListProfiles(ctx context.Context, filter specs.ListProfilesFilter) ([]specs.ResponseListProfiles, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfiles")
+	}
+
+	var r0 []specs.ResponseListProfiles
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListProfilesFilter) ([]specs.ResponseListProfiles, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListProfilesFilter) []specs.ResponseListProfiles); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.ResponseListProfiles)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListProfilesFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProjects provides a mock function with given fields: ctx, profileID, filter
+func (_m *Service) ListProjects(ctx context.Context, profileID int, filter specs.ListProjectsFilter) ([]specs.ProjectResponse, error) {
+	ret := _m.Called(ctx, profileID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjects")
+	}
+
+	var r0 []specs.ProjectResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListProjectsFilter) ([]specs.ProjectResponse, error)); ok {
+		return rf(ctx, profileID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListProjectsFilter) []specs.ProjectResponse); ok {
+		r0 = rf(ctx, profileID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.ProjectResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListProjectsFilter) error); ok {
+		r1 = rf(ctx, profileID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoles provides a mock function with given fields: ctx
+func (_m *Service) ListRoles(ctx context.Context) (specs.ListRolesResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 specs.ListRolesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.ListRolesResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.ListRolesResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.ListRolesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSkills provides a mock function with given fields: ctx
+func (_m *Service) ListSkills(ctx context.Context) (specs.ListSkills, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSkills")
+	}
+
+	var r0 specs.ListSkills
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.ListSkills, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.ListSkills); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.ListSkills)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSyncRuns provides a mock function with given fields: ctx, limit
+func (_m *Service) ListSyncRuns(ctx context.Context, limit int) (specs.ListSyncRunsResponse, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSyncRuns")
+	}
+
+	var r0 specs.ListSyncRunsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.ListSyncRunsResponse, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.ListSyncRunsResponse); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(specs.ListSyncRunsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsers provides a mock function with given fields: ctx, filter
+func (_m *Service) ListUsers(ctx context.Context, filter specs.ListUsersFilter) (specs.ListUsersResponse, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 specs.ListUsersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListUsersFilter) (specs.ListUsersResponse, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListUsersFilter) specs.ListUsersResponse); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(specs.ListUsersResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListUsersFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAllNotificationsRead provides a mock function with given fields: ctx, userID
+func (_m *Service) MarkAllNotificationsRead(ctx context.Context, userID int) (specs.MarkAllNotificationsReadResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllNotificationsRead")
+	}
+
+	var r0 specs.MarkAllNotificationsReadResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.MarkAllNotificationsReadResponse, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.MarkAllNotificationsReadResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(specs.MarkAllNotificationsReadResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkNotificationRead provides a mock function with given fields: ctx, userID, notificationID
+func (_m *Service) MarkNotificationRead(ctx context.Context, userID int, notificationID int64) (specs.Notification, error) {
+	ret := _m.Called(ctx, userID, notificationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNotificationRead")
+	}
+
+	var r0 specs.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int64) (specs.Notification, error)); ok {
+		return rf(ctx, userID, notificationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int64) specs.Notification); ok {
+		r0 = rf(ctx, userID, notificationID)
+	} else {
+		r0 = ret.Get(0).(specs.Notification)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int64) error); ok {
+		r1 = rf(ctx, userID, notificationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewEmailTemplate provides a mock function with given fields: ctx, kind, req
+func (_m *Service) PreviewEmailTemplate(ctx context.Context, kind string, req specs.PreviewEmailTemplateRequest) (specs.EmailPreviewResponse, error) {
+	ret := _m.Called(ctx, kind, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewEmailTemplate")
+	}
+
+	var r0 specs.EmailPreviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, specs.PreviewEmailTemplateRequest) (specs.EmailPreviewResponse, error)); ok {
+		return rf(ctx, kind, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, specs.PreviewEmailTemplateRequest) specs.EmailPreviewResponse); ok {
+		r0 = rf(ctx, kind, req)
+	} else {
+		r0 = ret.Get(0).(specs.EmailPreviewResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, specs.PreviewEmailTemplateRequest) error); ok {
+		r1 = rf(ctx, kind, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProcessIntranetWebhookEvents provides a mock function with given fields: ctx
+func (_m *Service) ProcessIntranetWebhookEvents(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessIntranetWebhookEvents")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReceiveIntranetWebhook provides a mock function with given fields: ctx, event, payload
+func (_m *Service) ReceiveIntranetWebhook(ctx context.Context, event specs.IntranetWebhookEvent, payload []byte) (specs.ReceiveIntranetWebhookResponse, error) {
+	ret := _m.Called(ctx, event, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveIntranetWebhook")
+	}
+
+	var r0 specs.ReceiveIntranetWebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.IntranetWebhookEvent, []byte) (specs.ReceiveIntranetWebhookResponse, error)); ok {
+		return rf(ctx, event, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.IntranetWebhookEvent, []byte) specs.ReceiveIntranetWebhookResponse); ok {
+		r0 = rf(ctx, event, payload)
+	} else {
+		r0 = ret.Get(0).(specs.ReceiveIntranetWebhookResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.IntranetWebhookEvent, []byte) error); ok {
+		r1 = rf(ctx, event, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveToken provides a mock function with given fields: ctx, token
+func (_m *Service) RemoveToken(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResendInvitation provides a mock function with given fields: ctx, userID, profileID
+func (_m *Service) ResendInvitation(ctx context.Context, userID int, profileID int) error {
+	ret := _m.Called(ctx, userID, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, userID, profileID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResendOutboxEmail provides a mock function with given fields: ctx, id
+func (_m *Service) ResendOutboxEmail(ctx context.Context, id int64) (specs.OutboxEmail, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendOutboxEmail")
+	}
+
+	var r0 specs.OutboxEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.OutboxEmail, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.OutboxEmail); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(specs.OutboxEmail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResolveEmployeeID provides a mock function with given fields: ctx, employeeID
+func (_m *Service) ResolveEmployeeID(ctx context.Context, employeeID string) (int, error) {
+	ret := _m.Called(ctx, employeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveEmployeeID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, employeeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, employeeID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, employeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResolveEmployeeIDs provides a mock function with given fields: ctx, req
+func (_m *Service) ResolveEmployeeIDs(ctx context.Context, req specs.ResolveEmployeesRequest) (specs.ResolveEmployeesResponseV1, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveEmployeeIDs")
+	}
+
+	var r0 specs.ResolveEmployeesResponseV1
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ResolveEmployeesRequest) (specs.ResolveEmployeesResponseV1, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ResolveEmployeesRequest) specs.ResolveEmployeesResponseV1); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(specs.ResolveEmployeesResponseV1)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ResolveEmployeesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreBackup provides a mock function with given fields: ctx, path
+func (_m *Service) RestoreBackup(ctx context.Context, path string) (specs.BackupReport, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreBackup")
+	}
+
+	var r0 specs.BackupReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (specs.BackupReport, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) specs.BackupReport); ok {
+		r0 = rf(ctx, path)
+	} else {
+		r0 = ret.Get(0).(specs.BackupReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetryIntranetWebhookEvent provides a mock function with given fields: ctx, id
+func (_m *Service) RetryIntranetWebhookEvent(ctx context.Context, id int64) (specs.IntranetWebhookEventRecord, error) {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProfile")
+		panic("no return value specified for RetryIntranetWebhookEvent")
 	}
 
-	var r0 specs.ResponseProfile
+	var r0 specs.IntranetWebhookEventRecord
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.ResponseProfile, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.IntranetWebhookEventRecord, error)); ok {
 		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) specs.ResponseProfile); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.IntranetWebhookEventRecord); ok {
 		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(specs.ResponseProfile)
+		r0 = ret.Get(0).(specs.IntranetWebhookEventRecord)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
 		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
@@ -393,17 +1954,17 @@ func (_m *Service) GetProfile(ctx context.Context, id int) (specs.ResponseProfil
 	return r0, r1
 }
 
-// InviteAdmin provides a mock function with given fields: ctx, userID, req
-func (_m *Service) InviteAdmin(ctx context.Context, userID int, req specs.AdminInviteRequest) error {
-	ret := _m.Called(ctx, userID, req)
+// RevokeAPIKey provides a mock function with given fields: ctx, apiKeyID
+func (_m *Service) RevokeAPIKey(ctx context.Context, apiKeyID int) error {
+	ret := _m.Called(ctx, apiKeyID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InviteAdmin")
+		panic("no return value specified for RevokeAPIKey")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, specs.AdminInviteRequest) error); ok {
-		r0 = rf(ctx, userID, req)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, apiKeyID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -411,29 +1972,27 @@ func (_m *Service) InviteAdmin(ctx context.Context, userID int, req specs.AdminI
 	return r0
 }
 
-// ListAchievements provides a mock function with given fields: ctx, profileID, filter
-func (_m *Service) ListAchievements(ctx context.Context, profileID int, filter specs.ListAchievementFilter) ([]specs.AchievementResponse, error) {
-	ret := _m.Called(ctx, profileID, filter)
+// RotateAPIKey provides a mock function with given fields: ctx, apiKeyID
+func (_m *Service) RotateAPIKey(ctx context.Context, apiKeyID int) (specs.APIKeySecretResponse, error) {
+	ret := _m.Called(ctx, apiKeyID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListAchievements")
+		panic("no return value specified for RotateAPIKey")
 	}
 
-	var r0 []specs.AchievementResponse
+	var r0 specs.APIKeySecretResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListAchievementFilter) ([]specs.AchievementResponse, error)); ok {
-		return rf(ctx, profileID, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (specs.APIKeySecretResponse, error)); ok {
+		return rf(ctx, apiKeyID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListAchievementFilter) []specs.AchievementResponse); ok {
-		r0 = rf(ctx, profileID, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, int) specs.APIKeySecretResponse); ok {
+		r0 = rf(ctx, apiKeyID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]specs.AchievementResponse)
-		}
+		r0 = ret.Get(0).(specs.APIKeySecretResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListAchievementFilter) error); ok {
-		r1 = rf(ctx, profileID, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, apiKeyID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -441,29 +2000,29 @@ func (_m *Service) ListAchievements(ctx context.Context, profileID int, filter s
 	return r0, r1
 }
 
-// ListCertificates provides a mock function with given fields: ctx, profileID, fitler
-func (_m *Service) ListCertificates(ctx context.Context, profileID int, fitler specs.ListCertificateFilter) ([]specs.CertificateResponse, error) {
-	ret := _m.Called(ctx, profileID, fitler)
+// RotateBackups provides a mock function with given fields: ctx
+func (_m *Service) RotateBackups(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListCertificates")
+		panic("no return value specified for RotateBackups")
 	}
 
-	var r0 []specs.CertificateResponse
+	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListCertificateFilter) ([]specs.CertificateResponse, error)); ok {
-		return rf(ctx, profileID, fitler)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListCertificateFilter) []specs.CertificateResponse); ok {
-		r0 = rf(ctx, profileID, fitler)
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]specs.CertificateResponse)
+			r0 = ret.Get(0).([]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListCertificateFilter) error); ok {
-		r1 = rf(ctx, profileID, fitler)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -471,59 +2030,62 @@ func (_m *Service) ListCertificates(ctx context.Context, profileID int, fitler s
 	return r0, r1
 }
 
-// ListEducations provides a mock function with given fields: ctx, id, filter
-func (_m *Service) ListEducations(ctx context.Context, id int, filter specs.ListEducationsFilter) ([]specs.EducationResponse, error) {
-	ret := _m.Called(ctx, id, filter)
+// RunEmployeeSync provides a mock function with given fields: ctx, trigger, triggeredByID, opts
+func (_m *Service) RunEmployeeSync(ctx context.Context, trigger string, triggeredByID int, opts specs.SyncEmployeesOptions) (specs.SyncRun, specs.SyncEmployeesReport, error) {
+	ret := _m.Called(ctx, trigger, triggeredByID, opts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListEducations")
+		panic("no return value specified for RunEmployeeSync")
 	}
 
-	var r0 []specs.EducationResponse
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListEducationsFilter) ([]specs.EducationResponse, error)); ok {
-		return rf(ctx, id, filter)
+	var r0 specs.SyncRun
+	var r1 specs.SyncEmployeesReport
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.SyncEmployeesOptions) (specs.SyncRun, specs.SyncEmployeesReport, error)); ok {
+		return rf(ctx, trigger, triggeredByID, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListEducationsFilter) []specs.EducationResponse); ok {
-		r0 = rf(ctx, id, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.SyncEmployeesOptions) specs.SyncRun); ok {
+		r0 = rf(ctx, trigger, triggeredByID, opts)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]specs.EducationResponse)
-		}
+		r0 = ret.Get(0).(specs.SyncRun)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListEducationsFilter) error); ok {
-		r1 = rf(ctx, id, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, specs.SyncEmployeesOptions) specs.SyncEmployeesReport); ok {
+		r1 = rf(ctx, trigger, triggeredByID, opts)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(specs.SyncEmployeesReport)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, specs.SyncEmployeesOptions) error); ok {
+		r2 = rf(ctx, trigger, triggeredByID, opts)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// ListExperiences provides a mock function with given fields: ctx, id, filter
-func (_m *Service) ListExperiences(ctx context.Context, id int, filter specs.ListExperiencesFilter) ([]specs.ExperienceResponse, error) {
-	ret := _m.Called(ctx, id, filter)
+// SendInvitationReminders provides a mock function with given fields: ctx
+func (_m *Service) SendInvitationReminders(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListExperiences")
+		panic("no return value specified for SendInvitationReminders")
 	}
 
-	var r0 []specs.ExperienceResponse
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListExperiencesFilter) ([]specs.ExperienceResponse, error)); ok {
-		return rf(ctx, id, filter)
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListExperiencesFilter) []specs.ExperienceResponse); ok {
-		r0 = rf(ctx, id, filter)
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]specs.ExperienceResponse)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListExperiencesFilter) error); ok {
-		r1 = rf(ctx, id, filter)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -531,25 +2093,23 @@ func (_m *Service) ListExperiences(ctx context.Context, id int, filter specs.Lis
 	return r0, r1
 }
 
-// ListProfiles provides a mock function with given fields: ctx
-func (_m *Service) ListProfiles(ctx context.Context) ([]specs.ResponseListProfiles, error) {
+// SendOutboxEmails provides a mock function with given fields: ctx
+func (_m *Service) SendOutboxEmails(ctx context.Context) (int, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListProfiles")
+		panic("no return value specified for SendOutboxEmails")
 	}
 
-	var r0 []specs.ResponseListProfiles
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]specs.ResponseListProfiles, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []specs.ResponseListProfiles); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
 		r0 = rf(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]specs.ResponseListProfiles)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
@@ -561,29 +2121,27 @@ func (_m *Service) ListProfiles(ctx context.Context) ([]specs.ResponseListProfil
 	return r0, r1
 }
 
-// ListProjects provides a mock function with given fields: ctx, profileID, filter
-func (_m *Service) ListProjects(ctx context.Context, profileID int, filter specs.ListProjectsFilter) ([]specs.ProjectResponse, error) {
-	ret := _m.Called(ctx, profileID, filter)
+// SendProfileDigest provides a mock function with given fields: ctx
+func (_m *Service) SendProfileDigest(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListProjects")
+		panic("no return value specified for SendProfileDigest")
 	}
 
-	var r0 []specs.ProjectResponse
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListProjectsFilter) ([]specs.ProjectResponse, error)); ok {
-		return rf(ctx, profileID, filter)
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListProjectsFilter) []specs.ProjectResponse); ok {
-		r0 = rf(ctx, profileID, filter)
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]specs.ProjectResponse)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListProjectsFilter) error); ok {
-		r1 = rf(ctx, profileID, filter)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -591,23 +2149,23 @@ func (_m *Service) ListProjects(ctx context.Context, profileID int, filter specs
 	return r0, r1
 }
 
-// ListSkills provides a mock function with given fields: ctx
-func (_m *Service) ListSkills(ctx context.Context) (specs.ListSkills, error) {
+// SendStaleProfileReminders provides a mock function with given fields: ctx
+func (_m *Service) SendStaleProfileReminders(ctx context.Context) (int, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListSkills")
+		panic("no return value specified for SendStaleProfileReminders")
 	}
 
-	var r0 specs.ListSkills
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (specs.ListSkills, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) specs.ListSkills); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
 		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(specs.ListSkills)
+		r0 = ret.Get(0).(int)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
@@ -619,17 +2177,17 @@ func (_m *Service) ListSkills(ctx context.Context) (specs.ListSkills, error) {
 	return r0, r1
 }
 
-// RemoveToken provides a mock function with given fields: token
-func (_m *Service) RemoveToken(token string) error {
-	ret := _m.Called(token)
+// SendUserInvitation provides a mock function with given fields: ctx, userID, profileID
+func (_m *Service) SendUserInvitation(ctx context.Context, userID int, profileID int) error {
+	ret := _m.Called(ctx, userID, profileID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveToken")
+		panic("no return value specified for SendUserInvitation")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(token)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, userID, profileID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -637,27 +2195,27 @@ func (_m *Service) RemoveToken(token string) error {
 	return r0
 }
 
-// ResolveEmployeeID provides a mock function with given fields: ctx, employeeID
-func (_m *Service) ResolveEmployeeID(ctx context.Context, employeeID string) (int, error) {
-	ret := _m.Called(ctx, employeeID)
+// StartEmployeeSync provides a mock function with given fields: ctx, trigger, triggeredByID, opts
+func (_m *Service) StartEmployeeSync(ctx context.Context, trigger string, triggeredByID int, opts specs.SyncEmployeesOptions) (specs.SyncRun, error) {
+	ret := _m.Called(ctx, trigger, triggeredByID, opts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ResolveEmployeeID")
+		panic("no return value specified for StartEmployeeSync")
 	}
 
-	var r0 int
+	var r0 specs.SyncRun
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
-		return rf(ctx, employeeID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.SyncEmployeesOptions) (specs.SyncRun, error)); ok {
+		return rf(ctx, trigger, triggeredByID, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
-		r0 = rf(ctx, employeeID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.SyncEmployeesOptions) specs.SyncRun); ok {
+		r0 = rf(ctx, trigger, triggeredByID, opts)
 	} else {
-		r0 = ret.Get(0).(int)
+		r0 = ret.Get(0).(specs.SyncRun)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, employeeID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, specs.SyncEmployeesOptions) error); ok {
+		r1 = rf(ctx, trigger, triggeredByID, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -665,57 +2223,64 @@ func (_m *Service) ResolveEmployeeID(ctx context.Context, employeeID string) (in
 	return r0, r1
 }
 
-// SendUserInvitation provides a mock function with given fields: ctx, userID, profileID
-func (_m *Service) SendUserInvitation(ctx context.Context, userID int, profileID int) error {
-	ret := _m.Called(ctx, userID, profileID)
+// SubscribeNotifications provides a mock function with given fields: userID
+func (_m *Service) SubscribeNotifications(userID int) (<-chan specs.NotificationEvent, func()) {
+	ret := _m.Called(userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendUserInvitation")
+		panic("no return value specified for SubscribeNotifications")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
-		r0 = rf(ctx, userID, profileID)
+	var r0 <-chan specs.NotificationEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func(int) (<-chan specs.NotificationEvent, func())); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(int) <-chan specs.NotificationEvent); ok {
+		r0 = rf(userID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan specs.NotificationEvent)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(int) func()); ok {
+		r1 = rf(userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
 }
 
-// SyncEmployees provides a mock function with given fields: ctx
-func (_m *Service) SyncEmployees(ctx context.Context) (int, int, error) {
-	ret := _m.Called(ctx)
+// SyncEmployees provides a mock function with given fields: ctx, opts
+func (_m *Service) SyncEmployees(ctx context.Context, opts specs.SyncEmployeesOptions) (specs.SyncEmployeesReport, error) {
+	ret := _m.Called(ctx, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SyncEmployees")
 	}
 
-	var r0 int
-	var r1 int
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context) (int, int, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
-		r0 = rf(ctx)
-	} else {
-		r0 = ret.Get(0).(int)
+	var r0 specs.SyncEmployeesReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.SyncEmployeesOptions) (specs.SyncEmployeesReport, error)); ok {
+		return rf(ctx, opts)
 	}
-
-	if rf, ok := ret.Get(1).(func(context.Context) int); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, specs.SyncEmployeesOptions) specs.SyncEmployeesReport); ok {
+		r0 = rf(ctx, opts)
 	} else {
-		r1 = ret.Get(1).(int)
+		r0 = ret.Get(0).(specs.SyncEmployeesReport)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
-		r2 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, specs.SyncEmployeesOptions) error); ok {
+		r1 = rf(ctx, opts)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
 // UpdateAchievement provides a mock function with given fields: ctx, profileID, achID, userID, req
@@ -802,6 +2367,34 @@ func (_m *Service) UpdateEducation(ctx context.Context, profileID int, eduID int
 	return r0, r1
 }
 
+// UpdateEmailTemplate provides a mock function with given fields: ctx, kind, userID, req
+func (_m *Service) UpdateEmailTemplate(ctx context.Context, kind string, userID int, req specs.UpdateEmailTemplateRequest) (specs.EmailTemplate, error) {
+	ret := _m.Called(ctx, kind, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmailTemplate")
+	}
+
+	var r0 specs.EmailTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.UpdateEmailTemplateRequest) (specs.EmailTemplate, error)); ok {
+		return rf(ctx, kind, userID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, specs.UpdateEmailTemplateRequest) specs.EmailTemplate); ok {
+		r0 = rf(ctx, kind, userID, req)
+	} else {
+		r0 = ret.Get(0).(specs.EmailTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, specs.UpdateEmailTemplateRequest) error); ok {
+		r1 = rf(ctx, kind, userID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateExperience provides a mock function with given fields: ctx, profileID, expID, userID, req
 func (_m *Service) UpdateExperience(ctx context.Context, profileID int, expID int, userID int, req specs.UpdateExperienceRequest) (int, error) {
 	ret := _m.Called(ctx, profileID, expID, userID, req)
@@ -922,6 +2515,24 @@ func (_m *Service) UpdateProject(ctx context.Context, profileID int, projID int,
 	return r0, r1
 }
 
+// UpdateRole provides a mock function with given fields: ctx, roleID, req
+func (_m *Service) UpdateRole(ctx context.Context, roleID int, req specs.UpdateRoleRequest) error {
+	ret := _m.Called(ctx, roleID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.UpdateRoleRequest) error); ok {
+		r0 = rf(ctx, roleID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateSequence provides a mock function with given fields: ctx, userID, seqDetail
 func (_m *Service) UpdateSequence(ctx context.Context, userID int, seqDetail specs.UpdateSequenceRequest) (int, error) {
 	ret := _m.Called(ctx, userID, seqDetail)
@@ -950,6 +2561,70 @@ func (_m *Service) UpdateSequence(ctx context.Context, userID int, seqDetail spe
 	return r0, r1
 }
 
+// UpdateUser provides a mock function with given fields: ctx, actorID, userID, req
+func (_m *Service) UpdateUser(ctx context.Context, actorID int, userID int, req specs.UpdateUserRequest) error {
+	ret := _m.Called(ctx, actorID, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, specs.UpdateUserRequest) error); ok {
+		r0 = rf(ctx, actorID, userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateUserStatus provides a mock function with given fields: ctx, actorID, userID, req
+func (_m *Service) UpdateUserStatus(ctx context.Context, actorID int, userID int, req specs.UpdateUserStatusRequest) error {
+	ret := _m.Called(ctx, actorID, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, specs.UpdateUserStatusRequest) error); ok {
+		r0 = rf(ctx, actorID, userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VerifyLatestBackup provides a mock function with given fields: ctx
+func (_m *Service) VerifyLatestBackup(ctx context.Context) (specs.BackupVerification, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyLatestBackup")
+	}
+
+	var r0 specs.BackupVerification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (specs.BackupVerification, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) specs.BackupVerification); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(specs.BackupVerification)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewService(t interface {
@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
 )
 
 // UserEmailService is an autogenerated mock type for the UserEmailService type
@@ -13,6 +15,24 @@ type UserEmailService struct {
 	mock.Mock
 }
 
+// InviteAdmin provides a mock function with given fields: ctx, userID, req
+func (_m *UserEmailService) InviteAdmin(ctx context.Context, userID int, req specs.AdminInviteRequest) error {
+	ret := _m.Called(ctx, userID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteAdmin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.AdminInviteRequest) error); ok {
+		r0 = rf(ctx, userID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendUserInvitation provides a mock function with given fields: ctx, userID, profileID
 func (_m *UserEmailService) SendUserInvitation(ctx context.Context, userID int, profileID int) error {
 	ret := _m.Called(ctx, userID, profileID)
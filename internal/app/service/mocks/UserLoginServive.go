@@ -43,17 +43,17 @@ func (_m *UserLoginServive) GenerateLoginToken(ctx context.Context, filter specs
 	return r0, r1
 }
 
-// RemoveToken provides a mock function with given fields: token
-func (_m *UserLoginServive) RemoveToken(token string) error {
-	ret := _m.Called(token)
+// RemoveToken provides a mock function with given fields: ctx, token
+func (_m *UserLoginServive) RemoveToken(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RemoveToken")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
-		r0 = rf(token)
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
 	} else {
 		r0 = ret.Error(0)
 	}
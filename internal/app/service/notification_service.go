@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"go.uber.org/zap"
+)
+
+// NotificationService contains methods for users to read their in-app notifications and follow them as they come
+type NotificationService interface {
+	ListNotifications(ctx context.Context, userID int, filter specs.ListNotificationsFilter) (specs.ListNotificationsResponse, error)
+	CountUnreadNotifications(ctx context.Context, userID int) (int, error)
+	MarkNotificationRead(ctx context.Context, userID int, notificationID int64) (specs.Notification, error)
+	MarkAllNotificationsRead(ctx context.Context, userID int) (specs.MarkAllNotificationsReadResponse, error)
+	SubscribeNotifications(userID int) (<-chan specs.NotificationEvent, func())
+}
+
+// notificationBroker passes notification events to the streams open in this server, by user. An event is dropped
+// for a stream that has fallen NotificationStreamBuffer events behind; the stream catches up with the unread count
+// it is sent every heartbeat.
+type notificationBroker struct {
+	mu          sync.Mutex
+	subscribers map[int]map[chan specs.NotificationEvent]bool
+}
+
+// newNotificationBroker creates a broker without subscribers
+func newNotificationBroker() *notificationBroker {
+	return &notificationBroker{subscribers: map[int]map[chan specs.NotificationEvent]bool{}}
+}
+
+// subscribe returns the events of a user along with a func to stop receiving them
+func (broker *notificationBroker) subscribe(userID int) (<-chan specs.NotificationEvent, func()) {
+	events := make(chan specs.NotificationEvent, constants.NotificationStreamBuffer)
+
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+	if broker.subscribers[userID] == nil {
+		broker.subscribers[userID] = map[chan specs.NotificationEvent]bool{}
+	}
+	broker.subscribers[userID][events] = true
+
+	return events, func() {
+		broker.mu.Lock()
+		defer broker.mu.Unlock()
+		delete(broker.subscribers[userID], events)
+		if len(broker.subscribers[userID]) == 0 {
+			delete(broker.subscribers, userID)
+		}
+	}
+}
+
+// hasSubscribers reports whether a user has a stream open in this server
+func (broker *notificationBroker) hasSubscribers(userID int) bool {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+	return len(broker.subscribers[userID]) > 0
+}
+
+// publish passes an event to every stream of a user, without waiting for streams that have fallen behind
+func (broker *notificationBroker) publish(userID int, event specs.NotificationEvent) {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+	for events := range broker.subscribers[userID] {
+		select {
+		case events <- event:
+		default:
+			zap.S().Warnf("Dropped a notification event for user %d, the stream has fallen behind", userID)
+		}
+	}
+}
+
+// ListNotifications returns a page of the notifications of a user, newest first, along with how many are unread
+func (notificationSvc *service) ListNotifications(ctx context.Context, userID int, filter specs.ListNotificationsFilter) (specs.ListNotificationsResponse, error) {
+	notifications, err := notificationSvc.NotificationRepo.ListNotifications(ctx, userID, filter)
+	if err != nil {
+		zap.S().Errorf("Unable to list the notifications of user %d : %v", userID, err)
+		return specs.ListNotificationsResponse{}, err
+	}
+
+	unread, err := notificationSvc.NotificationRepo.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		zap.S().Errorf("Unable to count the unread notifications of user %d : %v", userID, err)
+		return specs.ListNotificationsResponse{}, err
+	}
+
+	return specs.ListNotificationsResponse{
+		Notifications: notifications,
+		UnreadCount:   unread,
+		Page:          filter.Page,
+		Limit:         filter.Limit,
+	}, nil
+}
+
+// CountUnreadNotifications returns how many notifications of a user are unread
+func (notificationSvc *service) CountUnreadNotifications(ctx context.Context, userID int) (int, error) {
+	unread, err := notificationSvc.NotificationRepo.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		zap.S().Errorf("Unable to count the unread notifications of user %d : %v", userID, err)
+		return 0, err
+	}
+	return unread, nil
+}
+
+// MarkNotificationRead marks a notification of a user read. It returns ErrNotificationNotFound if the notification
+// is not the user's.
+func (notificationSvc *service) MarkNotificationRead(ctx context.Context, userID int, notificationID int64) (specs.Notification, error) {
+	notification, err := notificationSvc.NotificationRepo.MarkNotificationRead(ctx, userID, notificationID)
+	if err != nil {
+		zap.S().Errorf("Unable to mark notification %d of user %d read : %v", notificationID, userID, err)
+		return specs.Notification{}, err
+	}
+
+	notificationSvc.publishUnreadCount(ctx, userID)
+	return notification, nil
+}
+
+// MarkAllNotificationsRead marks every notification of a user read
+func (notificationSvc *service) MarkAllNotificationsRead(ctx context.Context, userID int) (specs.MarkAllNotificationsReadResponse, error) {
+	marked, err := notificationSvc.NotificationRepo.MarkAllNotificationsRead(ctx, userID)
+	if err != nil {
+		zap.S().Errorf("Unable to mark the notifications of user %d read : %v", userID, err)
+		return specs.MarkAllNotificationsReadResponse{}, err
+	}
+
+	if marked > 0 {
+		notificationSvc.publishUnreadCount(ctx, userID)
+	}
+	return specs.MarkAllNotificationsReadResponse{MarkedRead: marked}, nil
+}
+
+// SubscribeNotifications returns the notification events of a user as they happen in this server, along with a
+// func to stop receiving them that must be called once done.
+func (notificationSvc *service) SubscribeNotifications(userID int) (<-chan specs.NotificationEvent, func()) {
+	return notificationSvc.notificationBroker.subscribe(userID)
+}
+
+// notify creates a notification for its recipients within tx. The notifications are returned to be published once
+// tx is committed.
+func (notificationSvc *service) notify(ctx context.Context, notification repository.NotificationRepo, tx pgx.Tx) ([]specs.Notification, error) {
+	notifications, err := notificationSvc.NotificationRepo.CreateNotifications(ctx, notification, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to create %s notification : %v", notification.Kind, err)
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// publishNotifications passes notifications that have been committed on to the streams of their users
+func (notificationSvc *service) publishNotifications(ctx context.Context, notifications []specs.Notification) {
+	for i := range notifications {
+		notification := notifications[i]
+		if !notificationSvc.notificationBroker.hasSubscribers(notification.UserID) {
+			continue
+		}
+
+		unread, err := notificationSvc.NotificationRepo.CountUnreadNotifications(ctx, notification.UserID)
+		if err != nil {
+			zap.S().Errorf("Unable to count the unread notifications of user %d : %v", notification.UserID, err)
+			continue
+		}
+		notificationSvc.notificationBroker.publish(notification.UserID, specs.NotificationEvent{Notification: &notification, UnreadCount: unread})
+	}
+}
+
+// publishUnreadCount passes the unread count of a user on to their streams
+func (notificationSvc *service) publishUnreadCount(ctx context.Context, userID int) {
+	if !notificationSvc.notificationBroker.hasSubscribers(userID) {
+		return
+	}
+
+	unread, err := notificationSvc.NotificationRepo.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		zap.S().Errorf("Unable to count the unread notifications of user %d : %v", userID, err)
+		return
+	}
+	notificationSvc.notificationBroker.publish(userID, specs.NotificationEvent{UnreadCount: unread})
+}
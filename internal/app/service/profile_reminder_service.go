@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// ProfileReminderService contains methods to keep profiles from going stale once they are completed
+type ProfileReminderService interface {
+	SendStaleProfileReminders(ctx context.Context) (int, error)
+	SendProfileDigest(ctx context.Context) (int, error)
+}
+
+// SendStaleProfileReminders emails the employee of every stale profile a reminder to update it, unless they were
+// reminded within the last STALE_PROFILE_REMINDER_INTERVAL_DAYS. Only employees who completed an invitation, and so
+// can log in, are reminded; profiles with an open invitation are left to the invitation reminders. It returns how
+// many were sent.
+func (reminderSvc *service) SendStaleProfileReminders(ctx context.Context) (int, error) {
+	activities, err := reminderSvc.ProfileReminderRepo.ListProfileActivity(ctx)
+	if err != nil {
+		zap.S().Error("Unable to list the profiles to remind : ", err)
+		return 0, err
+	}
+
+	now := time.Now()
+	rules := staleProfileRulesFromEnv()
+	interval := daysFromEnv(constants.StaleProfileReminderIntervalDaysEnvVar, constants.DefaultStaleProfileReminderIntervalDays)
+	sent := 0
+	for _, activity := range activities {
+		if activity.HasOpenInvitation || !activity.HasCompletedInvitation {
+			continue
+		}
+		if activity.LastRemindedAt != nil && now.Sub(*activity.LastRemindedAt) < interval {
+			continue
+		}
+		stale, ok := rules.staleProfile(activity, now)
+		if !ok {
+			continue
+		}
+
+		reminded, err := reminderSvc.remindStaleProfile(ctx, stale, now.Add(-interval))
+		if err != nil {
+			zap.S().Errorf("Unable to remind profile %d that it is stale : %v", stale.ProfileID, err)
+			continue
+		}
+		if reminded {
+			sent++
+		}
+	}
+
+	if sent > 0 {
+		zap.S().Infof("Sent %d stale profile reminders", sent)
+	}
+	return sent, nil
+}
+
+// SendProfileDigest emails every active holder of profiles:digest a digest of the stale profiles, the incomplete
+// ones and those completed within the last ProfileDigestPeriod. Nothing is sent when there is nothing to report.
+// It returns how many digests were sent.
+func (reminderSvc *service) SendProfileDigest(ctx context.Context) (count int, err error) {
+	now := time.Now()
+	digest, err := reminderSvc.buildProfileDigest(ctx, now.Add(-constants.ProfileDigestPeriod), now)
+	if err != nil {
+		return 0, err
+	}
+	if len(digest.StaleProfiles) == 0 && len(digest.IncompleteProfiles) == 0 && len(digest.CompletedProfiles) == 0 {
+		zap.S().Info("No profiles to report, the profile digest is not sent")
+		return 0, nil
+	}
+
+	emailIDs := []int64{}
+	tx, err := reminderSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		txErr := reminderSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil {
+			reminderSvc.sendQueuedEmails(ctx, emailIDs...)
+		}
+	}()
+
+	isActive := true
+	recipients, err := reminderSvc.UserLoginRepo.ListUsers(ctx, specs.ListUsersFilter{Permission: constants.PermProfilesDigest, IsActive: &isActive}, tx)
+	if err != nil {
+		zap.S().Error("Unable to list the recipients of the profile digest : ", err)
+		return 0, err
+	}
+
+	for _, recipient := range recipients {
+		emailID, err := reminderSvc.queueEmail(ctx, constants.EmailKindProfileDigest, recipient.Email, specs.EmailTemplateData{
+			Name:   recipient.Name,
+			Email:  recipient.Email,
+			Digest: &digest,
+		}, nil, tx)
+		if err != nil {
+			return 0, err
+		}
+		emailIDs = append(emailIDs, emailID)
+	}
+
+	zap.S().Infof("Profile digest sent to %d users", len(emailIDs))
+	return len(emailIDs), nil
+}
+
+// remindStaleProfile records that the employee of a stale profile has been reminded and queues the reminder,
+// reporting whether it did. No reminder is queued when another run reminded them after remindedBefore.
+func (reminderSvc *service) remindStaleProfile(ctx context.Context, stale specs.StaleProfile, remindedBefore time.Time) (reminded bool, err error) {
+	var emailID int64
+	tx, err := reminderSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		txErr := reminderSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil && reminded {
+			reminderSvc.sendQueuedEmails(ctx, emailID)
+		}
+	}()
+
+	reminded, err = reminderSvc.ProfileReminderRepo.RecordStaleProfileReminder(ctx, stale.ProfileID, remindedBefore, tx)
+	if err != nil || !reminded {
+		return false, err
+	}
+
+	data := specs.EmailTemplateData{
+		Name:          stale.Name,
+		Email:         stale.Email,
+		ProfileID:     stale.ProfileID,
+		LastUpdatedAt: stale.UpdatedAt.Format(constants.ProfileDateFormat),
+	}
+	if stale.LatestProjectEndedAt != nil {
+		data.LatestProjectEndedAt = stale.LatestProjectEndedAt.Format(constants.ProfileDateFormat)
+	}
+	emailID, err = reminderSvc.queueEmail(ctx, constants.EmailKindStaleProfile, stale.Email, data, &stale.ProfileID, tx)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// buildProfileDigest gathers the profiles that are stale or incomplete at to, and those completed since from
+func (reminderSvc *service) buildProfileDigest(ctx context.Context, from time.Time, to time.Time) (specs.ProfileDigest, error) {
+	digest := specs.ProfileDigest{
+		From:               from.Format(constants.ProfileDateFormat),
+		To:                 to.Format(constants.ProfileDateFormat),
+		StaleProfiles:      []specs.DigestProfile{},
+		IncompleteProfiles: []specs.DigestProfile{},
+		CompletedProfiles:  []specs.DigestProfile{},
+	}
+
+	activities, err := reminderSvc.ProfileReminderRepo.ListProfileActivity(ctx)
+	if err != nil {
+		zap.S().Error("Unable to list the profiles for the profile digest : ", err)
+		return specs.ProfileDigest{}, err
+	}
+	rules := staleProfileRulesFromEnv()
+	for _, activity := range activities {
+		if activity.HasOpenInvitation {
+			continue
+		}
+		stale, ok := rules.staleProfile(activity, to)
+		if !ok {
+			continue
+		}
+
+		note := "last updated " + stale.UpdatedAt.Format(constants.ProfileDateFormat)
+		if stale.LatestProjectEndedAt != nil {
+			note += ", latest project ended " + stale.LatestProjectEndedAt.Format(constants.ProfileDateFormat)
+		}
+		digest.StaleProfiles = append(digest.StaleProfiles, digestProfile(stale.ProfileID, stale.Name, stale.Email, note))
+	}
+
+	for _, status := range []string{constants.InvitationOverdue, constants.InvitationPending} {
+		invitations, err := reminderSvc.UserEmailRepo.ListInvitations(ctx, specs.ListInvitationsFilter{Status: status})
+		if err != nil {
+			zap.S().Errorf("Unable to list the %s invitations for the profile digest : %v", status, err)
+			return specs.ProfileDigest{}, err
+		}
+		for _, invitation := range invitations {
+			note := fmt.Sprintf("invited %s, expires %s", invitation.CreatedAt.Format(constants.ProfileDateFormat), invitation.ExpiresAt.Format(constants.ProfileDateFormat))
+			if status == constants.InvitationOverdue {
+				note = fmt.Sprintf("invited %s, expired %s", invitation.CreatedAt.Format(constants.ProfileDateFormat), invitation.ExpiresAt.Format(constants.ProfileDateFormat))
+			}
+			digest.IncompleteProfiles = append(digest.IncompleteProfiles, digestProfile(invitation.ProfileID, invitation.Name, invitation.Email, note))
+		}
+	}
+
+	completed, err := reminderSvc.UserEmailRepo.ListInvitations(ctx, specs.ListInvitationsFilter{Status: constants.InvitationCompleted, CompletedFrom: &from})
+	if err != nil {
+		zap.S().Error("Unable to list the completed invitations for the profile digest : ", err)
+		return specs.ProfileDigest{}, err
+	}
+	for _, invitation := range completed {
+		note := "completed"
+		if invitation.CompletedAt != nil {
+			note += " " + invitation.CompletedAt.Format(constants.ProfileDateFormat)
+		}
+		digest.CompletedProfiles = append(digest.CompletedProfiles, digestProfile(invitation.ProfileID, invitation.Name, invitation.Email, note))
+	}
+
+	return digest, nil
+}
+
+// digestProfile lists a profile in the profile digest
+func digestProfile(profileID int, name string, email string, note string) specs.DigestProfile {
+	return specs.DigestProfile{
+		ProfileID:   profileID,
+		Name:        name,
+		Email:       email,
+		ProfileLink: helpers.ProfileLink(profileID),
+		Note:        note,
+	}
+}
+
+// staleProfileRules tell how long a profile can go without updates, and an employee without a project, before the
+// profile is stale
+type staleProfileRules struct {
+	profileAge time.Duration
+	projectAge time.Duration
+}
+
+// staleProfileRulesFromEnv reads the rules from STALE_PROFILE_DAYS and STALE_PROJECT_DAYS
+func staleProfileRulesFromEnv() staleProfileRules {
+	return staleProfileRules{
+		profileAge: daysFromEnv(constants.StaleProfileDaysEnvVar, constants.DefaultStaleProfileDays),
+		projectAge: daysFromEnv(constants.StaleProjectDaysEnvVar, constants.DefaultStaleProjectDays),
+	}
+}
+
+// staleProfile tells whether a profile is stale at now: it has not been updated for profileAge, or every project of
+// the employee has ended and the latest one ended projectAge ago. A project without a readable end date is taken to
+// be ongoing.
+func (rules staleProfileRules) staleProfile(activity specs.ProfileActivity, now time.Time) (specs.StaleProfile, bool) {
+	stale := specs.StaleProfile{
+		ProfileID: activity.ProfileID,
+		Name:      activity.Name,
+		Email:     activity.Email,
+		UpdatedAt: activity.UpdatedAt,
+	}
+	notUpdated := now.Sub(activity.UpdatedAt) >= rules.profileAge
+
+	var latestEnd *time.Time
+	for _, value := range activity.ProjectEndDates {
+		end, ok := parseProjectEndDate(value)
+		if !ok {
+			latestEnd = nil
+			break
+		}
+		if latestEnd == nil || end.After(*latestEnd) {
+			latestEnd = &end
+		}
+	}
+	if latestEnd != nil && now.Sub(*latestEnd) >= rules.projectAge {
+		stale.LatestProjectEndedAt = latestEnd
+	}
+
+	return stale, notUpdated || stale.LatestProjectEndedAt != nil
+}
+
+// parseProjectEndDate reads the working end date of a project with any of ProjectEndDateLayouts. A date with only a
+// month is taken to be the end of that month.
+func parseProjectEndDate(value string) (time.Time, bool) {
+	for _, layout := range constants.ProjectEndDateLayouts {
+		end, err := time.Parse(layout, value)
+		if err != nil {
+			continue
+		}
+		if layout != time.DateOnly {
+			end = end.AddDate(0, 1, -1)
+		}
+		return end, true
+	}
+	return time.Time{}, false
+}
+
+// daysFromEnv returns the number of days set in envVar as a duration, or defaultDays if it is not a positive number
+func daysFromEnv(envVar string, defaultDays int32) time.Duration {
+	days := helpers.ConvertStringToIntWithDefault(envVar, defaultDays)
+	if days < 1 {
+		days = defaultDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
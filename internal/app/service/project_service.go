@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"strings"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
@@ -17,11 +19,16 @@ type ProjectService interface {
 	ListProjects(ctx context.Context, profileID int, filter specs.ListProjectsFilter) (values []specs.ProjectResponse, err error)
 	UpdateProject(ctx context.Context, profileID int, projID int, userID int, req specs.UpdateProjectRequest) (ID int, err error)
 	DeleteProject(ctx context.Context, profileID, projectID int) error
+	ListIntranetProjectProposals(ctx context.Context, profileID int) (specs.IntranetProjectProposalsResponse, error)
+	ApplyIntranetProjectProposals(ctx context.Context, profileID int, userID int, req specs.ApplyIntranetProjectsRequest) (specs.ApplyIntranetProjectsResponse, error)
 }
 
 // CreateProject : Service layer function adds project details to a user profile.
 func (projSvc *service) CreateProject(ctx context.Context, projDetail specs.CreateProjectRequest, profileID int, userID int) (ID int, err error) {
-	tx, _ := projSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := projSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := projSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -74,7 +81,10 @@ func (projSvc *service) CreateProject(ctx context.Context, projDetail specs.Crea
 
 // ListProjects in the service layer retrieves a projects of specific profile.
 func (projSvc *service) ListProjects(ctx context.Context, profileID int, filter specs.ListProjectsFilter) (values []specs.ProjectResponse, err error) {
-	tx, _ := projSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := projSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return []specs.ProjectResponse{}, err
+	}
 	defer func() {
 		txErr := projSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -93,7 +103,10 @@ func (projSvc *service) ListProjects(ctx context.Context, profileID int, filter
 
 // UpdateProject in the service layer update a projects of specific profile.
 func (projSvc *service) UpdateProject(ctx context.Context, profileID int, projID int, userID int, req specs.UpdateProjectRequest) (ID int, err error) {
-	tx, _ := projSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := projSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := projSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -128,7 +141,10 @@ func (projSvc *service) UpdateProject(ctx context.Context, profileID int, projID
 }
 
 func (projSvc *service) DeleteProject(ctx context.Context, profileID, projectID int) (err error) {
-	tx, _ := projSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := projSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
 	defer func() {
 		txErr := projSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -150,3 +166,258 @@ func (projSvc *service) DeleteProject(ctx context.Context, profileID, projectID
 	zap.S().Info("project deleted with project_id : ", projectID, "profile id : ", profileID)
 	return nil
 }
+
+// intranetProjectPlan is an intranet project proposed for a profile, with the profile project it would update
+type intranetProjectPlan struct {
+	proposal specs.IntranetProjectProposal
+	intranet specs.IntranetProject
+	existing specs.ProjectResponse
+}
+
+// ListIntranetProjectProposals fetches the projects of the profile's employee from the intranet and proposes the
+// ones missing from the profile as additions, and the ones whose details differ as updates of the profile project
+// with the same name. Projects the employee rejected are not proposed again until the intranet changes them.
+func (projSvc *service) ListIntranetProjectProposals(ctx context.Context, profileID int) (resp specs.IntranetProjectProposalsResponse, err error) {
+	emp, err := projSvc.getProfileIntranetEmployee(ctx, profileID)
+	if err != nil {
+		return specs.IntranetProjectProposalsResponse{}, err
+	}
+
+	tx, err := projSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.IntranetProjectProposalsResponse{}, err
+	}
+	defer func() {
+		txErr := projSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	plans, err := projSvc.planIntranetProjects(ctx, profileID, emp.Projects, tx)
+	if err != nil {
+		return specs.IntranetProjectProposalsResponse{}, err
+	}
+
+	resp = specs.IntranetProjectProposalsResponse{EmployeeID: emp.EmployeeID, Proposals: []specs.IntranetProjectProposal{}}
+	for _, plan := range plans {
+		resp.Proposals = append(resp.Proposals, plan.proposal)
+	}
+	return resp, nil
+}
+
+// ApplyIntranetProjectProposals adds or updates the accepted intranet projects and remembers the rejected ones.
+// The proposals are worked out again from the intranet, and a decision on a project that is no longer proposed
+// fails the whole request with ErrProjectNotProposed.
+func (projSvc *service) ApplyIntranetProjectProposals(ctx context.Context, profileID int, userID int, req specs.ApplyIntranetProjectsRequest) (resp specs.ApplyIntranetProjectsResponse, err error) {
+	emp, err := projSvc.getProfileIntranetEmployee(ctx, profileID)
+	if err != nil {
+		return specs.ApplyIntranetProjectsResponse{}, err
+	}
+
+	ctx = helpers.WithAuditAction(ctx, constants.AuditActionIntranetImport)
+	tx, err := projSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.ApplyIntranetProjectsResponse{}, err
+	}
+	defer func() {
+		txErr := projSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	plans, err := projSvc.planIntranetProjects(ctx, profileID, emp.Projects, tx)
+	if err != nil {
+		return specs.ApplyIntranetProjectsResponse{}, err
+	}
+	planByName := make(map[string]intranetProjectPlan, len(plans))
+	for _, plan := range plans {
+		planByName[intranetProjectKey(plan.proposal.Name)] = plan
+	}
+
+	today := helpers.GetTodaysDate()
+	var additions []repository.ProjectRepo
+	for _, decision := range req.Decisions {
+		plan, ok := planByName[intranetProjectKey(decision.Name)]
+		if !ok {
+			zap.S().Warnf("Intranet project %q is not proposed for profile id : %d", decision.Name, profileID)
+			return specs.ApplyIntranetProjectsResponse{}, errors.ErrProjectNotProposed
+		}
+
+		switch {
+		case !decision.Accept:
+			err = projSvc.ProjectRepo.UpsertIntranetProjectRejection(ctx, repository.IntranetProjectRejectionRepo{
+				ProfileID:        profileID,
+				NameKey:          intranetProjectKey(plan.intranet.Name),
+				Description:      plan.intranet.Description,
+				WorkingStartDate: plan.intranet.StartDate,
+				WorkingEndDate:   plan.intranet.EndDate,
+				RejectedByID:     userID,
+			}, tx)
+			if err != nil {
+				zap.S().Error("Unable to reject intranet project : ", err, " for profile id : ", profileID)
+				return specs.ApplyIntranetProjectsResponse{}, err
+			}
+			resp.Rejected++
+		case plan.proposal.Action == constants.IntranetProjectAdd:
+			additions = append(additions, repository.ProjectRepo{
+				ProfileID:        profileID,
+				Name:             strings.TrimSpace(plan.intranet.Name),
+				Description:      plan.intranet.Description,
+				Technologies:     []string{},
+				TechWorkedOn:     []string{},
+				WorkingStartDate: plan.intranet.StartDate,
+				WorkingEndDate:   plan.intranet.EndDate,
+				CreatedAt:        today,
+				UpdatedAt:        today,
+				CreatedByID:      userID,
+				UpdatedByID:      userID,
+			})
+		default:
+			value := repository.UpdateProjectRepo{
+				Name:             plan.existing.Name,
+				Description:      plan.existing.Description,
+				Role:             plan.existing.Role,
+				Responsibilities: plan.existing.Responsibilities,
+				Technologies:     plan.existing.Technologies,
+				TechWorkedOn:     plan.existing.TechWorkedOn,
+				WorkingStartDate: plan.existing.WorkingStartDate,
+				WorkingEndDate:   plan.existing.WorkingEndDate,
+				Duration:         plan.existing.Duration,
+				UpdatedAt:        today,
+				UpdatedByID:      userID,
+			}
+			for _, change := range plan.proposal.Changes {
+				switch change.Field {
+				case "description":
+					value.Description = change.NewValue
+				case "working_start_date":
+					value.WorkingStartDate = change.NewValue
+				case "working_end_date":
+					value.WorkingEndDate = change.NewValue
+				}
+			}
+
+			_, err = projSvc.ProjectRepo.UpdateProject(ctx, profileID, plan.existing.ID, value, tx)
+			if err != nil {
+				zap.S().Error("Unable to update project from the intranet : ", err, " for profile id : ", profileID)
+				return specs.ApplyIntranetProjectsResponse{}, err
+			}
+			resp.Updated++
+		}
+	}
+
+	if len(additions) > 0 {
+		count, err := projSvc.ProfileRepo.CountRecords(ctx, profileID, constants.Projects, tx)
+		if err != nil {
+			return specs.ApplyIntranetProjectsResponse{}, errors.ErrInvalidRequestData
+		}
+		for i := range additions {
+			count++
+			additions[i].Priorities = count
+		}
+
+		err = projSvc.ProjectRepo.CreateProject(ctx, additions, tx)
+		if err != nil {
+			zap.S().Error("Unable to add projects from the intranet : ", err, " for profile id : ", profileID)
+			return specs.ApplyIntranetProjectsResponse{}, err
+		}
+		resp.Added = len(additions)
+	}
+
+	zap.S().Infof("Intranet projects applied for profile id : %d, added: %d, updated: %d, rejected: %d", profileID, resp.Added, resp.Updated, resp.Rejected)
+	return resp, nil
+}
+
+// getProfileIntranetEmployee fetches the intranet employee of a profile by its employee id
+func (projSvc *service) getProfileIntranetEmployee(ctx context.Context, profileID int) (specs.IntranetEmployee, error) {
+	profile, err := projSvc.GetProfile(ctx, profileID)
+	if err != nil {
+		return specs.IntranetEmployee{}, err
+	}
+	if profile.EmployeeID == nil || *profile.EmployeeID == "" {
+		zap.S().Warn("No employee id to look up intranet projects for profile id : ", profileID)
+		return specs.IntranetEmployee{}, errors.ErrNoEmployeeID
+	}
+
+	emp, err := projSvc.IntranetClient.GetEmployeeByID(ctx, *profile.EmployeeID)
+	if err != nil {
+		zap.S().Errorf("Unable to fetch employee %s from Intranet API : %v", *profile.EmployeeID, err)
+		return specs.IntranetEmployee{}, err
+	}
+	return *emp, nil
+}
+
+// planIntranetProjects compares the intranet projects with the projects of a profile, matched by name, and returns
+// the additions and updates to propose. Empty intranet values never replace profile values.
+func (projSvc *service) planIntranetProjects(ctx context.Context, profileID int, intranetProjects []specs.IntranetProject, tx pgx.Tx) ([]intranetProjectPlan, error) {
+	projects, err := projSvc.ProjectRepo.ListProjects(ctx, profileID, specs.ListProjectsFilter{}, tx)
+	if err != nil {
+		zap.S().Error("Unable to get projects : ", err, " for profile id : ", profileID)
+		return nil, err
+	}
+	projectByName := make(map[string]specs.ProjectResponse, len(projects))
+	for _, project := range projects {
+		projectByName[intranetProjectKey(project.Name)] = project
+	}
+
+	rejections, err := projSvc.ProjectRepo.ListIntranetProjectRejections(ctx, profileID, tx)
+	if err != nil {
+		zap.S().Error("Unable to get rejected intranet projects : ", err, " for profile id : ", profileID)
+		return nil, err
+	}
+	rejectionByName := make(map[string]specs.IntranetProjectRejection, len(rejections))
+	for _, rejection := range rejections {
+		rejectionByName[rejection.NameKey] = rejection
+	}
+
+	plans := []intranetProjectPlan{}
+	planned := map[string]bool{}
+	for _, intranetProject := range intranetProjects {
+		key := intranetProjectKey(intranetProject.Name)
+		if key == "" || planned[key] {
+			continue
+		}
+		planned[key] = true
+
+		rejection, rejected := rejectionByName[key]
+		if rejected && rejection.Description == intranetProject.Description && rejection.WorkingStartDate == intranetProject.StartDate &&
+			rejection.WorkingEndDate == intranetProject.EndDate {
+			continue
+		}
+
+		existing, exists := projectByName[key]
+		fields := []specs.IntranetProjectChange{
+			{Field: "description", OldValue: existing.Description, NewValue: intranetProject.Description},
+			{Field: "working_start_date", OldValue: existing.WorkingStartDate, NewValue: intranetProject.StartDate},
+			{Field: "working_end_date", OldValue: existing.WorkingEndDate, NewValue: intranetProject.EndDate},
+		}
+
+		plan := intranetProjectPlan{intranet: intranetProject, existing: existing}
+		plan.proposal = specs.IntranetProjectProposal{Action: constants.IntranetProjectAdd, Name: strings.TrimSpace(intranetProject.Name), Changes: []specs.IntranetProjectChange{}}
+		if exists {
+			plan.proposal.Action = constants.IntranetProjectUpdate
+			plan.proposal.ProjectID = existing.ID
+			plan.proposal.Name = existing.Name
+		}
+		for _, field := range fields {
+			if field.NewValue != "" && field.NewValue != field.OldValue {
+				plan.proposal.Changes = append(plan.proposal.Changes, field)
+			}
+		}
+		if exists && len(plan.proposal.Changes) == 0 {
+			continue
+		}
+		plans = append(plans, plan)
+	}
+
+	return plans, nil
+}
+
+// intranetProjectKey is the name projects are matched by
+func intranetProjectKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"go.uber.org/zap"
+)
+
+// RoleService contains methods to manage roles and to check the permissions they grant
+type RoleService interface {
+	ListRoles(ctx context.Context) (specs.ListRolesResponse, error)
+	CreateRole(ctx context.Context, req specs.CreateRoleRequest) (roleID int, err error)
+	UpdateRole(ctx context.Context, roleID int, req specs.UpdateRoleRequest) (err error)
+	DeleteRole(ctx context.Context, roleID int) (err error)
+	ListPermissions(ctx context.Context) specs.ListPermissionsResponse
+	AssignUserRole(ctx context.Context, actorID int, userID int, req specs.AssignRoleRequest) (err error)
+	AssignProfileManager(ctx context.Context, profileID int, req specs.AssignManagerRequest) (err error)
+	GetPermissionScope(ctx context.Context, role string, permission string) (string, error)
+	IsReportee(ctx context.Context, managerID int, profileID int) (bool, error)
+}
+
+// permissionCache keeps the role grants in memory so that permission checks do not hit the database on every request
+type permissionCache struct {
+	mutex    sync.RWMutex
+	grants   map[string]map[string]string
+	loadedAt time.Time
+}
+
+// invalidate forces the next permission check to reload the grants from the database
+func (cache *permissionCache) invalidate() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.grants = nil
+}
+
+// ListRoles returns every role along with the permissions it grants
+func (roleSvc *service) ListRoles(ctx context.Context) (values specs.ListRolesResponse, err error) {
+	tx, err := roleSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.ListRolesResponse{}, err
+	}
+	defer func() {
+		txErr := roleSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	roles, err := roleSvc.RoleRepo.ListRoles(ctx, tx)
+	if err != nil {
+		zap.S().Error("Unable to list roles : ", err)
+		return specs.ListRolesResponse{}, err
+	}
+
+	return specs.ListRolesResponse{Roles: roles}, nil
+}
+
+// CreateRole creates a new role with the given permissions
+func (roleSvc *service) CreateRole(ctx context.Context, req specs.CreateRoleRequest) (roleID int, err error) {
+	tx, err := roleSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		txErr := roleSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil {
+			roleSvc.permissionCache.invalidate()
+		}
+	}()
+
+	now := helpers.GetCurrentISTTime()
+	roleID, err = roleSvc.RoleRepo.CreateRole(ctx, repository.RoleRepo{
+		Name:        req.Name,
+		Description: req.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to create role %s : %v", req.Name, err)
+		return 0, err
+	}
+
+	err = roleSvc.RoleRepo.ReplaceRolePermissions(ctx, roleID, req.Permissions, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to grant permissions to role %s : %v", req.Name, err)
+		return 0, err
+	}
+
+	zap.S().Infof("Role %s created with id %d", req.Name, roleID)
+	return roleID, nil
+}
+
+// UpdateRole replaces the description and permissions of a role. System roles cannot be changed.
+func (roleSvc *service) UpdateRole(ctx context.Context, roleID int, req specs.UpdateRoleRequest) (err error) {
+	tx, err := roleSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		txErr := roleSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil {
+			roleSvc.permissionCache.invalidate()
+		}
+	}()
+
+	role, err := roleSvc.RoleRepo.GetRole(ctx, roleID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to get role %d : %v", roleID, err)
+		return err
+	}
+
+	if role.IsSystem {
+		return errors.ErrSystemRole
+	}
+
+	err = roleSvc.RoleRepo.UpdateRole(ctx, roleID, req.Description, helpers.GetCurrentISTTime(), tx)
+	if err != nil {
+		zap.S().Errorf("Unable to update role %d : %v", roleID, err)
+		return err
+	}
+
+	err = roleSvc.RoleRepo.ReplaceRolePermissions(ctx, roleID, req.Permissions, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to replace permissions of role %d : %v", roleID, err)
+		return err
+	}
+
+	zap.S().Infof("Role %s updated", role.Name)
+	return nil
+}
+
+// DeleteRole deletes a role that is neither a system role nor assigned to any user
+func (roleSvc *service) DeleteRole(ctx context.Context, roleID int) (err error) {
+	tx, err := roleSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		txErr := roleSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil {
+			roleSvc.permissionCache.invalidate()
+		}
+	}()
+
+	role, err := roleSvc.RoleRepo.GetRole(ctx, roleID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to get role %d : %v", roleID, err)
+		return err
+	}
+
+	if role.IsSystem {
+		return errors.ErrSystemRole
+	}
+
+	count, err := roleSvc.RoleRepo.CountUsersWithRole(ctx, role.Name, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to count users with role %s : %v", role.Name, err)
+		return err
+	}
+
+	if count > 0 {
+		return errors.ErrRoleInUse
+	}
+
+	err = roleSvc.RoleRepo.DeleteRole(ctx, roleID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to delete role %d : %v", roleID, err)
+		return err
+	}
+
+	zap.S().Infof("Role %s deleted", role.Name)
+	return nil
+}
+
+// ListPermissions returns every permission and scope that can be granted to a role
+func (roleSvc *service) ListPermissions(ctx context.Context) specs.ListPermissionsResponse {
+	permissions := make([]specs.PermissionResponse, 0, len(constants.Permissions))
+	for name, description := range constants.Permissions {
+		permissions = append(permissions, specs.PermissionResponse{Name: name, Description: description})
+	}
+	sort.Slice(permissions, func(i, j int) bool {
+		return permissions[i].Name < permissions[j].Name
+	})
+
+	return specs.ListPermissionsResponse{
+		Permissions: permissions,
+		Scopes:      []string{constants.ScopeAll, constants.ScopeReportees, constants.ScopeOwn},
+	}
+}
+
+// AssignUserRole changes the role of a user and signs them out of every session
+func (roleSvc *service) AssignUserRole(ctx context.Context, actorID int, userID int, req specs.AssignRoleRequest) (err error) {
+	roleChanged := false
+
+	tx, err := roleSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		txErr := roleSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil && roleChanged {
+			helpers.RevokeUserTokens(int64(userID))
+		}
+	}()
+
+	user, err := roleSvc.UserLoginRepo.GetUser(ctx, userID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to get user %d : %v", userID, err)
+		return err
+	}
+
+	if user.Role == req.Role {
+		return nil
+	}
+	roleChanged = true
+
+	err = roleSvc.checkUserRemoval(ctx, actorID, user, tx)
+	if err != nil {
+		return err
+	}
+
+	err = roleSvc.UserLoginRepo.UpdateUserRole(ctx, userID, req.Role, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to assign role %s to user %d : %v", req.Role, userID, err)
+		return err
+	}
+
+	zap.S().Infof("Role %s assigned to user %d", req.Role, userID)
+	return nil
+}
+
+// AssignProfileManager sets or clears the reporting manager of a profile
+func (roleSvc *service) AssignProfileManager(ctx context.Context, profileID int, req specs.AssignManagerRequest) (err error) {
+	tx, err := roleSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		txErr := roleSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	err = roleSvc.ProfileRepo.UpdateProfileManager(ctx, profileID, req.ManagerID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to assign manager to profile %d : %v", profileID, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetPermissionScope returns the scope in which the role holds the permission, or ErrPermissionDenied when it does not hold it
+func (roleSvc *service) GetPermissionScope(ctx context.Context, role string, permission string) (string, error) {
+	cache := roleSvc.permissionCache
+
+	cache.mutex.RLock()
+	grants, loadedAt := cache.grants, cache.loadedAt
+	cache.mutex.RUnlock()
+
+	if grants == nil || time.Since(loadedAt) > constants.RolePermissionsCacheTTL {
+		var err error
+		grants, err = roleSvc.RoleRepo.ListRolePermissions(ctx)
+		if err != nil {
+			zap.S().Error("Unable to load role permissions : ", err)
+			return "", err
+		}
+
+		cache.mutex.Lock()
+		cache.grants, cache.loadedAt = grants, time.Now()
+		cache.mutex.Unlock()
+	}
+
+	scope, ok := grants[role][permission]
+	if !ok {
+		return "", errors.ErrPermissionDenied
+	}
+	return scope, nil
+}
+
+// IsReportee returns true if the profile reports to the given manager
+func (roleSvc *service) IsReportee(ctx context.Context, managerID int, profileID int) (bool, error) {
+	return roleSvc.ProfileRepo.IsReportee(ctx, managerID, profileID)
+}
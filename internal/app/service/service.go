@@ -2,10 +2,10 @@ package service
 
 import (
 	"context"
-	"os"
 	"strings"
 
 	"github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/notifier"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
@@ -16,21 +16,34 @@ import (
 
 // service implements the Service interface.
 type service struct {
-	UserLoginRepo   repository.UserStorer
-	UserEmailRepo   repository.EmailStorer
-	ProfileRepo     repository.ProfileStorer
-	EducationRepo   repository.EducationStorer
-	ExperienceRepo  repository.ExperienceStorer
-	ProjectRepo     repository.ProjectStorer
-	CertificateRepo repository.CertificateStorer
-	AchievementRepo repository.AchievementStorer
-	IntranetClient  intranet.IntranetClient
+	UserLoginRepo       repository.UserStorer
+	UserEmailRepo       repository.EmailStorer
+	ProfileRepo         repository.ProfileStorer
+	EducationRepo       repository.EducationStorer
+	ExperienceRepo      repository.ExperienceStorer
+	ProjectRepo         repository.ProjectStorer
+	CertificateRepo     repository.CertificateStorer
+	AchievementRepo     repository.AchievementStorer
+	RoleRepo            repository.RoleStorer
+	AuditRepo           repository.AuditStorer
+	APIKeyRepo          repository.APIKeyStorer
+	IntranetSyncRepo    repository.IntranetSyncStorer
+	IntranetWebhookRepo repository.IntranetWebhookStorer
+	EmailOutboxRepo     repository.EmailOutboxStorer
+	EmailTemplateRepo   repository.EmailTemplateStorer
+	NotificationRepo    repository.NotificationStorer
+	ProfileReminderRepo repository.ProfileReminderStorer
+	BackupRepo          repository.BackupStorer
+	IntranetClient      intranet.IntranetClient
+	Notifier            notifier.Notifier
+	permissionCache     *permissionCache
+	notificationBroker  *notificationBroker
 }
 
 // Service interface provides methods to interact with user profiles.
 type Service interface {
 	CreateProfile(ctx context.Context, profileDetail specs.CreateProfileRequest, userID int) (profileID int, err error)
-	ListProfiles(ctx context.Context) (values []specs.ResponseListProfiles, err error)
+	ListProfiles(ctx context.Context, filter specs.ListProfilesFilter) (values []specs.ResponseListProfiles, err error)
 	ListSkills(ctx context.Context) (values specs.ListSkills, err error)
 	GetProfile(ctx context.Context, id int) (value specs.ResponseProfile, err error)
 	UpdateProfile(ctx context.Context, profileID int, userID int, profileDetail specs.UpdateProfileRequest) (ID int, err error)
@@ -38,14 +51,9 @@ type Service interface {
 	UpdateProfileStatus(ctx context.Context, profileID int, req specs.UpdateProfileStatus) (err error)
 	DeleteProfile(ctx context.Context, profileID int) (err error)
 	ResolveEmployeeID(ctx context.Context, employeeID string) (int, error)
-	SyncEmployees(ctx context.Context) (updated int, skipped int, err error)
 	GetIntranetEmployee(ctx context.Context, employeeID string) (specs.IntranetEmployeeResponse, error)
 	CreateFullProfile(ctx context.Context, req specs.CreateFullProfileRequest, userID int) (profileID int, err error)
 
-	// Description: It takes backups of all user profiles and stores them in an SQL file.
-	// Intentionally added here because, going forward, if there is any requirement for an API endpoint, it is currently being used by a cron job.
-	BackupAllProfiles() error
-
 	UserLoginServive
 	EducationService
 	ProjectService
@@ -53,39 +61,80 @@ type Service interface {
 	CertificateService
 	AchievementService
 	UserEmailService
+	RoleService
+	UserService
+	AuditService
+	APIKeyService
+	InternalProfileService
+	IntranetSyncService
+	IntranetCacheService
+	IntranetWebhookService
+	EmailOutboxService
+	EmailTemplateService
+	InvitationService
+	NotificationService
+	ProfileReminderService
+	BackupService
 }
 
 // RepoDeps is used to intialize repo dependencies
 type RepoDeps struct {
-	UserLoginDeps   repository.UserStorer
-	UserEmailDeps   repository.EmailStorer
-	ProfileDeps     repository.ProfileStorer
-	EducationDeps   repository.EducationStorer
-	ExperienceDeps  repository.ExperienceStorer
-	ProjectDeps     repository.ProjectStorer
-	CertificateDeps repository.CertificateStorer
-	AchievementDeps repository.AchievementStorer
-	IntranetClient  intranet.IntranetClient
+	UserLoginDeps       repository.UserStorer
+	UserEmailDeps       repository.EmailStorer
+	ProfileDeps         repository.ProfileStorer
+	EducationDeps       repository.EducationStorer
+	ExperienceDeps      repository.ExperienceStorer
+	ProjectDeps         repository.ProjectStorer
+	CertificateDeps     repository.CertificateStorer
+	AchievementDeps     repository.AchievementStorer
+	RoleDeps            repository.RoleStorer
+	AuditDeps           repository.AuditStorer
+	APIKeyDeps          repository.APIKeyStorer
+	IntranetSyncDeps    repository.IntranetSyncStorer
+	IntranetWebhookDeps repository.IntranetWebhookStorer
+	EmailOutboxDeps     repository.EmailOutboxStorer
+	EmailTemplateDeps   repository.EmailTemplateStorer
+	NotificationDeps    repository.NotificationStorer
+	ProfileReminderDeps repository.ProfileReminderStorer
+	BackupDeps          repository.BackupStorer
+	IntranetClient      intranet.IntranetClient
+	Notifier            notifier.Notifier
 }
 
 // NewServices creates a new instance of the Service.
 func NewServices(rp RepoDeps) Service {
 	return &service{
-		UserLoginRepo:   rp.UserLoginDeps,
-		UserEmailRepo:   rp.UserEmailDeps,
-		ProfileRepo:     rp.ProfileDeps,
-		EducationRepo:   rp.EducationDeps,
-		ExperienceRepo:  rp.ExperienceDeps,
-		ProjectRepo:     rp.ProjectDeps,
-		CertificateRepo: rp.CertificateDeps,
-		AchievementRepo: rp.AchievementDeps,
-		IntranetClient:  rp.IntranetClient,
+		UserLoginRepo:       rp.UserLoginDeps,
+		UserEmailRepo:       rp.UserEmailDeps,
+		ProfileRepo:         rp.ProfileDeps,
+		EducationRepo:       rp.EducationDeps,
+		ExperienceRepo:      rp.ExperienceDeps,
+		ProjectRepo:         rp.ProjectDeps,
+		CertificateRepo:     rp.CertificateDeps,
+		AchievementRepo:     rp.AchievementDeps,
+		RoleRepo:            rp.RoleDeps,
+		AuditRepo:           rp.AuditDeps,
+		APIKeyRepo:          rp.APIKeyDeps,
+		IntranetSyncRepo:    rp.IntranetSyncDeps,
+		IntranetWebhookRepo: rp.IntranetWebhookDeps,
+		EmailOutboxRepo:     rp.EmailOutboxDeps,
+		EmailTemplateRepo:   rp.EmailTemplateDeps,
+		NotificationRepo:    rp.NotificationDeps,
+		ProfileReminderRepo: rp.ProfileReminderDeps,
+		BackupRepo:          rp.BackupDeps,
+		IntranetClient:      rp.IntranetClient,
+		Notifier:            rp.Notifier,
+		permissionCache:     &permissionCache{},
+		notificationBroker:  newNotificationBroker(),
 	}
 }
 
 // CreateProfile : Service layer function creates a new user profile using the provided details.
 func (profileSvc *service) CreateProfile(ctx context.Context, profileDetail specs.CreateProfileRequest, userID int) (profileID int, err error) {
-	tx, _ := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := profileSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -130,8 +179,11 @@ func (profileSvc *service) CreateProfile(ctx context.Context, profileDetail spec
 }
 
 // ListProfiles in the service layer retrieves a list of user profiles.
-func (profileSvc *service) ListProfiles(ctx context.Context) (values []specs.ResponseListProfiles, err error) {
-	tx, _ := profileSvc.ProfileRepo.BeginTransaction(ctx)
+func (profileSvc *service) ListProfiles(ctx context.Context, filter specs.ListProfilesFilter) (values []specs.ResponseListProfiles, err error) {
+	tx, err := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return []specs.ResponseListProfiles{}, err
+	}
 	defer func() {
 		txErr := profileSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -140,7 +192,7 @@ func (profileSvc *service) ListProfiles(ctx context.Context) (values []specs.Res
 		}
 	}()
 
-	profiles, err := profileSvc.ProfileRepo.ListProfiles(ctx, tx)
+	profiles, err := profileSvc.ProfileRepo.ListProfiles(ctx, filter, tx)
 	if err != nil {
 		zap.S().Error("Unable to list profile : ", err)
 		return []specs.ResponseListProfiles{}, err
@@ -172,7 +224,10 @@ func (profileSvc *service) ListProfiles(ctx context.Context) (values []specs.Res
 
 // ListSkills in the service layer retrieves a list of skills.
 func (profileSvc *service) ListSkills(ctx context.Context) (values specs.ListSkills, err error) {
-	tx, _ := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.ListSkills{}, err
+	}
 	defer func() {
 		txErr := profileSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -191,7 +246,10 @@ func (profileSvc *service) ListSkills(ctx context.Context) (values specs.ListSki
 
 // GetProfile in the service layer retrieves a list of user profiles.
 func (profileSvc *service) GetProfile(ctx context.Context, id int) (value specs.ResponseProfile, err error) {
-	tx, _ := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.ResponseProfile{}, err
+	}
 	defer func() {
 		txErr := profileSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -210,7 +268,10 @@ func (profileSvc *service) GetProfile(ctx context.Context, id int) (value specs.
 
 // UpdateProfile in the service layer updates user profile.
 func (profileSvc *service) UpdateProfile(ctx context.Context, profileID int, userID int, profileDetail specs.UpdateProfileRequest) (ID int, err error) {
-	tx, _ := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := profileSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -270,7 +331,10 @@ func (profileSvc *service) UpdateProfile(ctx context.Context, profileID int, use
 }
 
 func (profileSvc *service) DeleteProfile(ctx context.Context, profileID int) (err error) {
-	tx, _ := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
 	defer func() {
 		txErr := profileSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -295,7 +359,10 @@ func (profileSvc *service) DeleteProfile(ctx context.Context, profileID int) (er
 
 // ResolveEmployeeID resolves employee_id to its internal profile_id in the service layer.
 func (profileSvc *service) ResolveEmployeeID(ctx context.Context, employeeID string) (profileID int, err error) {
-	tx, _ := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := profileSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -314,7 +381,10 @@ func (profileSvc *service) ResolveEmployeeID(ctx context.Context, employeeID str
 
 // UpdateSequence in the service layer updates sequence of components.
 func (profileSvc *service) UpdateSequence(ctx context.Context, userID int, seqDetail specs.UpdateSequenceRequest) (ID int, err error) {
-	tx, _ := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := profileSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -355,7 +425,11 @@ func (profileSvc *service) UpdateSequence(ctx context.Context, userID int, seqDe
 }
 
 func (profileSvc *service) UpdateProfileStatus(ctx context.Context, profileID int, req specs.UpdateProfileStatus) (err error) {
-	tx, _ := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	ctx = helpers.WithAuditAction(ctx, constants.AuditActionStatusChange)
+	tx, err := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
 	defer func() {
 		txErr := profileSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -398,44 +472,6 @@ func (profileSvc *service) UpdateProfileStatus(ctx context.Context, profileID in
 	return nil
 }
 
-func (profileSvc *service) BackupAllProfiles() error {
-	backupDir := os.Getenv("BACKUP_DIR")
-	if backupDir == "" {
-		return errors.ErrEmptyPayload
-	}
-	profileSvc.ProfileRepo.BackupAllProfiles(backupDir)
-	return nil
-}
-
-// SyncEmployees fetches all employees from the Intranet API and updates the employee_id
-func (profileSvc *service) SyncEmployees(ctx context.Context) (updated int, skipped int, err error) {
-	employees, err := profileSvc.IntranetClient.GetEmployees(ctx)
-	if err != nil {
-		zap.S().Error("SyncEmployees: failed to fetch employees from Intranet API: ", err)
-		return 0, 0, err
-	}
-
-	zap.S().Infof("SyncEmployees: fetched %d employees from Intranet API", len(employees))
-
-	for _, emp := range employees {
-		updateErr := profileSvc.ProfileRepo.UpdateEmployeeIDByEmail(ctx, emp.Email, emp.EmployeeID)
-		if updateErr != nil {
-			if updateErr == errors.ErrNoRecordFound {
-				zap.S().Infof("SyncEmployees: no profile found for email %s, skipping", emp.Email)
-			} else {
-				zap.S().Errorf("SyncEmployees: failed to update employee_id for email %s: %v", emp.Email, updateErr)
-			}
-			skipped++
-			continue
-		}
-
-		zap.S().Infof("SyncEmployees: updated employee_id=%s for email %s", emp.EmployeeID, emp.Email)
-		updated++
-	}
-
-	return updated, skipped, nil
-}
-
 // GetIntranetEmployee fetches an employee by ID from the Intranet API and formats it for form pre-fill.
 func (profileSvc *service) GetIntranetEmployee(ctx context.Context, employeeID string) (specs.IntranetEmployeeResponse, error) {
 	profileID, err := profileSvc.ResolveEmployeeID(ctx, employeeID)
@@ -458,24 +494,6 @@ func (profileSvc *service) GetIntranetEmployee(ctx context.Context, employeeID s
 		return specs.IntranetEmployeeResponse{}, err
 	}
 
-	var primarySkills []string
-	if emp.PrimarySkill != "" {
-		for _, s := range strings.Split(emp.PrimarySkill, ",") {
-			primarySkills = append(primarySkills, strings.TrimSpace(s))
-		}
-	} else {
-		primarySkills = []string{}
-	}
-
-	var secondarySkills []string
-	if emp.SecondarySkill != "" {
-		for _, s := range strings.Split(emp.SecondarySkill, ",") {
-			secondarySkills = append(secondarySkills, strings.TrimSpace(s))
-		}
-	} else {
-		secondarySkills = []string{}
-	}
-
 	response := specs.IntranetEmployeeResponse{
 		EmployeeID:        emp.EmployeeID,
 		Email:             emp.Email,
@@ -487,8 +505,8 @@ func (profileSvc *service) GetIntranetEmployee(ctx context.Context, employeeID s
 		JoshJoiningDate:   emp.JoshDOJ,
 		LinkedinURL:       emp.LinkedinURL,
 		GithubURL:         emp.GithubURL,
-		PrimarySkills:     primarySkills,
-		SecondarySkills:   secondarySkills,
+		PrimarySkills:     splitIntranetSkills(emp.PrimarySkill),
+		SecondarySkills:   splitIntranetSkills(emp.SecondarySkill),
 		Qualification:     emp.Qualification,
 	}
 
@@ -511,7 +529,10 @@ func (profileSvc *service) GetIntranetEmployee(ctx context.Context, employeeID s
 
 // CreateFullProfile creates a profile along with educations and projects in a single transaction.
 func (profileSvc *service) CreateFullProfile(ctx context.Context, req specs.CreateFullProfileRequest, userID int) (profileID int, err error) {
-	tx, _ := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	tx, err := profileSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
 	defer func() {
 		txErr := profileSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
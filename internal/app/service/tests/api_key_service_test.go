@@ -0,0 +1,220 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	errs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testAPIKey = "pbk_0a1b2c3d_5f3c9e"
+
+func testAPIKeyHash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	recent := time.Now().Add(-time.Second)
+
+	credential := repository.APIKeyCredential{
+		ID:      7,
+		Name:    "staffing",
+		KeyHash: testAPIKeyHash(testAPIKey),
+		Scopes:  []string{constants.APIScopeProfilesResolve},
+	}
+
+	tests := []struct {
+		name              string
+		key               string
+		clientIP          string
+		setup             func(apiKeyMock *mocks.APIKeyStorer)
+		expectedPrincipal specs.APIKeyPrincipal
+		expectedErr       error
+	}{
+		{
+			name:     "Success_for_valid_key",
+			key:      testAPIKey,
+			clientIP: "192.0.2.1",
+			setup: func(apiKeyMock *mocks.APIKeyStorer) {
+				apiKeyMock.On("GetAPIKeyCredential", mock.Anything, "0a1b2c3d").Return(credential, nil).Once()
+				apiKeyMock.On("UpdateAPIKeyLastUsed", mock.Anything, 7, mock.Anything, "192.0.2.1").Return(nil).Once()
+			},
+			expectedPrincipal: specs.APIKeyPrincipal{ID: 7, Name: "staffing", Scopes: []string{constants.APIScopeProfilesResolve}},
+		},
+		{
+			name:     "Success_for_recently_used_key_in_allowed_network",
+			key:      testAPIKey,
+			clientIP: "10.1.2.3",
+			setup: func(apiKeyMock *mocks.APIKeyStorer) {
+				cred := credential
+				cred.AllowedIPs = []string{"192.0.2.1", "10.0.0.0/8"}
+				cred.LastUsedAt = &recent
+				apiKeyMock.On("GetAPIKeyCredential", mock.Anything, "0a1b2c3d").Return(cred, nil).Once()
+			},
+			expectedPrincipal: specs.APIKeyPrincipal{ID: 7, Name: "staffing", Scopes: []string{constants.APIScopeProfilesResolve}},
+		},
+		{
+			name:        "Fail_for_malformed_key",
+			key:         "not-a-key",
+			setup:       func(apiKeyMock *mocks.APIKeyStorer) {},
+			expectedErr: errs.ErrAPIKeyInvalid,
+		},
+		{
+			name: "Fail_for_unknown_prefix",
+			key:  testAPIKey,
+			setup: func(apiKeyMock *mocks.APIKeyStorer) {
+				apiKeyMock.On("GetAPIKeyCredential", mock.Anything, "0a1b2c3d").Return(repository.APIKeyCredential{}, errs.ErrAPIKeyNotFound).Once()
+			},
+			expectedErr: errs.ErrAPIKeyInvalid,
+		},
+		{
+			name: "Fail_for_wrong_secret",
+			key:  "pbk_0a1b2c3d_000000",
+			setup: func(apiKeyMock *mocks.APIKeyStorer) {
+				apiKeyMock.On("GetAPIKeyCredential", mock.Anything, "0a1b2c3d").Return(credential, nil).Once()
+			},
+			expectedErr: errs.ErrAPIKeyInvalid,
+		},
+		{
+			name: "Fail_for_revoked_key",
+			key:  testAPIKey,
+			setup: func(apiKeyMock *mocks.APIKeyStorer) {
+				cred := credential
+				cred.RevokedAt = &past
+				apiKeyMock.On("GetAPIKeyCredential", mock.Anything, "0a1b2c3d").Return(cred, nil).Once()
+			},
+			expectedErr: errs.ErrAPIKeyRevoked,
+		},
+		{
+			name: "Fail_for_expired_key",
+			key:  testAPIKey,
+			setup: func(apiKeyMock *mocks.APIKeyStorer) {
+				cred := credential
+				cred.ExpiresAt = &past
+				apiKeyMock.On("GetAPIKeyCredential", mock.Anything, "0a1b2c3d").Return(cred, nil).Once()
+			},
+			expectedErr: errs.ErrAPIKeyExpired,
+		},
+		{
+			name:     "Fail_for_disallowed_address",
+			key:      testAPIKey,
+			clientIP: "203.0.113.9",
+			setup: func(apiKeyMock *mocks.APIKeyStorer) {
+				cred := credential
+				cred.AllowedIPs = []string{"192.0.2.1", "10.0.0.0/8"}
+				apiKeyMock.On("GetAPIKeyCredential", mock.Anything, "0a1b2c3d").Return(cred, nil).Once()
+			},
+			expectedErr: errs.ErrAPIKeyIP,
+		},
+		{
+			name:     "Success_when_last_used_update_fails",
+			key:      testAPIKey,
+			clientIP: "192.0.2.1",
+			setup: func(apiKeyMock *mocks.APIKeyStorer) {
+				apiKeyMock.On("GetAPIKeyCredential", mock.Anything, "0a1b2c3d").Return(credential, nil).Once()
+				apiKeyMock.On("UpdateAPIKeyLastUsed", mock.Anything, 7, mock.Anything, "192.0.2.1").Return(errors.New("db error")).Once()
+			},
+			expectedPrincipal: specs.APIKeyPrincipal{ID: 7, Name: "staffing", Scopes: []string{constants.APIScopeProfilesResolve}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKeyMock := new(mocks.APIKeyStorer)
+			apiKeySvc := service.NewServices(service.RepoDeps{APIKeyDeps: apiKeyMock})
+			tt.setup(apiKeyMock)
+
+			principal, err := apiKeySvc.AuthenticateAPIKey(context.Background(), tt.key, tt.clientIP)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedPrincipal, principal)
+			apiKeyMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	apiKeyMock := new(mocks.APIKeyStorer)
+	profileMock := new(mocks.ProfileStorer)
+	apiKeySvc := service.NewServices(service.RepoDeps{APIKeyDeps: apiKeyMock, ProfileDeps: profileMock})
+
+	var stored repository.APIKeyRepo
+	profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+	apiKeyMock.On("CreateAPIKey", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(repository.APIKeyRepo)
+	}).Return(3, nil).Once()
+	profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+
+	resp, err := apiKeySvc.CreateAPIKey(context.Background(), 1, specs.CreateAPIKeyRequest{
+		Name:   "staffing",
+		Scopes: []string{constants.APIScopeProfilesRead},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, resp.APIKeyID)
+	assert.Regexp(t, regexp.MustCompile(`^pbk_[0-9a-f]{8}_[0-9a-f]{64}$`), resp.Key)
+	assert.Equal(t, resp.Key[4:12], stored.Prefix)
+	assert.Equal(t, testAPIKeyHash(resp.Key), stored.KeyHash)
+	assert.Equal(t, 1, stored.CreatedByID)
+	apiKeyMock.AssertExpectations(t)
+	profileMock.AssertExpectations(t)
+}
+
+func TestRotateAPIKey(t *testing.T) {
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name        string
+		setup       func(apiKeyMock *mocks.APIKeyStorer, profileMock *mocks.ProfileStorer)
+		expectedErr error
+	}{
+		{
+			name: "Success_for_active_key",
+			setup: func(apiKeyMock *mocks.APIKeyStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				apiKeyMock.On("GetAPIKey", mock.Anything, 3, mock.Anything).Return(specs.APIKeyResponse{ID: 3, Name: "staffing"}, nil).Once()
+				apiKeyMock.On("RotateAPIKey", mock.Anything, 3, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+		},
+		{
+			name: "Fail_for_revoked_key",
+			setup: func(apiKeyMock *mocks.APIKeyStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				apiKeyMock.On("GetAPIKey", mock.Anything, 3, mock.Anything).Return(specs.APIKeyResponse{ID: 3, Name: "staffing", RevokedAt: &revokedAt}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, errs.ErrAPIKeyRevoked).Return(nil).Once()
+			},
+			expectedErr: errs.ErrAPIKeyRevoked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKeyMock := new(mocks.APIKeyStorer)
+			profileMock := new(mocks.ProfileStorer)
+			apiKeySvc := service.NewServices(service.RepoDeps{APIKeyDeps: apiKeyMock, ProfileDeps: profileMock})
+			tt.setup(apiKeyMock, profileMock)
+
+			resp, err := apiKeySvc.RotateAPIKey(context.Background(), 3)
+			assert.Equal(t, tt.expectedErr, err)
+			if tt.expectedErr == nil {
+				assert.NotEmpty(t, resp.Key)
+			}
+			apiKeyMock.AssertExpectations(t)
+			profileMock.AssertExpectations(t)
+		})
+	}
+}
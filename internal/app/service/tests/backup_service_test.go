@@ -0,0 +1,374 @@
+package service_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	pkgerrors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	repomocks "github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var backupRows = map[string][]string{
+	"users":    {`{"id": 1, "email": "admin@example.com"}`},
+	"profiles": {`{"id": 3, "name": "John <Doe>"}`, `{"id": 4, "name": "Jane"}`},
+}
+
+// writeTestBackup backs up backupRows with mocked repos and returns the path of the archive
+func writeTestBackup(t *testing.T, compress string) string {
+	t.Helper()
+	t.Setenv(constants.BackupDirEnvVar, t.TempDir())
+	t.Setenv(constants.BackupCompressEnvVar, compress)
+	mockBackupRepo := new(repomocks.BackupStorer)
+	mockProfileRepo := new(repomocks.ProfileStorer)
+	svc := service.NewServices(service.RepoDeps{BackupDeps: mockBackupRepo, ProfileDeps: mockProfileRepo})
+
+	var tx pgx.Tx
+	mockBackupRepo.On("BeginSnapshot", mock.Anything).Return(tx, nil).Once()
+	mockBackupRepo.On("GetSchemaVersion", mock.Anything, mock.Anything).Return(int64(22), nil).Once()
+	for _, table := range constants.BackupTables {
+		rows := backupRows[table]
+		mockBackupRepo.On("ExportTableRows", mock.Anything, table, mock.Anything, mock.Anything).Return(len(rows), nil).
+			Run(func(args mock.Arguments) {
+				write := args.Get(3).(func(row json.RawMessage) error)
+				for _, row := range rows {
+					require.NoError(t, write(json.RawMessage(row)))
+				}
+			}).Once()
+	}
+	mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+
+	report, err := svc.BackupAllProfiles(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(22), report.SchemaVersion)
+	assert.Equal(t, 2, report.RowCounts["profiles"])
+	assert.Equal(t, 0, report.RowCounts["projects"])
+	mockBackupRepo.AssertExpectations(t)
+	return report.Path
+}
+
+// mockRestoreTarget expects a restore into an empty database at schemaVersion
+func mockRestoreTarget(mockBackupRepo *repomocks.BackupStorer, mockProfileRepo *repomocks.ProfileStorer, schemaVersion int64) {
+	var tx pgx.Tx
+	mockProfileRepo.On("BeginTransaction", mock.Anything).Return(tx, nil).Once()
+	mockBackupRepo.On("GetSchemaVersion", mock.Anything, mock.Anything).Return(schemaVersion, nil).Once()
+	mockBackupRepo.On("CountTableRows", mock.Anything, mock.Anything, mock.Anything).Return(0, nil).Maybe()
+	mockBackupRepo.On("DisableTriggers", mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	mockBackupRepo.On("ListMissingUserRoles", mock.Anything, mock.Anything).Return([]string{}, nil).Maybe()
+}
+
+func TestBackupAllProfiles(t *testing.T) {
+	t.Run("Writes_an_archive_that_restores", func(t *testing.T) {
+		for _, compress := range []string{"true", "false"} {
+			path := writeTestBackup(t, compress)
+			assert.Equal(t, compress == "true", filepath.Ext(path) == constants.BackupGzipExt)
+			_, err := os.Stat(path + constants.BackupPartialExt)
+			assert.True(t, os.IsNotExist(err))
+			checksum, err := os.ReadFile(path + constants.BackupChecksumExt)
+			require.NoError(t, err)
+			archive, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("%x  %s\n", sha256.Sum256(archive), filepath.Base(path)), string(checksum))
+
+			mockBackupRepo := new(repomocks.BackupStorer)
+			mockProfileRepo := new(repomocks.ProfileStorer)
+			svc := service.NewServices(service.RepoDeps{BackupDeps: mockBackupRepo, ProfileDeps: mockProfileRepo})
+			mockRestoreTarget(mockBackupRepo, mockProfileRepo, 22)
+			mockBackupRepo.On("ImportTableRows", mock.Anything, "users", []json.RawMessage{json.RawMessage(`{"id":1,"email":"admin@example.com"}`)}, mock.Anything).Return(nil).Once()
+			mockBackupRepo.On("ImportTableRows", mock.Anything, "profiles", []json.RawMessage{
+				json.RawMessage(`{"id":3,"name":"John <Doe>"}`),
+				json.RawMessage(`{"id":4,"name":"Jane"}`),
+			}, mock.Anything).Return(nil).Once()
+			mockBackupRepo.On("ResetTableIdentity", mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(len(constants.BackupTables) - len(constants.BackupMergedTables))
+			mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+
+			report, err := svc.RestoreBackup(context.Background(), path)
+
+			require.NoError(t, err)
+			assert.True(t, report.TriggersDisabled)
+			assert.Equal(t, 1, report.RowCounts["users"])
+			assert.Equal(t, 2, report.RowCounts["profiles"])
+			for _, table := range constants.BackupMergedTables {
+				mockBackupRepo.AssertNotCalled(t, "CountTableRows", mock.Anything, table, mock.Anything)
+				mockBackupRepo.AssertNotCalled(t, "ResetTableIdentity", mock.Anything, table, mock.Anything)
+			}
+			mockBackupRepo.AssertExpectations(t)
+			mockProfileRepo.AssertExpectations(t)
+		}
+	})
+
+	t.Run("Removes_the_archive_when_the_backup_fails", func(t *testing.T) {
+		dir := t.TempDir()
+		t.Setenv(constants.BackupDirEnvVar, dir)
+		mockBackupRepo := new(repomocks.BackupStorer)
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		svc := service.NewServices(service.RepoDeps{BackupDeps: mockBackupRepo, ProfileDeps: mockProfileRepo})
+
+		var tx pgx.Tx
+		exportErr := errors.New("connection reset")
+		mockBackupRepo.On("BeginSnapshot", mock.Anything).Return(tx, nil).Once()
+		mockBackupRepo.On("GetSchemaVersion", mock.Anything, mock.Anything).Return(int64(22), nil).Once()
+		mockBackupRepo.On("ExportTableRows", mock.Anything, constants.BackupTables[0], mock.Anything, mock.Anything).Return(0, exportErr).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, exportErr).Return(nil).Once()
+
+		_, err := svc.BackupAllProfiles(context.Background())
+
+		assert.Equal(t, exportErr, err)
+		entries, _ := os.ReadDir(dir)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("Fails_without_a_backup_directory", func(t *testing.T) {
+		t.Setenv(constants.BackupDirEnvVar, "")
+		svc := service.NewServices(service.RepoDeps{})
+
+		_, err := svc.BackupAllProfiles(context.Background())
+
+		assert.Equal(t, pkgerrors.ErrBackupDirNotSet, err)
+	})
+}
+
+func TestRestoreBackup(t *testing.T) {
+	t.Run("Refuses_a_database_at_another_schema_version", func(t *testing.T) {
+		path := writeTestBackup(t, "true")
+		mockBackupRepo := new(repomocks.BackupStorer)
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		svc := service.NewServices(service.RepoDeps{BackupDeps: mockBackupRepo, ProfileDeps: mockProfileRepo})
+		mockRestoreTarget(mockBackupRepo, mockProfileRepo, 23)
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := svc.RestoreBackup(context.Background(), path)
+
+		assert.ErrorIs(t, err, pkgerrors.ErrBackupSchemaMismatch)
+		mockBackupRepo.AssertNotCalled(t, "ImportTableRows", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Refuses_a_database_with_rows", func(t *testing.T) {
+		path := writeTestBackup(t, "true")
+		mockBackupRepo := new(repomocks.BackupStorer)
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		svc := service.NewServices(service.RepoDeps{BackupDeps: mockBackupRepo, ProfileDeps: mockProfileRepo})
+
+		var tx pgx.Tx
+		mockProfileRepo.On("BeginTransaction", mock.Anything).Return(tx, nil).Once()
+		mockBackupRepo.On("GetSchemaVersion", mock.Anything, mock.Anything).Return(int64(22), nil).Once()
+		mockBackupRepo.On("CountTableRows", mock.Anything, "users", mock.Anything).Return(1, nil).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := svc.RestoreBackup(context.Background(), path)
+
+		assert.ErrorIs(t, err, pkgerrors.ErrRestoreTargetNotEmpty)
+		mockBackupRepo.AssertNotCalled(t, "ImportTableRows", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Refuses_a_backup_whose_users_have_roles_missing", func(t *testing.T) {
+		path := writeTestBackup(t, "true")
+		mockBackupRepo := new(repomocks.BackupStorer)
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		svc := service.NewServices(service.RepoDeps{BackupDeps: mockBackupRepo, ProfileDeps: mockProfileRepo})
+
+		var tx pgx.Tx
+		mockProfileRepo.On("BeginTransaction", mock.Anything).Return(tx, nil).Once()
+		mockBackupRepo.On("GetSchemaVersion", mock.Anything, mock.Anything).Return(int64(22), nil).Once()
+		mockBackupRepo.On("CountTableRows", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+		mockBackupRepo.On("DisableTriggers", mock.Anything, mock.Anything).Return(true, nil).Once()
+		mockBackupRepo.On("ImportTableRows", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockBackupRepo.On("ListMissingUserRoles", mock.Anything, mock.Anything).Return([]string{"sales"}, nil).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := svc.RestoreBackup(context.Background(), path)
+
+		assert.ErrorIs(t, err, pkgerrors.ErrBackupRolesMissing)
+		assert.ErrorContains(t, err, "sales")
+		mockBackupRepo.AssertNotCalled(t, "ResetTableIdentity", mock.Anything, mock.Anything, mock.Anything)
+		mockProfileRepo.AssertExpectations(t)
+	})
+}
+
+func TestCheckBackup(t *testing.T) {
+	path := writeTestBackup(t, "false")
+	archive, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := bytes.SplitAfter(archive, []byte("\n"))
+	// header, users row, two profiles rows, trailer and the empty string after the last newline
+	require.Len(t, lines, 6)
+
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	gz.Write(archive)
+	gz.Close()
+
+	tests := []struct {
+		name    string
+		archive []byte
+		wantErr bool
+	}{
+		{name: "Complete_archive", archive: archive},
+		{name: "Complete_gzipped_archive", archive: gzipped.Bytes()},
+		{name: "Truncated_archive", archive: bytes.Join(lines[:4], nil), wantErr: true},
+		{name: "Missing_row", archive: bytes.Join([][]byte{lines[0], lines[1], lines[2], lines[4]}, nil), wantErr: true},
+		{name: "Rows_out_of_order", archive: bytes.Join([][]byte{lines[0], lines[2], lines[1], lines[3], lines[4]}, nil), wantErr: true},
+		{name: "Data_after_the_trailer", archive: append(bytes.Clone(archive), lines[1]...), wantErr: true},
+		{name: "Truncated_gzip", archive: gzipped.Bytes()[:gzipped.Len()-10], wantErr: true},
+		{name: "Not_a_backup", archive: []byte(`{"format": "something_else", "version": 1}` + "\n"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "backup.ndjson")
+			require.NoError(t, os.WriteFile(path, tt.archive, 0o600))
+			svc := service.NewServices(service.RepoDeps{})
+
+			report, err := svc.CheckBackup(context.Background(), path)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, pkgerrors.ErrInvalidBackup)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(22), report.SchemaVersion)
+			assert.Equal(t, 2, report.RowCounts["profiles"])
+		})
+	}
+}
+
+// writeBackupFile writes an empty archive taken at createdAt, with its checksum file, and returns its name
+func writeBackupFile(t *testing.T, dir string, createdAt time.Time) string {
+	t.Helper()
+	name := constants.BackupFilePrefix + createdAt.Format(constants.BackupTimeFormat) + constants.BackupFileExt + constants.BackupGzipExt
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+constants.BackupChecksumExt), []byte(fmt.Sprintf("%x  %s\n", sha256.Sum256([]byte(name)), name)), 0o600))
+	return name
+}
+
+func TestRotateBackups(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(constants.BackupDirEnvVar, dir)
+	t.Setenv(constants.BackupKeepDailyEnvVar, "2")
+	t.Setenv(constants.BackupKeepWeeklyEnvVar, "2")
+	t.Setenv(constants.BackupKeepMonthlyEnvVar, "2")
+
+	at := func(day int, hour int) time.Time { return time.Date(2026, 3, day, hour, 0, 0, 0, time.Local) }
+	// Sunday 22 March 2026 ends a week; the weeks before end on the 15th and the 8th, and February before March
+	latest := writeBackupFile(t, dir, at(22, 12))
+	sameDay := writeBackupFile(t, dir, at(22, 0))
+	dayBefore := writeBackupFile(t, dir, at(21, 0))
+	twoDaysBefore := writeBackupFile(t, dir, at(20, 0))
+	lastWeek := writeBackupFile(t, dir, at(15, 0))
+	twoWeeksAgo := writeBackupFile(t, dir, at(8, 0))
+	lastMonth := writeBackupFile(t, dir, time.Date(2026, 2, 27, 0, 0, 0, 0, time.Local))
+	twoMonthsAgo := writeBackupFile(t, dir, time.Date(2026, 1, 30, 0, 0, 0, 0, time.Local))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("kept"), 0o600))
+	svc := service.NewServices(service.RepoDeps{})
+
+	deleted, err := svc.RotateBackups(context.Background())
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{sameDay, twoDaysBefore, twoWeeksAgo, twoMonthsAgo}, deleted)
+	for _, name := range []string{latest, dayBefore, lastWeek, lastMonth} {
+		assert.FileExists(t, filepath.Join(dir, name))
+		assert.FileExists(t, filepath.Join(dir, name+constants.BackupChecksumExt))
+	}
+	for _, name := range deleted {
+		assert.NoFileExists(t, filepath.Join(dir, name))
+		assert.NoFileExists(t, filepath.Join(dir, name+constants.BackupChecksumExt))
+	}
+	assert.FileExists(t, filepath.Join(dir, "notes.txt"))
+}
+
+func TestListBackups(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(constants.BackupDirEnvVar, dir)
+	older := writeBackupFile(t, dir, time.Date(2026, 3, 21, 0, 0, 0, 0, time.Local))
+	latest := writeBackupFile(t, dir, time.Date(2026, 3, 22, 0, 0, 0, 0, time.Local))
+	require.NoError(t, os.Remove(filepath.Join(dir, latest+constants.BackupChecksumExt)))
+	mockBackupRepo := new(repomocks.BackupStorer)
+	svc := service.NewServices(service.RepoDeps{BackupDeps: mockBackupRepo})
+
+	verifiedAt := time.Date(2026, 3, 21, 2, 0, 0, 0, time.UTC)
+	mockBackupRepo.On("ListLatestBackupVerifications", mock.Anything, []string{latest, older}).Return(map[string]specs.BackupVerification{
+		older: {FileName: older, Status: constants.BackupVerificationPassed, VerifiedAt: verifiedAt},
+	}, nil).Once()
+
+	resp, err := svc.ListBackups(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, resp.Backups, 2)
+	assert.Equal(t, specs.Backup{FileName: latest, SizeBytes: int64(len(latest)), CreatedAt: time.Date(2026, 3, 22, 0, 0, 0, 0, time.Local),
+		VerificationStatus: constants.BackupVerificationUnverified}, resp.Backups[0])
+	assert.Equal(t, specs.Backup{FileName: older, SizeBytes: int64(len(older)), CreatedAt: time.Date(2026, 3, 21, 0, 0, 0, 0, time.Local),
+		Checksum: fmt.Sprintf("%x", sha256.Sum256([]byte(older))), VerificationStatus: constants.BackupVerificationPassed, VerifiedAt: &verifiedAt}, resp.Backups[1])
+}
+
+func TestVerifyLatestBackup(t *testing.T) {
+	t.Run("Restores_the_latest_backup_into_the_scratch_schema", func(t *testing.T) {
+		path := writeTestBackup(t, "true")
+		mockBackupRepo := new(repomocks.BackupStorer)
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		svc := service.NewServices(service.RepoDeps{BackupDeps: mockBackupRepo, ProfileDeps: mockProfileRepo})
+
+		mockRestoreTarget(mockBackupRepo, mockProfileRepo, 22)
+		mockBackupRepo.On("CreateScratchSchema", mock.Anything, constants.BackupVerifySchema, constants.BackupTables, mock.Anything).Return(nil).Once()
+		mockBackupRepo.On("ImportTableRows", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+		mockBackupRepo.On("DropScratchSchema", mock.Anything, constants.BackupVerifySchema, mock.Anything).Return(nil).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+		mockBackupRepo.On("CreateBackupVerification", mock.Anything, mock.MatchedBy(func(verification repository.BackupVerificationRepo) bool {
+			return verification.FileName == filepath.Base(path) && verification.Status == constants.BackupVerificationPassed &&
+				len(verification.Checksum) == 64 && verification.RowCounts["profiles"] == 2
+		})).Return(specs.BackupVerification{ID: 1, Status: constants.BackupVerificationPassed}, nil).Once()
+
+		verification, err := svc.VerifyLatestBackup(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, constants.BackupVerificationPassed, verification.Status)
+		mockBackupRepo.AssertNotCalled(t, "DisableTriggers", mock.Anything, mock.Anything)
+		mockBackupRepo.AssertNotCalled(t, "ResetTableIdentity", mock.Anything, mock.Anything, mock.Anything)
+		mockBackupRepo.AssertExpectations(t)
+		mockProfileRepo.AssertExpectations(t)
+	})
+
+	t.Run("Records_a_backup_that_does_not_match_its_checksum_as_failed", func(t *testing.T) {
+		path := writeTestBackup(t, "true")
+		require.NoError(t, os.WriteFile(path+constants.BackupChecksumExt, []byte(fmt.Sprintf("%x  backup\n", sha256.Sum256(nil))), 0o600))
+		mockBackupRepo := new(repomocks.BackupStorer)
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		svc := service.NewServices(service.RepoDeps{BackupDeps: mockBackupRepo, ProfileDeps: mockProfileRepo})
+
+		mockBackupRepo.On("CreateBackupVerification", mock.Anything, mock.MatchedBy(func(verification repository.BackupVerificationRepo) bool {
+			return verification.Status == constants.BackupVerificationFailed && verification.Error != ""
+		})).Return(specs.BackupVerification{ID: 2, Status: constants.BackupVerificationFailed}, nil).Once()
+
+		verification, err := svc.VerifyLatestBackup(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, constants.BackupVerificationFailed, verification.Status)
+		mockProfileRepo.AssertNotCalled(t, "BeginTransaction", mock.Anything)
+		mockBackupRepo.AssertExpectations(t)
+	})
+
+	t.Run("Fails_without_a_backup", func(t *testing.T) {
+		t.Setenv(constants.BackupDirEnvVar, t.TempDir())
+		svc := service.NewServices(service.RepoDeps{})
+
+		_, err := svc.VerifyLatestBackup(context.Background())
+
+		assert.Equal(t, pkgerrors.ErrNoBackup, err)
+	})
+}
@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/notifier"
+	notifiermocks "github.com/joshsoftware/profile_builder_backend_go/internal/client/notifier/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	pkgerrors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	repomocks "github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSendOutboxEmails(t *testing.T) {
+	email := specs.OutboxEmail{ID: 3, Kind: constants.EmailKindEmployeeInvitation, Recipient: "alice@example.com", Subject: "Welcome", Body: "<p>Hi</p>"}
+	message := notifier.Message{To: "alice@example.com", Subject: "Welcome", Body: "<p>Hi</p>"}
+
+	tests := []struct {
+		name       string
+		attempts   int
+		sendErr    error
+		wantStatus string
+		wantRetry  bool
+	}{
+		{name: "Sent", attempts: 1, wantStatus: constants.EmailSent},
+		{name: "Failed_email_is_retried_later", attempts: 2, sendErr: pkgerrors.ErrUnableToSendEmail, wantStatus: constants.EmailFailed, wantRetry: true},
+		{name: "Email_failing_its_last_attempt_is_dead", attempts: 5, sendErr: pkgerrors.ErrUnableToSendEmail, wantStatus: constants.EmailDead},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(constants.EmailMaxAttemptsEnvVar, "5")
+			mockOutboxRepo := new(repomocks.EmailOutboxStorer)
+			mockNotifier := new(notifiermocks.Notifier)
+			svc := service.NewServices(service.RepoDeps{EmailOutboxDeps: mockOutboxRepo, Notifier: mockNotifier})
+
+			claimed := email
+			claimed.Attempts = tt.attempts
+			mockOutboxRepo.On("ClaimDueOutboxEmails", mock.Anything, constants.EmailOutboxBatchSize, mock.Anything).Return([]specs.OutboxEmail{claimed}, nil).Once()
+			mockNotifier.On("Send", mock.Anything, message).Return(tt.sendErr).Once()
+
+			var outcome repository.OutboxEmailResultRepo
+			mockOutboxRepo.On("FinishOutboxEmail", mock.Anything, int64(3), tt.attempts, mock.Anything).Run(func(args mock.Arguments) {
+				outcome = args.Get(3).(repository.OutboxEmailResultRepo)
+			}).Return(true, nil).Once()
+
+			count, err := svc.SendOutboxEmails(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, count)
+			assert.Equal(t, tt.wantStatus, outcome.Status)
+			assert.Equal(t, tt.sendErr == nil, outcome.SentAt != nil)
+			if tt.sendErr != nil {
+				assert.Equal(t, tt.sendErr.Error(), outcome.LastError)
+			}
+			assert.Equal(t, tt.wantRetry, outcome.NextAttemptAt.After(time.Now().UTC()))
+			mockOutboxRepo.AssertExpectations(t)
+			mockNotifier.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResendOutboxEmail(t *testing.T) {
+	dead := specs.OutboxEmail{ID: 6, Kind: constants.EmailKindAdminInvitation, Recipient: "bob@example.com", Status: constants.EmailDead, Attempts: 5, LastError: "status 503"}
+
+	t.Run("Makes_a_dead_email_pending_again", func(t *testing.T) {
+		mockOutboxRepo := new(repomocks.EmailOutboxStorer)
+		svc := service.NewServices(service.RepoDeps{EmailOutboxDeps: mockOutboxRepo})
+
+		mockOutboxRepo.On("GetOutboxEmail", mock.Anything, int64(6)).Return(dead, nil).Once()
+		mockOutboxRepo.On("RequeueOutboxEmail", mock.Anything, int64(6)).Return(true, nil).Once()
+		// sending starts in the background; here the email is already taken
+		mockOutboxRepo.On("ClaimOutboxEmail", mock.Anything, int64(6), mock.Anything).Return(specs.OutboxEmail{}, pkgerrors.ErrNoRecordFound).Maybe()
+
+		email, err := svc.ResendOutboxEmail(context.Background(), 6)
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.EmailPending, email.Status)
+		assert.Equal(t, 0, email.Attempts)
+		assert.Empty(t, email.LastError)
+	})
+
+	t.Run("Refuses_an_email_waiting_to_be_sent", func(t *testing.T) {
+		mockOutboxRepo := new(repomocks.EmailOutboxStorer)
+		svc := service.NewServices(service.RepoDeps{EmailOutboxDeps: mockOutboxRepo})
+
+		pending := dead
+		pending.Status = constants.EmailPending
+		mockOutboxRepo.On("GetOutboxEmail", mock.Anything, int64(6)).Return(pending, nil).Once()
+		mockOutboxRepo.On("RequeueOutboxEmail", mock.Anything, int64(6)).Return(false, nil).Once()
+
+		_, err := svc.ResendOutboxEmail(context.Background(), 6)
+
+		assert.Equal(t, pkgerrors.ErrEmailNotResendable, err)
+		mockOutboxRepo.AssertNotCalled(t, "ClaimOutboxEmail", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Not_found", func(t *testing.T) {
+		mockOutboxRepo := new(repomocks.EmailOutboxStorer)
+		svc := service.NewServices(service.RepoDeps{EmailOutboxDeps: mockOutboxRepo})
+
+		mockOutboxRepo.On("GetOutboxEmail", mock.Anything, int64(7)).Return(specs.OutboxEmail{}, pkgerrors.ErrOutboxEmailNotFound).Once()
+
+		_, err := svc.ResendOutboxEmail(context.Background(), 7)
+
+		assert.Equal(t, pkgerrors.ErrOutboxEmailNotFound, err)
+	})
+}
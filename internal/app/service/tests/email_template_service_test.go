@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	pkgerrors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	repomocks "github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var invitationTemplate = specs.EmailTemplate{
+	Kind:     constants.EmailKindEmployeeInvitation,
+	Version:  2,
+	Subject:  "Welcome {{.Name}}",
+	HTMLBody: `<p>Hello {{.Name}}, <a href="{{.ProfileLink}}">open your profile</a></p>`,
+	TextBody: "Hello {{.Name}}, open your profile: {{.ProfileLink}}",
+}
+
+func TestUpdateEmailTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    string
+		req     specs.UpdateEmailTemplateRequest
+		setup   func(mockTemplateRepo *repomocks.EmailTemplateStorer)
+		wantErr error
+	}{
+		{
+			name: "Saves_a_new_version",
+			kind: constants.EmailKindEmployeeInvitation,
+			req:  specs.UpdateEmailTemplateRequest{Subject: invitationTemplate.Subject, HTMLBody: invitationTemplate.HTMLBody, TextBody: invitationTemplate.TextBody},
+			setup: func(mockTemplateRepo *repomocks.EmailTemplateStorer) {
+				mockTemplateRepo.On("CreateEmailTemplateVersion", mock.Anything, repository.EmailTemplateRepo{
+					Kind:        constants.EmailKindEmployeeInvitation,
+					Subject:     invitationTemplate.Subject,
+					HTMLBody:    invitationTemplate.HTMLBody,
+					TextBody:    invitationTemplate.TextBody,
+					CreatedByID: 4,
+				}).Return(invitationTemplate, nil).Once()
+			},
+		},
+		{
+			name:    "Refuses_a_template_that_does_not_parse",
+			kind:    constants.EmailKindEmployeeInvitation,
+			req:     specs.UpdateEmailTemplateRequest{Subject: "Welcome {{.Name", HTMLBody: "<p></p>", TextBody: "Hi"},
+			setup:   func(mockTemplateRepo *repomocks.EmailTemplateStorer) {},
+			wantErr: pkgerrors.ErrInvalidEmailTemplate,
+		},
+		{
+			name:    "Refuses_a_template_using_an_unknown_field",
+			kind:    constants.EmailKindEmployeeInvitation,
+			req:     specs.UpdateEmailTemplateRequest{Subject: "Welcome", HTMLBody: "<p>{{.Password}}</p>", TextBody: "Hi"},
+			setup:   func(mockTemplateRepo *repomocks.EmailTemplateStorer) {},
+			wantErr: pkgerrors.ErrInvalidEmailTemplate,
+		},
+		{
+			name:    "Unknown_kind",
+			kind:    "birthday",
+			req:     specs.UpdateEmailTemplateRequest{Subject: "Hi", HTMLBody: "<p></p>", TextBody: "Hi"},
+			setup:   func(mockTemplateRepo *repomocks.EmailTemplateStorer) {},
+			wantErr: pkgerrors.ErrEmailTemplateNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTemplateRepo := new(repomocks.EmailTemplateStorer)
+			svc := service.NewServices(service.RepoDeps{EmailTemplateDeps: mockTemplateRepo})
+			tt.setup(mockTemplateRepo)
+
+			template, err := svc.UpdateEmailTemplate(context.Background(), tt.kind, 4, tt.req)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockTemplateRepo.AssertNotCalled(t, "CreateEmailTemplateVersion", mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 2, template.Version)
+			mockTemplateRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPreviewEmailTemplate(t *testing.T) {
+	t.Setenv("HOST_URL", "https://profiles.example.com")
+
+	t.Run("Escapes_names_in_the_html_body_only", func(t *testing.T) {
+		mockTemplateRepo := new(repomocks.EmailTemplateStorer)
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		svc := service.NewServices(service.RepoDeps{EmailTemplateDeps: mockTemplateRepo, ProfileDeps: mockProfileRepo})
+
+		var tx pgx.Tx
+		mockTemplateRepo.On("GetEmailTemplate", mock.Anything, constants.EmailKindEmployeeInvitation).Return(invitationTemplate, nil).Once()
+		mockProfileRepo.On("BeginTransaction", mock.Anything).Return(tx, nil).Once()
+		mockProfileRepo.On("GetProfile", mock.Anything, 9, mock.Anything).Return(specs.ResponseProfile{ProfileID: 9, Name: `<script>alert("x")</script>`}, nil).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+
+		preview, err := svc.PreviewEmailTemplate(context.Background(), constants.EmailKindEmployeeInvitation, specs.PreviewEmailTemplateRequest{ProfileID: 9})
+
+		assert.NoError(t, err)
+		assert.Equal(t, `<p>Hello &lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;, <a href="https://profiles.example.com/profile-builder/9">open your profile</a></p>`, preview.HTMLBody)
+		assert.Equal(t, `Hello <script>alert("x")</script>, open your profile: https://profiles.example.com/profile-builder/9`, preview.TextBody)
+		assert.Equal(t, `Welcome <script>alert("x")</script>`, preview.Subject)
+		mockProfileRepo.AssertExpectations(t)
+	})
+
+	t.Run("Renders_unsaved_changes_for_the_sample_profile", func(t *testing.T) {
+		mockTemplateRepo := new(repomocks.EmailTemplateStorer)
+		svc := service.NewServices(service.RepoDeps{EmailTemplateDeps: mockTemplateRepo})
+
+		mockTemplateRepo.On("GetEmailTemplate", mock.Anything, constants.EmailKindEmployeeInvitation).Return(invitationTemplate, nil).Once()
+
+		preview, err := svc.PreviewEmailTemplate(context.Background(), constants.EmailKindEmployeeInvitation, specs.PreviewEmailTemplateRequest{Subject: "Hi\r\nBcc: {{.Email}}"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Hi Bcc: jane.doe@example.com", preview.Subject)
+		assert.Equal(t, "Jane Doe", preview.Data.Name)
+		assert.Contains(t, preview.HTMLBody, "Hello Jane Doe")
+	})
+}
@@ -0,0 +1,161 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	errs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetInternalProfile(t *testing.T) {
+	employeeID := "E1"
+
+	tests := []struct {
+		name        string
+		setup       func(profileMock *mocks.ProfileStorer, eduMock *mocks.EducationStorer, certMock *mocks.CertificateStorer, projMock *mocks.ProjectStorer, expMock *mocks.ExperienceStorer, achMock *mocks.AchievementStorer)
+		expected    specs.InternalProfileV1
+		expectedErr error
+	}{
+		{
+			name: "Success_for_full_profile",
+			setup: func(profileMock *mocks.ProfileStorer, eduMock *mocks.EducationStorer, certMock *mocks.CertificateStorer, projMock *mocks.ProjectStorer, expMock *mocks.ExperienceStorer, achMock *mocks.AchievementStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmployeeID", mock.Anything, employeeID, mock.Anything).Return(4, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 4, mock.Anything).Return(specs.ResponseProfile{
+					ProfileID: 4, Name: "Jane", EmployeeID: &employeeID, JoshJoiningDate: sql.NullString{String: "2020-01-01", Valid: true},
+				}, nil).Once()
+				eduMock.On("ListEducations", mock.Anything, 4, specs.ListEducationsFilter{}, mock.Anything).Return([]specs.EducationResponse{{ID: 1, ProfileID: 4, Degree: "BE"}}, nil).Once()
+				certMock.On("ListCertificates", mock.Anything, 4, specs.ListCertificateFilter{}, mock.Anything).Return([]specs.CertificateResponse{}, nil).Once()
+				projMock.On("ListProjects", mock.Anything, 4, specs.ListProjectsFilter{}, mock.Anything).Return([]specs.ProjectResponse{{ID: 2, ProfileID: 4, Name: "Builder"}}, nil).Once()
+				expMock.On("ListExperiences", mock.Anything, 4, specs.ListExperiencesFilter{}, mock.Anything).Return([]specs.ExperienceResponse{}, nil).Once()
+				achMock.On("ListAchievements", mock.Anything, 4, specs.ListAchievementFilter{}, mock.Anything).Return([]specs.AchievementResponse{}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+			expected: specs.InternalProfileV1{
+				ProfileID:       4,
+				EmployeeID:      &employeeID,
+				Name:            "Jane",
+				JoshJoiningDate: func() *string { date := "2020-01-01"; return &date }(),
+				Educations:      []specs.InternalEducationV1{{ID: 1, Degree: "BE"}},
+				Certificates:    []specs.InternalCertificateV1{},
+				Projects:        []specs.InternalProjectV1{{ID: 2, Name: "Builder"}},
+				Experiences:     []specs.InternalExperienceV1{},
+				Achievements:    []specs.InternalAchievementV1{},
+			},
+		},
+		{
+			name: "Fail_for_unknown_employee",
+			setup: func(profileMock *mocks.ProfileStorer, eduMock *mocks.EducationStorer, certMock *mocks.CertificateStorer, projMock *mocks.ProjectStorer, expMock *mocks.ExperienceStorer, achMock *mocks.AchievementStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmployeeID", mock.Anything, employeeID, mock.Anything).Return(0, errs.ErrNoRecordFound).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, errs.ErrNoRecordFound).Return(nil).Once()
+			},
+			expectedErr: errs.ErrNoRecordFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profileMock := new(mocks.ProfileStorer)
+			eduMock := new(mocks.EducationStorer)
+			certMock := new(mocks.CertificateStorer)
+			projMock := new(mocks.ProjectStorer)
+			expMock := new(mocks.ExperienceStorer)
+			achMock := new(mocks.AchievementStorer)
+			internalSvc := service.NewServices(service.RepoDeps{
+				ProfileDeps:     profileMock,
+				EducationDeps:   eduMock,
+				CertificateDeps: certMock,
+				ProjectDeps:     projMock,
+				ExperienceDeps:  expMock,
+				AchievementDeps: achMock,
+			})
+			tt.setup(profileMock, eduMock, certMock, projMock, expMock, achMock)
+
+			got, err := internalSvc.GetInternalProfile(context.Background(), employeeID)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expected, got)
+			profileMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolveEmployeeIDs(t *testing.T) {
+	profileMock := new(mocks.ProfileStorer)
+	internalSvc := service.NewServices(service.RepoDeps{ProfileDeps: profileMock})
+
+	profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+	profileMock.On("GetProfileIDsByEmployeeIDs", mock.Anything, []string{"E1", "E2", "E3"}, mock.Anything).Return(map[string]int{"E1": 4, "E3": 9}, nil).Once()
+	profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+
+	got, err := internalSvc.ResolveEmployeeIDs(context.Background(), specs.ResolveEmployeesRequest{EmployeeIDs: []string{"E1", "E2", "E3"}})
+	assert.NoError(t, err)
+	assert.Equal(t, specs.ResolveEmployeesResponseV1{
+		Profiles: []specs.ResolvedEmployeeV1{{EmployeeID: "E1", ProfileID: 4}, {EmployeeID: "E3", ProfileID: 9}},
+		NotFound: []string{"E2"},
+	}, got)
+	profileMock.AssertExpectations(t)
+}
+
+func TestListProfileChanges(t *testing.T) {
+	since := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	first := since.Add(time.Minute)
+	second := since.Add(2 * time.Minute)
+
+	tests := []struct {
+		name     string
+		changes  []specs.ProfileChangeV1
+		expected specs.ProfileChangesResponseV1
+	}{
+		{
+			name: "Success_for_page_with_more",
+			changes: []specs.ProfileChangeV1{
+				{EmployeeID: "E1", ProfileID: 4, ChangedAt: first},
+				{EmployeeID: "E2", ProfileID: 5, ChangedAt: first, Deleted: true},
+				{EmployeeID: "E3", ProfileID: 6, ChangedAt: second},
+			},
+			expected: specs.ProfileChangesResponseV1{
+				Changes: []specs.ProfileChangeV1{
+					{EmployeeID: "E1", ProfileID: 4, ChangedAt: first},
+					{EmployeeID: "E2", ProfileID: 5, ChangedAt: first, Deleted: true},
+				},
+				HasMore:     true,
+				NextSince:   first,
+				NextAfterID: 5,
+			},
+		},
+		{
+			name:    "Success_for_no_changes",
+			changes: []specs.ProfileChangeV1{},
+			expected: specs.ProfileChangesResponseV1{
+				Changes:     []specs.ProfileChangeV1{},
+				NextSince:   since,
+				NextAfterID: 3,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profileMock := new(mocks.ProfileStorer)
+			internalSvc := service.NewServices(service.RepoDeps{ProfileDeps: profileMock})
+
+			profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+			profileMock.On("ListProfileChanges", mock.Anything, specs.ListProfileChangesFilter{Since: since, AfterID: 3, Limit: 3, Lag: constants.ProfileChangesLag}, mock.Anything).Return(tt.changes, nil).Once()
+			profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+
+			got, err := internalSvc.ListProfileChanges(context.Background(), specs.ListProfileChangesFilter{Since: since, AfterID: 3, Limit: 2})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+			profileMock.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	clientmocks "github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	pkgerrors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	repomocks "github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReceiveIntranetWebhook(t *testing.T) {
+	event := specs.IntranetWebhookEvent{ID: "evt_1", Type: constants.IntranetEventEmployeeUpdated, EmployeeID: "EMP001"}
+	payload := []byte(`{"id":"evt_1","type":"employee.updated","employee_id":"EMP001"}`)
+
+	t.Run("Stores_a_new_event", func(t *testing.T) {
+		mockWebhookRepo := new(repomocks.IntranetWebhookStorer)
+		svc := service.NewServices(service.RepoDeps{IntranetWebhookDeps: mockWebhookRepo})
+
+		mockWebhookRepo.On("CreateWebhookEvent", mock.Anything, repository.IntranetWebhookEventRepo{
+			EventID: "evt_1", EventType: constants.IntranetEventEmployeeUpdated, EmployeeID: "EMP001", Payload: payload,
+		}).Return(int64(4), true, nil).Once()
+		// processing starts in the background; here the event is already taken
+		mockWebhookRepo.On("ClaimWebhookEvent", mock.Anything, int64(4), mock.Anything).Return(specs.IntranetWebhookEventRecord{}, pkgerrors.ErrNoRecordFound).Maybe()
+
+		resp, err := svc.ReceiveIntranetWebhook(context.Background(), event, payload)
+
+		assert.NoError(t, err)
+		assert.Equal(t, specs.ReceiveIntranetWebhookResponse{EventID: "evt_1", Status: constants.WebhookEventPending}, resp)
+	})
+
+	t.Run("Does_not_process_a_duplicate_again", func(t *testing.T) {
+		mockWebhookRepo := new(repomocks.IntranetWebhookStorer)
+		svc := service.NewServices(service.RepoDeps{IntranetWebhookDeps: mockWebhookRepo})
+
+		mockWebhookRepo.On("CreateWebhookEvent", mock.Anything, mock.Anything).Return(int64(4), false, nil).Once()
+
+		resp, err := svc.ReceiveIntranetWebhook(context.Background(), event, payload)
+
+		assert.NoError(t, err)
+		assert.True(t, resp.Duplicate)
+		mockWebhookRepo.AssertNotCalled(t, "ClaimWebhookEvent", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestProcessIntranetWebhookEvents(t *testing.T) {
+	aliceID := "EMP001"
+	alice := specs.ProfileEmployment{ProfileID: 1, Name: "Alice", Email: "alice@example.com", EmployeeID: &aliceID, IsCurrentEmployee: 1, IsActive: 1, CreatedByID: 9}
+
+	tests := []struct {
+		name   string
+		event  specs.IntranetWebhookEventRecord
+		setup  func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, userMock *repomocks.UserStorer, intranetMock *clientmocks.IntranetClient)
+		expect func(result repository.IntranetWebhookResultRepo) bool
+	}{
+		{
+			name:  "Syncs_the_employee_of_an_update",
+			event: specs.IntranetWebhookEventRecord{ID: 1, EventID: "evt_1", EventType: constants.IntranetEventEmployeeUpdated, EmployeeID: "EMP001", Attempts: 1},
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, userMock *repomocks.UserStorer, intranetMock *clientmocks.IntranetClient) {
+				intranetMock.On("GetEmployeeByID", mock.Anything, "EMP001").Return(&specs.IntranetEmployee{EmployeeID: "EMP001", Email: "alice@example.com", Designation: "Lead"}, nil).Once()
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{alice}, nil).Once()
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "alice@example.com", nil).Return(1, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{ProfileID: 1, EmployeeID: &aliceID, Designation: "Engineer"}, nil).Once()
+				profileMock.On("UpdateProfileSyncFields", mock.Anything, 1, map[string]interface{}{"designation": "Lead"}, mock.Anything, nil).Return(nil).Once()
+				syncMock.On("CreateProfileSyncChanges", mock.Anything, mock.Anything, nil).Return(nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+			expect: func(result repository.IntranetWebhookResultRepo) bool {
+				return result.Status == constants.WebhookEventProcessed && result.ProcessedAt != nil && result.LastError == "" &&
+					strings.Contains(string(result.Result), `"status":"updated"`)
+			},
+		},
+		{
+			name:  "Marks_an_exited_employee_as_a_leaver",
+			event: specs.IntranetWebhookEventRecord{ID: 2, EventID: "evt_2", EventType: constants.IntranetEventEmployeeExited, EmployeeID: "EMP001", Attempts: 1},
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, userMock *repomocks.UserStorer, intranetMock *clientmocks.IntranetClient) {
+				intranetMock.On("GetEmployeeByID", mock.Anything, "EMP001").Return(nil, pkgerrors.ErrNoRecordFound).Once()
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{alice}, nil).Once()
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("UpdateProfileSyncFields", mock.Anything, 1, map[string]interface{}{"is_current_employee": 0}, mock.Anything, nil).Return(nil).Once()
+				syncMock.On("CreateProfileSyncChanges", mock.Anything, mock.Anything, nil).Return(nil).Once()
+				userMock.On("GetUserInfo", mock.Anything, specs.UserInfoFilter{Email: "alice@example.com"}).Return(repository.User{}, pkgerrors.ErrNoRecordFound).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+				userMock.On("GetUserInfo", mock.Anything, specs.UserInfoFilter{ID: 9}).Return(repository.User{}, pkgerrors.ErrNoRecordFound).Once()
+			},
+			expect: func(result repository.IntranetWebhookResultRepo) bool {
+				return result.Status == constants.WebhookEventProcessed && strings.Contains(string(result.Result), `"employment":"left"`)
+			},
+		},
+		{
+			name:  "Retries_when_the_intranet_is_unavailable",
+			event: specs.IntranetWebhookEventRecord{ID: 3, EventID: "evt_3", EventType: constants.IntranetEventEmployeeCreated, EmployeeID: "EMP001", Attempts: 2},
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, userMock *repomocks.UserStorer, intranetMock *clientmocks.IntranetClient) {
+				intranetMock.On("GetEmployeeByID", mock.Anything, "EMP001").Return(nil, pkgerrors.ErrIntranetUnavailable).Once()
+			},
+			expect: func(result repository.IntranetWebhookResultRepo) bool {
+				// the second failure waits twice the first backoff
+				return result.Status == constants.WebhookEventFailed && result.LastError == pkgerrors.ErrIntranetUnavailable.Error() &&
+					time.Until(result.NextAttemptAt) > constants.WebhookRetryBackoff && result.ProcessedAt == nil
+			},
+		},
+		{
+			name:  "Gives_up_after_the_last_attempt",
+			event: specs.IntranetWebhookEventRecord{ID: 4, EventID: "evt_4", EventType: constants.IntranetEventEmployeeUpdated, EmployeeID: "EMP404", Attempts: 5},
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, userMock *repomocks.UserStorer, intranetMock *clientmocks.IntranetClient) {
+				intranetMock.On("GetEmployeeByID", mock.Anything, "EMP404").Return(nil, pkgerrors.ErrNoRecordFound).Once()
+			},
+			expect: func(result repository.IntranetWebhookResultRepo) bool {
+				return result.Status == constants.WebhookEventDead && result.LastError == pkgerrors.ErrNoRecordFound.Error()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(constants.IntranetSyncPoliciesEnvVar, "")
+			t.Setenv(constants.IntranetWebhookMaxAttemptsEnvVar, "")
+			t.Setenv(constants.IntranetSyncDeactivateLeaversEnvVar, "")
+
+			mockProfileRepo := new(repomocks.ProfileStorer)
+			mockSyncRepo := new(repomocks.IntranetSyncStorer)
+			mockUserRepo := new(repomocks.UserStorer)
+			mockWebhookRepo := new(repomocks.IntranetWebhookStorer)
+			mockIntranetClient := new(clientmocks.IntranetClient)
+			svc := service.NewServices(service.RepoDeps{
+				ProfileDeps:         mockProfileRepo,
+				IntranetSyncDeps:    mockSyncRepo,
+				UserLoginDeps:       mockUserRepo,
+				IntranetWebhookDeps: mockWebhookRepo,
+				IntranetClient:      mockIntranetClient,
+			})
+
+			mockWebhookRepo.On("ClaimDueWebhookEvents", mock.Anything, constants.WebhookEventsBatchSize, mock.Anything).Return([]specs.IntranetWebhookEventRecord{tt.event}, nil).Once()
+			tt.setup(mockProfileRepo, mockSyncRepo, mockUserRepo, mockIntranetClient)
+			mockWebhookRepo.On("FinishWebhookEvent", mock.Anything, tt.event.ID, mock.MatchedBy(tt.expect)).Return(nil).Once()
+
+			processed, err := svc.ProcessIntranetWebhookEvents(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, processed)
+			mockProfileRepo.AssertExpectations(t)
+			mockSyncRepo.AssertExpectations(t)
+			mockUserRepo.AssertExpectations(t)
+			mockWebhookRepo.AssertExpectations(t)
+			mockIntranetClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRetryIntranetWebhookEvent(t *testing.T) {
+	dead := specs.IntranetWebhookEventRecord{ID: 6, EventID: "evt_6", Status: constants.WebhookEventDead, Attempts: 5, LastError: "db error"}
+
+	t.Run("Makes_a_dead_event_pending_again", func(t *testing.T) {
+		mockWebhookRepo := new(repomocks.IntranetWebhookStorer)
+		svc := service.NewServices(service.RepoDeps{IntranetWebhookDeps: mockWebhookRepo})
+
+		mockWebhookRepo.On("GetWebhookEvent", mock.Anything, int64(6)).Return(dead, nil).Once()
+		mockWebhookRepo.On("ResetWebhookEvent", mock.Anything, int64(6)).Return(true, nil).Once()
+		mockWebhookRepo.On("ClaimWebhookEvent", mock.Anything, int64(6), mock.Anything).Return(specs.IntranetWebhookEventRecord{}, pkgerrors.ErrNoRecordFound).Maybe()
+
+		event, err := svc.RetryIntranetWebhookEvent(context.Background(), 6)
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.WebhookEventPending, event.Status)
+		assert.Equal(t, 0, event.Attempts)
+	})
+
+	t.Run("Refuses_an_event_that_did_not_fail", func(t *testing.T) {
+		mockWebhookRepo := new(repomocks.IntranetWebhookStorer)
+		svc := service.NewServices(service.RepoDeps{IntranetWebhookDeps: mockWebhookRepo})
+
+		processed := dead
+		processed.Status = constants.WebhookEventProcessed
+		mockWebhookRepo.On("GetWebhookEvent", mock.Anything, int64(6)).Return(processed, nil).Once()
+		mockWebhookRepo.On("ResetWebhookEvent", mock.Anything, int64(6)).Return(false, nil).Once()
+
+		_, err := svc.RetryIntranetWebhookEvent(context.Background(), 6)
+
+		assert.Equal(t, pkgerrors.ErrWebhookEventNotRetryable, err)
+		mockWebhookRepo.AssertNotCalled(t, "ClaimWebhookEvent", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Not_found", func(t *testing.T) {
+		mockWebhookRepo := new(repomocks.IntranetWebhookStorer)
+		svc := service.NewServices(service.RepoDeps{IntranetWebhookDeps: mockWebhookRepo})
+
+		mockWebhookRepo.On("GetWebhookEvent", mock.Anything, int64(7)).Return(specs.IntranetWebhookEventRecord{}, pkgerrors.ErrWebhookEventNotFound).Once()
+
+		_, err := svc.RetryIntranetWebhookEvent(context.Background(), 7)
+
+		assert.Equal(t, pkgerrors.ErrWebhookEventNotFound, err)
+	})
+}
@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	pkgerrors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	repomocks "github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var reminderTemplate = specs.EmailTemplate{Version: 1, Subject: "Complete your profile by {{.ExpiresAt}}", HTMLBody: "<p>{{.Name}}</p>", TextBody: "{{.Name}}"}
+
+func TestSendInvitationReminders(t *testing.T) {
+	day := 24 * time.Hour
+	tests := []struct {
+		name          string
+		expiresIn     time.Duration
+		remindersSent int
+		wantReminded  int
+		remindedByRun bool
+	}{
+		{name: "Not_due_yet", expiresIn: 10 * day, remindersSent: 0},
+		{name: "First_reminder_due", expiresIn: 6 * day, remindersSent: 0, wantReminded: 1},
+		{name: "Already_reminded", expiresIn: 6 * day, remindersSent: 1},
+		{name: "Missed_reminders_are_not_caught_up_on", expiresIn: 12 * time.Hour, remindersSent: 0, wantReminded: 3},
+		{name: "Reminded_by_another_run", expiresIn: 6 * day, remindersSent: 0, wantReminded: 1, remindedByRun: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(constants.InvitationReminderDaysEnvVar, "3, 7,1")
+			mockEmailRepo := new(repomocks.EmailStorer)
+			mockProfileRepo := new(repomocks.ProfileStorer)
+			mockOutboxRepo := new(repomocks.EmailOutboxStorer)
+			mockTemplateRepo := new(repomocks.EmailTemplateStorer)
+			svc := service.NewServices(service.RepoDeps{UserEmailDeps: mockEmailRepo, ProfileDeps: mockProfileRepo, EmailOutboxDeps: mockOutboxRepo, EmailTemplateDeps: mockTemplateRepo})
+
+			invitation := specs.PendingInvitation{ProfileID: 8, Name: "Alice", Email: "alice@example.com", ExpiresAt: time.Now().Add(tt.expiresIn), RemindersSent: tt.remindersSent}
+			mockEmailRepo.On("ListPendingInvitations", mock.Anything).Return([]specs.PendingInvitation{invitation}, nil).Once()
+			if tt.wantReminded > 0 {
+				var tx pgx.Tx
+				mockProfileRepo.On("BeginTransaction", mock.Anything).Return(tx, nil).Once()
+				mockEmailRepo.On("RecordInvitationReminder", mock.Anything, 8, tt.wantReminded, mock.Anything).Return(!tt.remindedByRun, nil).Once()
+				mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			}
+			if tt.wantReminded > 0 && !tt.remindedByRun {
+				mockTemplateRepo.On("GetEmailTemplate", mock.Anything, constants.EmailKindInvitationReminder).Return(reminderTemplate, nil).Once()
+				mockOutboxRepo.On("QueueEmail", mock.Anything, mock.MatchedBy(func(email repository.OutboxEmailRepo) bool {
+					return email.Kind == constants.EmailKindInvitationReminder && email.Recipient == "alice@example.com" && *email.ProfileID == 8
+				}), mock.Anything).Return(int64(4), nil).Once()
+				mockOutboxRepo.On("ClaimOutboxEmail", mock.Anything, int64(4), mock.Anything).Return(specs.OutboxEmail{}, pkgerrors.ErrNoRecordFound).Maybe()
+			}
+
+			sent, err := svc.SendInvitationReminders(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantReminded > 0 && !tt.remindedByRun, sent == 1)
+			mockEmailRepo.AssertExpectations(t)
+			mockOutboxRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResendInvitation(t *testing.T) {
+	t.Run("Renews_the_expiry_and_emails_the_invitation_again", func(t *testing.T) {
+		t.Setenv(constants.InvitationExpiryDaysEnvVar, "10")
+		mockEmailRepo := new(repomocks.EmailStorer)
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		mockOutboxRepo := new(repomocks.EmailOutboxStorer)
+		mockTemplateRepo := new(repomocks.EmailTemplateStorer)
+		svc := service.NewServices(service.RepoDeps{UserEmailDeps: mockEmailRepo, ProfileDeps: mockProfileRepo, EmailOutboxDeps: mockOutboxRepo, EmailTemplateDeps: mockTemplateRepo})
+
+		var tx pgx.Tx
+		mockProfileRepo.On("BeginTransaction", mock.Anything).Return(tx, nil).Once()
+		mockEmailRepo.On("GetPendingInvitation", mock.Anything, 8, mock.Anything).Return(specs.PendingInvitation{ProfileID: 8, Name: "Alice", Email: "alice@example.com", IsOverdue: true}, nil).Once()
+		mockEmailRepo.On("RenewInvitation", mock.Anything, 8, mock.MatchedBy(func(renewal repository.RenewInvitationRepo) bool {
+			expiry := time.Until(renewal.ExpiresAt)
+			return renewal.UpdatedByID == 2 && renewal.RemindersSent == 0 && expiry > 10*24*time.Hour-time.Minute && expiry <= 10*24*time.Hour
+		}), mock.Anything).Return(nil).Once()
+		mockTemplateRepo.On("GetEmailTemplate", mock.Anything, constants.EmailKindEmployeeInvitation).Return(reminderTemplate, nil).Once()
+		mockOutboxRepo.On("QueueEmail", mock.Anything, mock.MatchedBy(func(email repository.OutboxEmailRepo) bool {
+			return email.Kind == constants.EmailKindEmployeeInvitation && email.Recipient == "alice@example.com" &&
+				email.Subject == "Complete your profile by "+time.Now().Add(10*24*time.Hour).In(time.FixedZone("IST", 5*3600+1800)).Format(constants.InvitationExpiryFormat)
+		}), mock.Anything).Return(int64(5), nil).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+		mockOutboxRepo.On("ClaimOutboxEmail", mock.Anything, int64(5), mock.Anything).Return(specs.OutboxEmail{}, pkgerrors.ErrNoRecordFound).Maybe()
+
+		err := svc.ResendInvitation(context.Background(), 2, 8)
+
+		assert.NoError(t, err)
+		mockEmailRepo.AssertExpectations(t)
+		mockOutboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("Fails_without_an_open_invitation", func(t *testing.T) {
+		mockEmailRepo := new(repomocks.EmailStorer)
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		svc := service.NewServices(service.RepoDeps{UserEmailDeps: mockEmailRepo, ProfileDeps: mockProfileRepo})
+
+		var tx pgx.Tx
+		mockProfileRepo.On("BeginTransaction", mock.Anything).Return(tx, nil).Once()
+		mockEmailRepo.On("GetPendingInvitation", mock.Anything, 8, mock.Anything).Return(specs.PendingInvitation{}, pkgerrors.ErrInvitationNotFound).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, pkgerrors.ErrInvitationNotFound).Return(nil).Once()
+
+		err := svc.ResendInvitation(context.Background(), 2, 8)
+
+		assert.Equal(t, pkgerrors.ErrInvitationNotFound, err)
+		mockEmailRepo.AssertNotCalled(t, "RenewInvitation", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestListProfileInvitations(t *testing.T) {
+	mockEmailRepo := new(repomocks.EmailStorer)
+	svc := service.NewServices(service.RepoDeps{UserEmailDeps: mockEmailRepo})
+
+	hours := 26.5
+	invitations := []specs.Invitation{
+		{ID: 9, ProfileID: 8, Status: constants.InvitationPending},
+		{ID: 4, ProfileID: 8, Status: constants.InvitationCompleted, HoursToComplete: &hours},
+	}
+	mockEmailRepo.On("ListInvitations", mock.Anything, specs.ListInvitationsFilter{ProfileID: 8}).Return(invitations, nil).Once()
+
+	resp, err := svc.ListProfileInvitations(context.Background(), 8)
+
+	assert.NoError(t, err)
+	assert.Equal(t, specs.ListInvitationsResponse{Invitations: invitations}, resp)
+	mockEmailRepo.AssertExpectations(t)
+}
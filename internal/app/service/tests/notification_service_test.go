@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	pkgerrors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	repomocks "github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMarkNotificationRead(t *testing.T) {
+	t.Run("Publishes_the_unread_count_to_the_streams_of_the_user", func(t *testing.T) {
+		mockNotificationRepo := new(repomocks.NotificationStorer)
+		svc := service.NewServices(service.RepoDeps{NotificationDeps: mockNotificationRepo})
+
+		events, unsubscribe := svc.SubscribeNotifications(1)
+		otherEvents, unsubscribeOther := svc.SubscribeNotifications(2)
+		defer unsubscribeOther()
+
+		mockNotificationRepo.On("MarkNotificationRead", mock.Anything, 1, int64(3)).Return(specs.Notification{ID: 3, UserID: 1}, nil).Once()
+		mockNotificationRepo.On("CountUnreadNotifications", mock.Anything, 1).Return(4, nil).Once()
+
+		notification, err := svc.MarkNotificationRead(context.Background(), 1, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), notification.ID)
+		assert.Equal(t, specs.NotificationEvent{UnreadCount: 4}, <-events)
+		assert.Empty(t, otherEvents)
+
+		unsubscribe()
+		mockNotificationRepo.On("MarkNotificationRead", mock.Anything, 1, int64(5)).Return(specs.Notification{ID: 5, UserID: 1}, nil).Once()
+
+		_, err = svc.MarkNotificationRead(context.Background(), 1, 5)
+
+		assert.NoError(t, err)
+		mockNotificationRepo.AssertExpectations(t)
+	})
+
+	t.Run("Fails_for_notification_of_another_user", func(t *testing.T) {
+		mockNotificationRepo := new(repomocks.NotificationStorer)
+		svc := service.NewServices(service.RepoDeps{NotificationDeps: mockNotificationRepo})
+
+		mockNotificationRepo.On("MarkNotificationRead", mock.Anything, 1, int64(3)).Return(specs.Notification{}, pkgerrors.ErrNotificationNotFound).Once()
+
+		_, err := svc.MarkNotificationRead(context.Background(), 1, 3)
+
+		assert.Equal(t, pkgerrors.ErrNotificationNotFound, err)
+		mockNotificationRepo.AssertNotCalled(t, "CountUnreadNotifications", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMarkAllNotificationsRead(t *testing.T) {
+	mockNotificationRepo := new(repomocks.NotificationStorer)
+	svc := service.NewServices(service.RepoDeps{NotificationDeps: mockNotificationRepo})
+
+	mockNotificationRepo.On("MarkAllNotificationsRead", mock.Anything, 1).Return(6, nil).Once()
+
+	resp, err := svc.MarkAllNotificationsRead(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, specs.MarkAllNotificationsReadResponse{MarkedRead: 6}, resp)
+	mockNotificationRepo.AssertNotCalled(t, "CountUnreadNotifications", mock.Anything, mock.Anything)
+}
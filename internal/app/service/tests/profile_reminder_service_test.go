@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	pkgerrors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	repomocks "github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var staleProfileTemplate = specs.EmailTemplate{Version: 1, Subject: "Update your profile", HTMLBody: "<p>{{.LastUpdatedAt}} {{.LatestProjectEndedAt}}</p>", TextBody: "{{.LastUpdatedAt}} {{.LatestProjectEndedAt}}"}
+
+func TestSendStaleProfileReminders(t *testing.T) {
+	day := 24 * time.Hour
+	recently := time.Now().Add(-10 * day)
+	longAgo := time.Now().Add(-40 * day)
+	tests := []struct {
+		name                     string
+		activity                 specs.ProfileActivity
+		neverInvited             bool
+		wantReminded             bool
+		remindedByRun            bool
+		wantLatestProjectEndedAt string
+	}{
+		{name: "Recently_updated", activity: specs.ProfileActivity{UpdatedAt: time.Now().Add(-30 * day)}},
+		{name: "Not_updated_within_the_window", activity: specs.ProfileActivity{UpdatedAt: time.Now().Add(-200 * day)}, wantReminded: true},
+		{
+			name:                     "Latest_project_ended_long_ago",
+			activity:                 specs.ProfileActivity{UpdatedAt: time.Now().Add(-30 * day), ProjectEndDates: []string{"2020-01-15", "Jul-2021"}},
+			wantReminded:             true,
+			wantLatestProjectEndedAt: "31 Jul 2021",
+		},
+		{name: "Project_is_ongoing", activity: specs.ProfileActivity{UpdatedAt: time.Now().Add(-30 * day), ProjectEndDates: []string{"2020-01-15", ""}}},
+		{name: "Invitation_is_open", activity: specs.ProfileActivity{UpdatedAt: time.Now().Add(-200 * day), HasOpenInvitation: true}},
+		{name: "Never_invited", activity: specs.ProfileActivity{UpdatedAt: time.Now().Add(-200 * day)}, neverInvited: true},
+		{name: "Reminded_recently", activity: specs.ProfileActivity{UpdatedAt: time.Now().Add(-200 * day), RemindersSent: 1, LastRemindedAt: &recently}},
+		{name: "Reminded_before_the_interval", activity: specs.ProfileActivity{UpdatedAt: time.Now().Add(-200 * day), RemindersSent: 1, LastRemindedAt: &longAgo}, wantReminded: true},
+		{name: "Reminded_by_another_run", activity: specs.ProfileActivity{UpdatedAt: time.Now().Add(-200 * day)}, wantReminded: true, remindedByRun: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(constants.StaleProfileDaysEnvVar, "180")
+			t.Setenv(constants.StaleProjectDaysEnvVar, "180")
+			t.Setenv(constants.StaleProfileReminderIntervalDaysEnvVar, "30")
+			mockReminderRepo := new(repomocks.ProfileReminderStorer)
+			mockProfileRepo := new(repomocks.ProfileStorer)
+			mockOutboxRepo := new(repomocks.EmailOutboxStorer)
+			mockTemplateRepo := new(repomocks.EmailTemplateStorer)
+			svc := service.NewServices(service.RepoDeps{ProfileReminderDeps: mockReminderRepo, ProfileDeps: mockProfileRepo, EmailOutboxDeps: mockOutboxRepo, EmailTemplateDeps: mockTemplateRepo})
+
+			activity := tt.activity
+			activity.ProfileID, activity.Name, activity.Email = 8, "Alice", "alice@example.com"
+			activity.HasCompletedInvitation = !tt.neverInvited
+			mockReminderRepo.On("ListProfileActivity", mock.Anything).Return([]specs.ProfileActivity{activity}, nil).Once()
+			if tt.wantReminded {
+				var tx pgx.Tx
+				mockProfileRepo.On("BeginTransaction", mock.Anything).Return(tx, nil).Once()
+				mockReminderRepo.On("RecordStaleProfileReminder", mock.Anything, 8, mock.MatchedBy(func(remindedBefore time.Time) bool {
+					return time.Since(remindedBefore) >= 30*day && time.Since(remindedBefore) < 30*day+time.Minute
+				}), mock.Anything).Return(!tt.remindedByRun, nil).Once()
+				mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			}
+			if tt.wantReminded && !tt.remindedByRun {
+				mockTemplateRepo.On("GetEmailTemplate", mock.Anything, constants.EmailKindStaleProfile).Return(staleProfileTemplate, nil).Once()
+				mockOutboxRepo.On("QueueEmail", mock.Anything, mock.MatchedBy(func(email repository.OutboxEmailRepo) bool {
+					return email.Kind == constants.EmailKindStaleProfile && email.Recipient == "alice@example.com" && *email.ProfileID == 8 &&
+						email.TextBody == activity.UpdatedAt.Format(constants.ProfileDateFormat)+" "+tt.wantLatestProjectEndedAt
+				}), mock.Anything).Return(int64(4), nil).Once()
+				mockOutboxRepo.On("ClaimOutboxEmail", mock.Anything, int64(4), mock.Anything).Return(specs.OutboxEmail{}, pkgerrors.ErrNoRecordFound).Maybe()
+			}
+
+			sent, err := svc.SendStaleProfileReminders(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantReminded && !tt.remindedByRun, sent == 1)
+			mockReminderRepo.AssertExpectations(t)
+			mockOutboxRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSendProfileDigest(t *testing.T) {
+	t.Run("Emails_the_digest_to_every_recipient", func(t *testing.T) {
+		mockReminderRepo := new(repomocks.ProfileReminderStorer)
+		mockEmailRepo := new(repomocks.EmailStorer)
+		mockUserRepo := new(repomocks.UserStorer)
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		mockOutboxRepo := new(repomocks.EmailOutboxStorer)
+		mockTemplateRepo := new(repomocks.EmailTemplateStorer)
+		svc := service.NewServices(service.RepoDeps{ProfileReminderDeps: mockReminderRepo, UserEmailDeps: mockEmailRepo, UserLoginDeps: mockUserRepo,
+			ProfileDeps: mockProfileRepo, EmailOutboxDeps: mockOutboxRepo, EmailTemplateDeps: mockTemplateRepo})
+
+		completedAt := time.Date(2024, 7, 3, 10, 0, 0, 0, time.UTC)
+		mockReminderRepo.On("ListProfileActivity", mock.Anything).Return([]specs.ProfileActivity{
+			{ProfileID: 1, Name: "John", UpdatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{ProfileID: 2, Name: "Jane", UpdatedAt: time.Now()},
+		}, nil).Once()
+		mockEmailRepo.On("ListInvitations", mock.Anything, specs.ListInvitationsFilter{Status: constants.InvitationOverdue}).Return([]specs.Invitation{}, nil).Once()
+		mockEmailRepo.On("ListInvitations", mock.Anything, specs.ListInvitationsFilter{Status: constants.InvitationPending}).
+			Return([]specs.Invitation{{ProfileID: 5, Name: "Sam", CreatedAt: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), ExpiresAt: time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)}}, nil).Once()
+		mockEmailRepo.On("ListInvitations", mock.Anything, mock.MatchedBy(func(filter specs.ListInvitationsFilter) bool {
+			return filter.Status == constants.InvitationCompleted && filter.CompletedFrom != nil && time.Since(*filter.CompletedFrom) > 7*24*time.Hour-time.Minute
+		})).Return([]specs.Invitation{{ProfileID: 6, Name: "Lee", CompletedAt: &completedAt}}, nil).Once()
+
+		var tx pgx.Tx
+		mockProfileRepo.On("BeginTransaction", mock.Anything).Return(tx, nil).Once()
+		mockUserRepo.On("ListUsers", mock.Anything, mock.MatchedBy(func(filter specs.ListUsersFilter) bool {
+			return filter.Permission == constants.PermProfilesDigest && *filter.IsActive
+		}), mock.Anything).Return([]specs.UserResponse{{ID: 1, Name: "Admin", Email: "admin@example.com"}, {ID: 2, Name: "Manager", Email: "manager@example.com"}}, nil).Once()
+		mockTemplateRepo.On("GetEmailTemplate", mock.Anything, constants.EmailKindProfileDigest).Return(specs.EmailTemplate{
+			Version:  1,
+			Subject:  "Digest",
+			HTMLBody: "<p>{{.Name}}</p>",
+			TextBody: "{{range .Digest.StaleProfiles}}{{.Name}}: {{.Note}}\n{{end}}{{range .Digest.IncompleteProfiles}}{{.Name}}: {{.Note}}\n{{end}}{{range .Digest.CompletedProfiles}}{{.Name}}: {{.Note}}\n{{end}}",
+		}, nil).Twice()
+		wantText := "John: last updated 01 Jan 2024\nSam: invited 01 Jul 2024, expires 15 Jul 2024\nLee: completed 03 Jul 2024\n"
+		mockOutboxRepo.On("QueueEmail", mock.Anything, mock.MatchedBy(func(email repository.OutboxEmailRepo) bool {
+			return email.Kind == constants.EmailKindProfileDigest && email.Recipient == "admin@example.com" && email.TextBody == wantText
+		}), mock.Anything).Return(int64(7), nil).Once()
+		mockOutboxRepo.On("QueueEmail", mock.Anything, mock.MatchedBy(func(email repository.OutboxEmailRepo) bool {
+			return email.Recipient == "manager@example.com" && email.TextBody == wantText
+		}), mock.Anything).Return(int64(8), nil).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+		mockOutboxRepo.On("ClaimOutboxEmail", mock.Anything, mock.Anything, mock.Anything).Return(specs.OutboxEmail{}, pkgerrors.ErrNoRecordFound).Maybe()
+
+		sent, err := svc.SendProfileDigest(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		mockEmailRepo.AssertExpectations(t)
+		mockUserRepo.AssertExpectations(t)
+		mockOutboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("Sends_nothing_without_profiles_to_report", func(t *testing.T) {
+		mockReminderRepo := new(repomocks.ProfileReminderStorer)
+		mockEmailRepo := new(repomocks.EmailStorer)
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		svc := service.NewServices(service.RepoDeps{ProfileReminderDeps: mockReminderRepo, UserEmailDeps: mockEmailRepo, ProfileDeps: mockProfileRepo})
+
+		mockReminderRepo.On("ListProfileActivity", mock.Anything).Return([]specs.ProfileActivity{{ProfileID: 2, UpdatedAt: time.Now()}}, nil).Once()
+		mockEmailRepo.On("ListInvitations", mock.Anything, mock.Anything).Return([]specs.Invitation{}, nil).Times(3)
+
+		sent, err := svc.SendProfileDigest(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, sent)
+		mockProfileRepo.AssertNotCalled(t, "BeginTransaction", mock.Anything)
+	})
+}
@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	clientmocks "github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
 	errs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -427,3 +430,155 @@ func TestDeleteProjectService(t *testing.T) {
 		})
 	}
 }
+
+func TestListIntranetProjectProposals(t *testing.T) {
+	employeeID := "EMP001"
+	intranetProjects := []specs.IntranetProject{
+		{Name: "Billing", Description: "Invoices and payments", StartDate: "Jan-2023", EndDate: "Dec-2023"},
+		{Name: "payroll ", Description: "Monthly payroll", StartDate: "Feb-2022"},
+		{Name: "Search", Description: "Full text search", StartDate: "Mar-2021"},
+		{Name: "Archived", Description: "Old system"},
+	}
+	profileProjects := []specs.ProjectResponse{
+		{ID: 4, ProfileID: 1, Name: "Payroll", Description: "Payroll", WorkingStartDate: "Feb-2022"},
+		{ID: 5, ProfileID: 1, Name: "Search", Description: "Full text search", WorkingStartDate: "Mar-2021", WorkingEndDate: "Apr-2021"},
+	}
+	rejections := []specs.IntranetProjectRejection{{NameKey: "archived", Description: "Old system"}}
+
+	tests := []struct {
+		name            string
+		setup           func(profileMock *mocks.ProfileStorer, projectMock *mocks.ProjectStorer, intranetMock *clientmocks.IntranetClient)
+		wantProposals   []specs.IntranetProjectProposal
+		isErrorExpected error
+	}{
+		{
+			name: "Success_proposes_additions_and_updates",
+			setup: func(profileMock *mocks.ProfileStorer, projectMock *mocks.ProjectStorer, intranetMock *clientmocks.IntranetClient) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Twice()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{ProfileID: 1, EmployeeID: &employeeID}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Twice()
+				intranetMock.On("GetEmployeeByID", mock.Anything, employeeID).Return(&specs.IntranetEmployee{EmployeeID: employeeID, Projects: intranetProjects}, nil).Once()
+				projectMock.On("ListProjects", mock.Anything, 1, specs.ListProjectsFilter{}, nil).Return(profileProjects, nil).Once()
+				projectMock.On("ListIntranetProjectRejections", mock.Anything, 1, nil).Return(rejections, nil).Once()
+			},
+			wantProposals: []specs.IntranetProjectProposal{
+				{Action: constants.IntranetProjectAdd, Name: "Billing", Changes: []specs.IntranetProjectChange{
+					{Field: "description", NewValue: "Invoices and payments"},
+					{Field: "working_start_date", NewValue: "Jan-2023"},
+					{Field: "working_end_date", NewValue: "Dec-2023"},
+				}},
+				{Action: constants.IntranetProjectUpdate, ProjectID: 4, Name: "Payroll", Changes: []specs.IntranetProjectChange{
+					{Field: "description", OldValue: "Payroll", NewValue: "Monthly payroll"},
+				}},
+			},
+		},
+		{
+			name: "Fail_for_profile_without_employee_id",
+			setup: func(profileMock *mocks.ProfileStorer, projectMock *mocks.ProjectStorer, intranetMock *clientmocks.IntranetClient) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{ProfileID: 1}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+			isErrorExpected: errs.ErrNoEmployeeID,
+		},
+		{
+			name: "Fail_for_intranet_unavailable",
+			setup: func(profileMock *mocks.ProfileStorer, projectMock *mocks.ProjectStorer, intranetMock *clientmocks.IntranetClient) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{ProfileID: 1, EmployeeID: &employeeID}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+				intranetMock.On("GetEmployeeByID", mock.Anything, employeeID).Return(nil, errs.ErrIntranetUnavailable).Once()
+			},
+			isErrorExpected: errs.ErrIntranetUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProfileRepo := new(mocks.ProfileStorer)
+			mockProjectRepo := new(mocks.ProjectStorer)
+			mockIntranetClient := new(clientmocks.IntranetClient)
+			svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, ProjectDeps: mockProjectRepo, IntranetClient: mockIntranetClient})
+			tt.setup(mockProfileRepo, mockProjectRepo, mockIntranetClient)
+
+			resp, err := svc.ListIntranetProjectProposals(context.Background(), 1)
+
+			assert.Equal(t, tt.isErrorExpected, err)
+			if tt.isErrorExpected == nil {
+				assert.Equal(t, employeeID, resp.EmployeeID)
+				assert.Equal(t, tt.wantProposals, resp.Proposals)
+			}
+			mockProfileRepo.AssertExpectations(t)
+			mockProjectRepo.AssertExpectations(t)
+			mockIntranetClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestApplyIntranetProjectProposals(t *testing.T) {
+	employeeID := "EMP001"
+	intranetEmployee := &specs.IntranetEmployee{EmployeeID: employeeID, Projects: []specs.IntranetProject{
+		{Name: "Billing", Description: "Invoices and payments", StartDate: "Jan-2023"},
+		{Name: "Payroll", Description: "Monthly payroll"},
+		{Name: "Search", Description: "Full text search"},
+	}}
+	profileProjects := []specs.ProjectResponse{
+		{ID: 4, ProfileID: 1, Name: "Payroll", Description: "Payroll", Role: "Developer", TechWorkedOn: []string{"Go"}, Duration: "1 year"},
+	}
+
+	setup := func(profileMock *mocks.ProfileStorer, projectMock *mocks.ProjectStorer, intranetMock *clientmocks.IntranetClient, txErr error) {
+		profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Twice()
+		profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{ProfileID: 1, EmployeeID: &employeeID}, nil).Once()
+		profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+		profileMock.On("HandleTransaction", mock.Anything, mock.Anything, txErr).Return(nil).Once()
+		intranetMock.On("GetEmployeeByID", mock.Anything, employeeID).Return(intranetEmployee, nil).Once()
+		projectMock.On("ListProjects", mock.Anything, 1, specs.ListProjectsFilter{}, nil).Return(profileProjects, nil).Once()
+		projectMock.On("ListIntranetProjectRejections", mock.Anything, 1, nil).Return([]specs.IntranetProjectRejection{}, nil).Once()
+	}
+
+	t.Run("Success_adds_updates_and_rejects", func(t *testing.T) {
+		mockProfileRepo := new(mocks.ProfileStorer)
+		mockProjectRepo := new(mocks.ProjectStorer)
+		mockIntranetClient := new(clientmocks.IntranetClient)
+		svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, ProjectDeps: mockProjectRepo, IntranetClient: mockIntranetClient})
+		setup(mockProfileRepo, mockProjectRepo, mockIntranetClient, nil)
+
+		mockProjectRepo.On("UpdateProject", mock.Anything, 1, 4, mock.MatchedBy(func(value repository.UpdateProjectRepo) bool {
+			return value.Description == "Monthly payroll" && value.Role == "Developer" && value.Duration == "1 year" && value.UpdatedByID == 9
+		}), nil).Return(1, nil).Once()
+		mockProjectRepo.On("UpsertIntranetProjectRejection", mock.Anything, repository.IntranetProjectRejectionRepo{
+			ProfileID: 1, NameKey: "search", Description: "Full text search", RejectedByID: 9,
+		}, nil).Return(nil).Once()
+		mockProfileRepo.On("CountRecords", mock.Anything, 1, constants.Projects, nil).Return(2, nil).Once()
+		mockProjectRepo.On("CreateProject", mock.Anything, mock.MatchedBy(func(values []repository.ProjectRepo) bool {
+			return len(values) == 1 && values[0].Name == "Billing" && values[0].Priorities == 3 && values[0].WorkingStartDate == "Jan-2023" && values[0].TechWorkedOn != nil
+		}), nil).Return(nil).Once()
+
+		resp, err := svc.ApplyIntranetProjectProposals(context.Background(), 1, 9, specs.ApplyIntranetProjectsRequest{Decisions: []specs.IntranetProjectDecision{
+			{Name: "billing", Accept: true},
+			{Name: "Payroll", Accept: true},
+			{Name: "Search", Accept: false},
+		}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, specs.ApplyIntranetProjectsResponse{Added: 1, Updated: 1, Rejected: 1}, resp)
+		mockProfileRepo.AssertExpectations(t)
+		mockProjectRepo.AssertExpectations(t)
+	})
+
+	t.Run("Fail_for_project_not_proposed", func(t *testing.T) {
+		mockProfileRepo := new(mocks.ProfileStorer)
+		mockProjectRepo := new(mocks.ProjectStorer)
+		mockIntranetClient := new(clientmocks.IntranetClient)
+		svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, ProjectDeps: mockProjectRepo, IntranetClient: mockIntranetClient})
+		setup(mockProfileRepo, mockProjectRepo, mockIntranetClient, errs.ErrProjectNotProposed)
+
+		_, err := svc.ApplyIntranetProjectProposals(context.Background(), 1, 9, specs.ApplyIntranetProjectsRequest{Decisions: []specs.IntranetProjectDecision{
+			{Name: "Unknown", Accept: true},
+		}})
+
+		assert.Equal(t, errs.ErrProjectNotProposed, err)
+		mockProjectRepo.AssertNotCalled(t, "CreateProject", mock.Anything, mock.Anything, mock.Anything)
+		mockProfileRepo.AssertExpectations(t)
+	})
+}
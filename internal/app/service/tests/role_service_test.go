@@ -0,0 +1,187 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	errs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetPermissionScope(t *testing.T) {
+	mockRoleRepo := &mocks.RoleStorer{}
+	mockRoleRepo.Test(t)
+
+	roleService := service.NewServices(service.RepoDeps{RoleDeps: mockRoleRepo})
+
+	grants := map[string]map[string]string{
+		constants.Admin:    {constants.PermProfilesList: constants.ScopeAll},
+		constants.Employee: {constants.PermProfilesRead: constants.ScopeOwn},
+	}
+	// grants are loaded once and served from the cache afterwards
+	mockRoleRepo.On("ListRolePermissions", mock.Anything).Return(grants, nil).Once()
+
+	tests := []struct {
+		name          string
+		role          string
+		permission    string
+		expectedScope string
+		expectedErr   error
+	}{
+		{
+			name:          "Success_for_admin_permission",
+			role:          constants.Admin,
+			permission:    constants.PermProfilesList,
+			expectedScope: constants.ScopeAll,
+		},
+		{
+			name:          "Success_for_own_scope",
+			role:          constants.Employee,
+			permission:    constants.PermProfilesRead,
+			expectedScope: constants.ScopeOwn,
+		},
+		{
+			name:        "Fail_for_permission_not_granted",
+			role:        constants.Employee,
+			permission:  constants.PermProfilesDelete,
+			expectedErr: errs.ErrPermissionDenied,
+		},
+		{
+			name:        "Fail_for_unknown_role",
+			role:        "intern",
+			permission:  constants.PermProfilesRead,
+			expectedErr: errs.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := roleService.GetPermissionScope(context.Background(), tt.role, tt.permission)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedScope, scope)
+		})
+	}
+
+	mockRoleRepo.AssertExpectations(t)
+}
+
+func TestUpdateRole(t *testing.T) {
+	tests := []struct {
+		name        string
+		roleID      int
+		setup       func(roleMock *mocks.RoleStorer, profileMock *mocks.ProfileStorer)
+		expectedErr error
+	}{
+		{
+			name:   "Success_for_custom_role",
+			roleID: 3,
+			setup: func(roleMock *mocks.RoleStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				roleMock.On("GetRole", mock.Anything, 3, mock.Anything).Return(specs.RoleResponse{ID: 3, Name: "viewer"}, nil).Once()
+				roleMock.On("UpdateRole", mock.Anything, 3, "Sales", mock.Anything, mock.Anything).Return(nil).Once()
+				roleMock.On("ReplaceRolePermissions", mock.Anything, 3, []specs.RolePermission{{Permission: constants.PermProfilesRead, Scope: constants.ScopeAll}}, mock.Anything).Return(nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+		},
+		{
+			name:   "Fail_for_system_role",
+			roleID: 1,
+			setup: func(roleMock *mocks.RoleStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				roleMock.On("GetRole", mock.Anything, 1, mock.Anything).Return(specs.RoleResponse{ID: 1, Name: constants.Admin, IsSystem: true}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, errs.ErrSystemRole).Return(nil).Once()
+			},
+			expectedErr: errs.ErrSystemRole,
+		},
+		{
+			name:   "Fail_for_missing_role",
+			roleID: 9,
+			setup: func(roleMock *mocks.RoleStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				roleMock.On("GetRole", mock.Anything, 9, mock.Anything).Return(specs.RoleResponse{}, errs.ErrRoleNotFound).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, errs.ErrRoleNotFound).Return(nil).Once()
+			},
+			expectedErr: errs.ErrRoleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRoleRepo := &mocks.RoleStorer{}
+			mockProfileRepo := getProfileMock(t)
+			roleService := service.NewServices(service.RepoDeps{RoleDeps: mockRoleRepo, ProfileDeps: mockProfileRepo})
+
+			tt.setup(mockRoleRepo, mockProfileRepo)
+
+			err := roleService.UpdateRole(context.Background(), tt.roleID, specs.UpdateRoleRequest{
+				Description: "Sales",
+				Permissions: []specs.RolePermission{{Permission: constants.PermProfilesRead, Scope: constants.ScopeAll}},
+			})
+			assert.Equal(t, tt.expectedErr, err)
+
+			mockRoleRepo.AssertExpectations(t)
+			mockProfileRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteRole(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(roleMock *mocks.RoleStorer, profileMock *mocks.ProfileStorer)
+		expectedErr error
+	}{
+		{
+			name: "Success_for_unassigned_role",
+			setup: func(roleMock *mocks.RoleStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				roleMock.On("GetRole", mock.Anything, 4, mock.Anything).Return(specs.RoleResponse{ID: 4, Name: "recruiter"}, nil).Once()
+				roleMock.On("CountUsersWithRole", mock.Anything, "recruiter", mock.Anything).Return(0, nil).Once()
+				roleMock.On("DeleteRole", mock.Anything, 4, mock.Anything).Return(nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+		},
+		{
+			name: "Fail_for_role_in_use",
+			setup: func(roleMock *mocks.RoleStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				roleMock.On("GetRole", mock.Anything, 4, mock.Anything).Return(specs.RoleResponse{ID: 4, Name: "recruiter"}, nil).Once()
+				roleMock.On("CountUsersWithRole", mock.Anything, "recruiter", mock.Anything).Return(2, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, errs.ErrRoleInUse).Return(nil).Once()
+			},
+			expectedErr: errs.ErrRoleInUse,
+		},
+		{
+			name: "Fail_for_count_error",
+			setup: func(roleMock *mocks.RoleStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				roleMock.On("GetRole", mock.Anything, 4, mock.Anything).Return(specs.RoleResponse{ID: 4, Name: "recruiter"}, nil).Once()
+				roleMock.On("CountUsersWithRole", mock.Anything, "recruiter", mock.Anything).Return(0, errors.New("db error")).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expectedErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRoleRepo := &mocks.RoleStorer{}
+			mockProfileRepo := getProfileMock(t)
+			roleService := service.NewServices(service.RepoDeps{RoleDeps: mockRoleRepo, ProfileDeps: mockProfileRepo})
+
+			tt.setup(mockRoleRepo, mockProfileRepo)
+
+			err := roleService.DeleteRole(context.Background(), 4)
+			assert.Equal(t, tt.expectedErr, err)
+
+			mockRoleRepo.AssertExpectations(t)
+			mockProfileRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -103,7 +103,7 @@ func TestListProfile(t *testing.T) {
 			name: "Success_get_list_of_Profiles",
 			setup: func(userMock *mocks.ProfileStorer) {
 				userMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
-				userMock.On("ListProfiles", mock.Anything, mock.Anything).Return(mockListProfile, nil).Once()
+				userMock.On("ListProfiles", mock.Anything, specs.ListProfilesFilter{}, mock.Anything).Return(mockListProfile, nil).Once()
 				userMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
 			},
 			isErrorExpected: false,
@@ -113,7 +113,7 @@ func TestListProfile(t *testing.T) {
 			name: "Fail_get_list_of_Profiles",
 			setup: func(userMock *mocks.ProfileStorer) {
 				userMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
-				userMock.On("ListProfiles", mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+				userMock.On("ListProfiles", mock.Anything, specs.ListProfilesFilter{}, mock.Anything).Return(nil, errors.New("error")).Once()
 				userMock.On("HandleTransaction", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("handle transaction error")).Once()
 			},
 			isErrorExpected: true,
@@ -125,7 +125,7 @@ func TestListProfile(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			test.setup(mockProfileRepo)
 
-			gotResp, err := profileService.ListProfiles(context.Background())
+			gotResp, err := profileService.ListProfiles(context.Background(), specs.ListProfilesFilter{})
 			assert.Equal(t, test.wantResponse, gotResp)
 
 			if (err != nil) != test.isErrorExpected {
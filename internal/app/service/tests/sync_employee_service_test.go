@@ -2,71 +2,214 @@ package service_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
 	clientmocks "github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
 	pkgerrors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
 	repomocks "github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func TestSyncEmployees(t *testing.T) {
+	employeeID := "EMP001"
+
 	tests := []struct {
 		name            string
-		setup           func(profileMock *repomocks.ProfileStorer, intranetMock *clientmocks.IntranetClient)
+		policies        string
+		opts            specs.SyncEmployeesOptions
+		setup           func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient)
 		wantUpdated     int
 		wantSkipped     int
 		isErrorExpected bool
 	}{
 		{
-			name: "Success_all_matched",
-			setup: func(profileMock *repomocks.ProfileStorer, intranetMock *clientmocks.IntranetClient) {
+			name: "Success_applies_field_policies",
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient) {
+				employees := []specs.IntranetEmployee{
+					{EmployeeID: "EMP001", Email: "alice@example.com", Name: "Alice Intranet", Designation: "Senior Engineer", Gender: "Female", PrimarySkill: "Go, SQL"},
+				}
+				expectIntranetEmployees(intranetMock, employees)
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
+
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "alice@example.com", nil).Return(1, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{
+					ProfileID: 1, Name: "Alice", Designation: "Engineer", Gender: "Female", PrimarySkills: []string{},
+				}, nil).Once()
+
+				// name is profile_wins and gender is unchanged, so only these fields are taken from the intranet
+				fields := map[string]interface{}{
+					"employee_id":    "EMP001",
+					"designation":    "Senior Engineer",
+					"primary_skills": []string{"Go", "SQL"},
+				}
+				profileMock.On("GetProfileIDByEmployeeID", mock.Anything, "EMP001", nil).Return(0, pkgerrors.ErrNoRecordFound).Once()
+				profileMock.On("UpdateProfileSyncFields", mock.Anything, 1, fields, mock.Anything, nil).Return(nil).Once()
+				syncMock.On("CreateProfileSyncChanges", mock.Anything, mock.MatchedBy(func(changes []repository.ProfileSyncChangeRepo) bool {
+					return len(changes) == 3 &&
+						changes[0].Field == "employee_id" &&
+						changes[1].Field == "designation" && changes[1].OldValue == "Engineer" && changes[1].Policy == constants.SyncPolicyIntranetWins &&
+						changes[2].Field == "primary_skills" && changes[2].NewValue == "Go, SQL" && changes[2].Policy == constants.SyncPolicyFillIfEmpty
+				}), nil).Return(nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+			wantUpdated:     1,
+			wantSkipped:     0,
+			isErrorExpected: false,
+		},
+		{
+			name: "Success_fill_if_empty_keeps_profile_value",
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient) {
+				employees := []specs.IntranetEmployee{
+					{EmployeeID: "EMP001", Email: "alice@example.com", Gender: "F", LinkedinURL: "https://linkedin.com/in/alice-intranet"},
+				}
+				expectIntranetEmployees(intranetMock, employees)
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
+
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "alice@example.com", nil).Return(1, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{
+					ProfileID: 1, Gender: "Female", LinkedinLink: "https://linkedin.com/in/alice", EmployeeID: &employeeID,
+				}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+			wantUpdated:     0,
+			wantSkipped:     0,
+			isErrorExpected: false,
+		},
+		{
+			name:     "Success_with_policy_override",
+			policies: "name=intranet_wins, designation=profile_wins",
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient) {
+				employees := []specs.IntranetEmployee{
+					{EmployeeID: "EMP001", Email: "alice@example.com", Name: "Alice Intranet", Designation: "Senior Engineer", JoshDOJ: "2020-01-01"},
+				}
+				expectIntranetEmployees(intranetMock, employees)
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
+
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "alice@example.com", nil).Return(1, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{
+					ProfileID: 1, Name: "Alice", Designation: "Engineer", JoshJoiningDate: sql.NullString{String: "2020-01-01", Valid: true}, EmployeeID: &employeeID,
+				}, nil).Once()
+
+				fields := map[string]interface{}{"name": "Alice Intranet"}
+				profileMock.On("UpdateProfileSyncFields", mock.Anything, 1, fields, mock.Anything, nil).Return(nil).Once()
+				syncMock.On("CreateProfileSyncChanges", mock.Anything, mock.Anything, nil).Return(nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+			wantUpdated:     1,
+			wantSkipped:     0,
+			isErrorExpected: false,
+		},
+		{
+			name: "No_profile_for_email",
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient) {
+				employees := []specs.IntranetEmployee{
+					{EmployeeID: "EMP099", Email: "ghost@example.com"},
+				}
+				expectIntranetEmployees(intranetMock, employees)
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
+
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "ghost@example.com", nil).Return(0, pkgerrors.ErrNoRecordFound).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, pkgerrors.ErrNoRecordFound).Return(nil).Once()
+			},
+			wantUpdated:     0,
+			wantSkipped:     1,
+			isErrorExpected: false,
+		},
+		{
+			name: "Update_error_one_employee_continues",
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient) {
 				employees := []specs.IntranetEmployee{
 					{EmployeeID: "EMP001", Email: "alice@example.com"},
 					{EmployeeID: "EMP002", Email: "bob@example.com"},
 				}
-				intranetMock.On("GetEmployees", mock.Anything).Return(employees, nil).Once()
+				expectIntranetEmployees(intranetMock, employees)
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
 
-				profileMock.On("UpdateEmployeeIDByEmail", mock.Anything, "alice@example.com", "EMP001").Return(nil).Once()
-				profileMock.On("UpdateEmployeeIDByEmail", mock.Anything, "bob@example.com", "EMP002").Return(nil).Once()
+				dbErr := errors.New("db error")
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Twice()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "alice@example.com", nil).Return(1, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{ProfileID: 1}, nil).Once()
+				profileMock.On("GetProfileIDByEmployeeID", mock.Anything, "EMP001", nil).Return(0, pkgerrors.ErrNoRecordFound).Once()
+				profileMock.On("UpdateProfileSyncFields", mock.Anything, 1, mock.Anything, mock.Anything, nil).Return(dbErr).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, dbErr).Return(nil).Once()
+
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "bob@example.com", nil).Return(2, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 2, nil).Return(specs.ResponseProfile{ProfileID: 2}, nil).Once()
+				profileMock.On("GetProfileIDByEmployeeID", mock.Anything, "EMP002", nil).Return(2, nil).Once()
+				profileMock.On("UpdateProfileSyncFields", mock.Anything, 2, mock.Anything, mock.Anything, nil).Return(nil).Once()
+				syncMock.On("CreateProfileSyncChanges", mock.Anything, mock.Anything, nil).Return(nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
 			},
-			wantUpdated:     2,
+			wantUpdated:     1,
+			wantSkipped:     1,
+			isErrorExpected: false,
+		},
+		{
+			name: "Dry_run_writes_nothing",
+			opts: specs.SyncEmployeesOptions{DryRun: true},
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient) {
+				employees := []specs.IntranetEmployee{
+					{EmployeeID: "EMP001", Email: "alice@example.com", Designation: "Senior Engineer"},
+				}
+				expectIntranetEmployees(intranetMock, employees)
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
+
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "alice@example.com", nil).Return(1, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{ProfileID: 1, Designation: "Engineer", EmployeeID: &employeeID}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+			wantUpdated:     1,
 			wantSkipped:     0,
 			isErrorExpected: false,
 		},
 		{
-			name: "Partial_match",
-			setup: func(profileMock *repomocks.ProfileStorer, intranetMock *clientmocks.IntranetClient) {
+			name: "Only_syncs_listed_employees",
+			opts: specs.SyncEmployeesOptions{Only: []string{"BOB@example.com"}},
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient) {
 				employees := []specs.IntranetEmployee{
 					{EmployeeID: "EMP001", Email: "alice@example.com"},
 					{EmployeeID: "EMP002", Email: "bob@example.com"},
-					{EmployeeID: "EMP003", Email: "unknown@example.com"},
 				}
-				intranetMock.On("GetEmployees", mock.Anything).Return(employees, nil).Once()
+				expectIntranetEmployees(intranetMock, employees)
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
 
-				profileMock.On("UpdateEmployeeIDByEmail", mock.Anything, "alice@example.com", "EMP001").Return(nil).Once()
-				profileMock.On("UpdateEmployeeIDByEmail", mock.Anything, "bob@example.com", "EMP002").Return(nil).Once()
-				profileMock.On("UpdateEmployeeIDByEmail", mock.Anything, "unknown@example.com", "EMP003").Return(pkgerrors.ErrNoRecordFound).Once()
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "bob@example.com", nil).Return(0, pkgerrors.ErrNoRecordFound).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, pkgerrors.ErrNoRecordFound).Return(nil).Once()
 			},
-			wantUpdated:     2,
+			wantUpdated:     0,
 			wantSkipped:     1,
 			isErrorExpected: false,
 		},
 		{
-			name: "No_match",
-			setup: func(profileMock *repomocks.ProfileStorer, intranetMock *clientmocks.IntranetClient) {
+			name: "Employee_id_of_another_profile_is_skipped",
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient) {
 				employees := []specs.IntranetEmployee{
-					{EmployeeID: "EMP099", Email: "ghost1@example.com"},
-					{EmployeeID: "EMP100", Email: "ghost2@example.com"},
+					{EmployeeID: "EMP001", Email: "alice@example.com"},
+					{EmployeeID: "EMP001", Email: "alice.other@example.com"},
 				}
-				intranetMock.On("GetEmployees", mock.Anything).Return(employees, nil).Once()
+				expectIntranetEmployees(intranetMock, employees)
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
 
-				profileMock.On("UpdateEmployeeIDByEmail", mock.Anything, "ghost1@example.com", "EMP099").Return(pkgerrors.ErrNoRecordFound).Once()
-				profileMock.On("UpdateEmployeeIDByEmail", mock.Anything, "ghost2@example.com", "EMP100").Return(pkgerrors.ErrNoRecordFound).Once()
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "alice@example.com", nil).Return(1, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{ProfileID: 1}, nil).Once()
+				profileMock.On("GetProfileIDByEmployeeID", mock.Anything, "EMP001", nil).Return(7, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, pkgerrors.ErrDuplicateEmployeeID).Return(nil).Once()
 			},
 			wantUpdated:     0,
 			wantSkipped:     2,
@@ -74,50 +217,57 @@ func TestSyncEmployees(t *testing.T) {
 		},
 		{
 			name: "IntranetClient_error",
-			setup: func(profileMock *repomocks.ProfileStorer, intranetMock *clientmocks.IntranetClient) {
-				intranetMock.On("GetEmployees", mock.Anything).Return(nil, errors.New("API unavailable")).Once()
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient) {
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
+				intranetMock.On("ListEmployees", mock.Anything, specs.ListIntranetEmployeesOptions{}, mock.Anything).Return(errors.New("API unavailable")).Once()
 			},
 			wantUpdated:     0,
 			wantSkipped:     0,
 			isErrorExpected: true,
 		},
 		{
-			name: "UpdateEmployeeIDByEmail_error_one_employee_continues",
-			setup: func(profileMock *repomocks.ProfileStorer, intranetMock *clientmocks.IntranetClient) {
-				employees := []specs.IntranetEmployee{
-					{EmployeeID: "EMP001", Email: "alice@example.com"},
-					{EmployeeID: "EMP002", Email: "bob@example.com"},
-				}
-				intranetMock.On("GetEmployees", mock.Anything).Return(employees, nil).Once()
-
-				// alice — DB error (non-fatal: log and continue)
-				profileMock.On("UpdateEmployeeIDByEmail", mock.Anything, "alice@example.com", "EMP001").Return(errors.New("db error")).Once()
-				// bob — succeeds
-				profileMock.On("UpdateEmployeeIDByEmail", mock.Anything, "bob@example.com", "EMP002").Return(nil).Once()
+			name:     "Invalid_policy_override",
+			policies: "name=always",
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient) {
 			},
-			wantUpdated:     1,
-			wantSkipped:     1,
-			isErrorExpected: false,
+			wantUpdated:     0,
+			wantSkipped:     0,
+			isErrorExpected: true,
+		},
+		{
+			name:     "Unknown_field_in_policy_override",
+			policies: "email=intranet_wins",
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, intranetMock *clientmocks.IntranetClient) {
+			},
+			wantUpdated:     0,
+			wantSkipped:     0,
+			isErrorExpected: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(constants.IntranetSyncPoliciesEnvVar, tt.policies)
+
 			mockProfileRepo := new(repomocks.ProfileStorer)
+			mockSyncRepo := new(repomocks.IntranetSyncStorer)
 			mockIntranetClient := new(clientmocks.IntranetClient)
 
 			repoDeps := service.RepoDeps{
-				ProfileDeps:    mockProfileRepo,
-				IntranetClient: mockIntranetClient,
+				ProfileDeps:      mockProfileRepo,
+				IntranetSyncDeps: mockSyncRepo,
+				IntranetClient:   mockIntranetClient,
 			}
 			svc := service.NewServices(repoDeps)
 
-			tt.setup(mockProfileRepo, mockIntranetClient)
+			mockSyncRepo.On("SetSyncWatermark", mock.Anything, constants.SyncStateIntranetEmployees, mock.Anything).Return(nil).Maybe()
+			tt.setup(mockProfileRepo, mockSyncRepo, mockIntranetClient)
 
-			updated, skipped, err := svc.SyncEmployees(context.Background())
+			report, err := svc.SyncEmployees(context.Background(), tt.opts)
 
-			assert.Equal(t, tt.wantUpdated, updated)
-			assert.Equal(t, tt.wantSkipped, skipped)
+			assert.Equal(t, tt.wantUpdated, report.Updated)
+			assert.Equal(t, tt.wantSkipped, report.Skipped)
+			assert.Equal(t, tt.opts.DryRun, report.DryRun)
 			if tt.isErrorExpected {
 				assert.Error(t, err)
 			} else {
@@ -125,7 +275,420 @@ func TestSyncEmployees(t *testing.T) {
 			}
 
 			mockProfileRepo.AssertExpectations(t)
+			mockSyncRepo.AssertExpectations(t)
 			mockIntranetClient.AssertExpectations(t)
 		})
 	}
 }
+
+func TestListProfileSyncChanges(t *testing.T) {
+	mockProfileRepo := new(repomocks.ProfileStorer)
+	mockSyncRepo := new(repomocks.IntranetSyncStorer)
+	svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, IntranetSyncDeps: mockSyncRepo})
+
+	changes := []specs.ProfileSyncChange{{ID: 1, ProfileID: 1, EmployeeID: "EMP001", Field: "designation", OldValue: "Engineer", NewValue: "Senior Engineer", Policy: constants.SyncPolicyIntranetWins}}
+	mockProfileRepo.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+	mockSyncRepo.On("ListProfileSyncChanges", mock.Anything, 1, nil).Return(changes, nil).Once()
+	mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+
+	resp, err := svc.ListProfileSyncChanges(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, changes, resp.Changes)
+	mockProfileRepo.AssertExpectations(t)
+	mockSyncRepo.AssertExpectations(t)
+}
+
+func TestSyncEmployeesEmployment(t *testing.T) {
+	aliceID, bobID := "EMP001", "EMP002"
+	alice := specs.ProfileEmployment{ProfileID: 1, Name: "Alice", Email: "alice@example.com", EmployeeID: &aliceID, IsCurrentEmployee: 1, IsActive: 1, CreatedByID: 9}
+	bob := specs.ProfileEmployment{ProfileID: 2, Name: "Bob", Email: "bob@example.com", EmployeeID: &bobID, IsCurrentEmployee: 1, IsActive: 1, CreatedByID: 9}
+
+	tests := []struct {
+		name             string
+		opts             specs.SyncEmployeesOptions
+		setup            func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, userMock *repomocks.UserStorer, intranetMock *clientmocks.IntranetClient)
+		wantLeft         int
+		wantRejoined     int
+		wantCheckSkipped bool
+	}{
+		{
+			name: "Leaver_is_marked_and_login_deactivated",
+			opts: specs.SyncEmployeesOptions{DeactivateLeavers: true},
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, userMock *repomocks.UserStorer, intranetMock *clientmocks.IntranetClient) {
+				expectIntranetEmployees(intranetMock, []specs.IntranetEmployee{{EmployeeID: "EMP001", Email: "alice@example.com"}})
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{alice, bob}, nil).Once()
+
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Twice()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "alice@example.com", nil).Return(1, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{ProfileID: 1, EmployeeID: &aliceID}, nil).Once()
+
+				fields := map[string]interface{}{"is_current_employee": 0, "is_active": 0}
+				profileMock.On("UpdateProfileSyncFields", mock.Anything, 2, fields, mock.Anything, nil).Return(nil).Once()
+				syncMock.On("CreateProfileSyncChanges", mock.Anything, mock.MatchedBy(func(changes []repository.ProfileSyncChangeRepo) bool {
+					return len(changes) == 2 && changes[0].Field == "is_current_employee" && changes[1].Field == "is_active"
+				}), nil).Return(nil).Once()
+				userMock.On("GetUserInfo", mock.Anything, specs.UserInfoFilter{Email: "bob@example.com"}).Return(repository.User{ID: 5, Role: constants.Employee, IsActive: true}, nil).Once()
+				userMock.On("UpdateUserStatus", mock.Anything, 5, false, mock.Anything, nil).Return(nil).Once()
+				userMock.On("GetUserInfo", mock.Anything, specs.UserInfoFilter{ID: 9}).Return(repository.User{ID: 9, Email: "admin@example.com", Name: "Admin"}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Twice()
+			},
+			wantLeft: 1,
+		},
+		{
+			name: "Exited_employee_is_a_leaver_on_dry_run",
+			opts: specs.SyncEmployeesOptions{DryRun: true},
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, userMock *repomocks.UserStorer, intranetMock *clientmocks.IntranetClient) {
+				employees := []specs.IntranetEmployee{
+					{EmployeeID: "EMP001", Email: "alice@example.com"},
+					{EmployeeID: "EMP002", Email: "bob@example.com", Status: "Exited"},
+				}
+				expectIntranetEmployees(intranetMock, employees)
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{alice, bob}, nil).Once()
+
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "alice@example.com", nil).Return(1, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{ProfileID: 1, EmployeeID: &aliceID}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+			wantLeft: 1,
+		},
+		{
+			name: "Rejoiner_is_reactivated",
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, userMock *repomocks.UserStorer, intranetMock *clientmocks.IntranetClient) {
+				former := alice
+				former.IsCurrentEmployee, former.IsActive = 0, 0
+				expectIntranetEmployees(intranetMock, []specs.IntranetEmployee{{EmployeeID: "EMP001", Email: "alice@example.com"}})
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{former}, nil).Once()
+
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "alice@example.com", nil).Return(1, nil).Once()
+				profileMock.On("GetProfile", mock.Anything, 1, nil).Return(specs.ResponseProfile{ProfileID: 1, EmployeeID: &aliceID}, nil).Once()
+				fields := map[string]interface{}{"is_current_employee": 1, "is_active": 1}
+				profileMock.On("UpdateProfileSyncFields", mock.Anything, 1, fields, mock.Anything, nil).Return(nil).Once()
+				syncMock.On("CreateProfileSyncChanges", mock.Anything, mock.Anything, nil).Return(nil).Once()
+				userMock.On("GetUserInfo", mock.Anything, specs.UserInfoFilter{Email: "alice@example.com"}).Return(repository.User{ID: 4, Role: constants.Employee, IsActive: false}, nil).Once()
+				userMock.On("UpdateUserStatus", mock.Anything, 4, true, mock.Anything, nil).Return(nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+			wantRejoined: 1,
+		},
+		{
+			name: "Empty_intranet_list_marks_nobody",
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, userMock *repomocks.UserStorer, intranetMock *clientmocks.IntranetClient) {
+				expectIntranetEmployees(intranetMock, []specs.IntranetEmployee{})
+				profileMock.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{alice, bob}, nil).Once()
+			},
+			wantCheckSkipped: true,
+		},
+		{
+			name: "Too_many_leavers_marks_nobody",
+			setup: func(profileMock *repomocks.ProfileStorer, syncMock *repomocks.IntranetSyncStorer, userMock *repomocks.UserStorer, intranetMock *clientmocks.IntranetClient) {
+				expectIntranetEmployees(intranetMock, []specs.IntranetEmployee{{EmployeeID: "EMP100", Email: "new@example.com"}})
+				employments := []specs.ProfileEmployment{}
+				for i := 1; i <= 6; i++ {
+					id := fmt.Sprintf("EMP00%d", i)
+					employments = append(employments, specs.ProfileEmployment{ProfileID: i, Email: id + "@example.com", EmployeeID: &id, IsCurrentEmployee: 1, IsActive: 1})
+				}
+				profileMock.On("ListProfileEmployments", mock.Anything).Return(employments, nil).Once()
+
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, "new@example.com", nil).Return(0, pkgerrors.ErrNoRecordFound).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, pkgerrors.ErrNoRecordFound).Return(nil).Once()
+			},
+			wantCheckSkipped: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(constants.IntranetSyncPoliciesEnvVar, "")
+			mockProfileRepo := new(repomocks.ProfileStorer)
+			mockSyncRepo := new(repomocks.IntranetSyncStorer)
+			mockUserRepo := new(repomocks.UserStorer)
+			mockIntranetClient := new(clientmocks.IntranetClient)
+			mockOutboxRepo := new(repomocks.EmailOutboxStorer)
+			mockTemplateRepo := new(repomocks.EmailTemplateStorer)
+			svc := service.NewServices(service.RepoDeps{
+				ProfileDeps:       mockProfileRepo,
+				IntranetSyncDeps:  mockSyncRepo,
+				UserLoginDeps:     mockUserRepo,
+				IntranetClient:    mockIntranetClient,
+				EmailOutboxDeps:   mockOutboxRepo,
+				EmailTemplateDeps: mockTemplateRepo,
+			})
+
+			mockSyncRepo.On("SetSyncWatermark", mock.Anything, constants.SyncStateIntranetEmployees, mock.Anything).Return(nil).Maybe()
+			mockTemplateRepo.On("GetEmailTemplate", mock.Anything, constants.EmailKindLeaverNotification).Return(specs.EmailTemplate{Subject: "{{.EmployeeName}} has left"}, nil).Maybe()
+			mockOutboxRepo.On("QueueEmail", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil).Maybe()
+			mockOutboxRepo.On("ClaimOutboxEmail", mock.Anything, int64(1), mock.Anything).Return(specs.OutboxEmail{}, pkgerrors.ErrNoRecordFound).Maybe()
+			tt.setup(mockProfileRepo, mockSyncRepo, mockUserRepo, mockIntranetClient)
+
+			report, err := svc.SyncEmployees(context.Background(), tt.opts)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLeft, report.Left)
+			assert.Equal(t, tt.wantRejoined, report.Rejoined)
+			assert.Equal(t, tt.wantCheckSkipped, report.LeaverCheckSkipped != "")
+			mockProfileRepo.AssertExpectations(t)
+			mockSyncRepo.AssertExpectations(t)
+			mockUserRepo.AssertExpectations(t)
+			mockIntranetClient.AssertExpectations(t)
+		})
+	}
+}
+
+// expectIntranetEmployees makes the intranet list the given employees as a single page
+func expectIntranetEmployees(intranetMock *clientmocks.IntranetClient, employees []specs.IntranetEmployee) {
+	intranetMock.On("ListEmployees", mock.Anything, specs.ListIntranetEmployeesOptions{}, mock.Anything).Run(func(args mock.Arguments) {
+		handlePage := args.Get(2).(func(page []specs.IntranetEmployee) error)
+		handlePage(employees)
+	}).Return(nil).Once()
+}
+
+func TestSyncEmployeesIncremental(t *testing.T) {
+	aliceID := "EMP001"
+	alice := specs.ProfileEmployment{ProfileID: 1, Name: "Alice", Email: "alice@example.com", EmployeeID: &aliceID, IsCurrentEmployee: 1, IsActive: 1}
+	watermark := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Fetches_changes_since_the_watermark_and_advances_it", func(t *testing.T) {
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		mockSyncRepo := new(repomocks.IntranetSyncStorer)
+		mockIntranetClient := new(clientmocks.IntranetClient)
+		svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, IntranetSyncDeps: mockSyncRepo, IntranetClient: mockIntranetClient})
+
+		mockSyncRepo.On("GetSyncWatermark", mock.Anything, constants.SyncStateIntranetEmployees).Return(watermark, nil).Once()
+		mockProfileRepo.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{alice}, nil).Once()
+		// alice is missing from the changes, which does not make her a leaver
+		mockIntranetClient.On("ListEmployees", mock.Anything, specs.ListIntranetEmployeesOptions{UpdatedSince: &watermark}, mock.Anything).Return(nil).Once()
+		mockSyncRepo.On("SetSyncWatermark", mock.Anything, constants.SyncStateIntranetEmployees, mock.MatchedBy(func(at time.Time) bool {
+			return at.After(watermark)
+		})).Return(nil).Once()
+
+		report, err := svc.SyncEmployees(context.Background(), specs.SyncEmployeesOptions{Incremental: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, &watermark, report.UpdatedSince)
+		assert.Equal(t, 0, report.Left)
+		assert.Empty(t, report.LeaverCheckSkipped)
+		mockProfileRepo.AssertExpectations(t)
+		mockSyncRepo.AssertExpectations(t)
+		mockIntranetClient.AssertExpectations(t)
+	})
+
+	t.Run("Fetches_everyone_without_a_watermark", func(t *testing.T) {
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		mockSyncRepo := new(repomocks.IntranetSyncStorer)
+		mockIntranetClient := new(clientmocks.IntranetClient)
+		svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, IntranetSyncDeps: mockSyncRepo, IntranetClient: mockIntranetClient})
+
+		mockSyncRepo.On("GetSyncWatermark", mock.Anything, constants.SyncStateIntranetEmployees).Return(time.Time{}, pkgerrors.ErrNoRecordFound).Once()
+		mockProfileRepo.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
+		expectIntranetEmployees(mockIntranetClient, []specs.IntranetEmployee{{EmployeeID: "EMP009", Email: "new@example.com"}})
+		mockProfileRepo.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+		mockProfileRepo.On("GetProfileIDByEmail", mock.Anything, "new@example.com", nil).Return(0, pkgerrors.ErrNoRecordFound).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockSyncRepo.On("SetSyncWatermark", mock.Anything, constants.SyncStateIntranetEmployees, mock.Anything).Return(nil).Once()
+
+		report, err := svc.SyncEmployees(context.Background(), specs.SyncEmployeesOptions{Incremental: true})
+
+		assert.NoError(t, err)
+		assert.Nil(t, report.UpdatedSince)
+		mockSyncRepo.AssertExpectations(t)
+		mockIntranetClient.AssertExpectations(t)
+	})
+
+	t.Run("Keeps_the_watermark_when_leavers_were_not_looked_for", func(t *testing.T) {
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		mockSyncRepo := new(repomocks.IntranetSyncStorer)
+		mockIntranetClient := new(clientmocks.IntranetClient)
+		svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, IntranetSyncDeps: mockSyncRepo, IntranetClient: mockIntranetClient})
+
+		mockSyncRepo.On("GetSyncWatermark", mock.Anything, constants.SyncStateIntranetEmployees).Return(time.Time{}, pkgerrors.ErrNoRecordFound).Once()
+		mockProfileRepo.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{alice}, nil).Once()
+		// an intranet that lists no current employees is not trusted to say who has left
+		expectIntranetEmployees(mockIntranetClient, []specs.IntranetEmployee{})
+
+		report, err := svc.SyncEmployees(context.Background(), specs.SyncEmployeesOptions{Incremental: true})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, report.LeaverCheckSkipped)
+		mockSyncRepo.AssertNotCalled(t, "SetSyncWatermark", mock.Anything, mock.Anything, mock.Anything)
+		mockSyncRepo.AssertExpectations(t)
+	})
+
+	t.Run("Keeps_the_watermark_after_duplicate_employee_ids", func(t *testing.T) {
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		mockSyncRepo := new(repomocks.IntranetSyncStorer)
+		mockIntranetClient := new(clientmocks.IntranetClient)
+		svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, IntranetSyncDeps: mockSyncRepo, IntranetClient: mockIntranetClient})
+
+		mockSyncRepo.On("GetSyncWatermark", mock.Anything, constants.SyncStateIntranetEmployees).Return(watermark, nil).Once()
+		mockProfileRepo.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
+		mockIntranetClient.On("ListEmployees", mock.Anything, specs.ListIntranetEmployeesOptions{UpdatedSince: &watermark}, mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(2).(func(page []specs.IntranetEmployee) error)([]specs.IntranetEmployee{
+				{EmployeeID: "EMP009", Email: "new@example.com"},
+				{EmployeeID: "EMP009", Email: "other@example.com"},
+			})
+		}).Return(nil).Once()
+		mockProfileRepo.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+		mockProfileRepo.On("GetProfileIDByEmail", mock.Anything, "new@example.com", nil).Return(0, pkgerrors.ErrNoRecordFound).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		report, err := svc.SyncEmployees(context.Background(), specs.SyncEmployeesOptions{Incremental: true})
+
+		assert.NoError(t, err)
+		assert.True(t, report.HasDuplicateEmployeeIDs())
+		assert.False(t, report.HasErrors())
+		mockSyncRepo.AssertNotCalled(t, "SetSyncWatermark", mock.Anything, mock.Anything, mock.Anything)
+		mockProfileRepo.AssertExpectations(t)
+	})
+
+	t.Run("Keeps_the_watermark_after_errors", func(t *testing.T) {
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		mockSyncRepo := new(repomocks.IntranetSyncStorer)
+		mockIntranetClient := new(clientmocks.IntranetClient)
+		svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, IntranetSyncDeps: mockSyncRepo, IntranetClient: mockIntranetClient})
+
+		dbErr := errors.New("db error")
+		mockSyncRepo.On("GetSyncWatermark", mock.Anything, constants.SyncStateIntranetEmployees).Return(watermark, nil).Once()
+		mockProfileRepo.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{alice}, nil).Once()
+		mockIntranetClient.On("ListEmployees", mock.Anything, specs.ListIntranetEmployeesOptions{UpdatedSince: &watermark}, mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(2).(func(page []specs.IntranetEmployee) error)([]specs.IntranetEmployee{{EmployeeID: "EMP001", Email: "alice@example.com"}})
+		}).Return(nil).Once()
+		mockProfileRepo.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+		mockProfileRepo.On("GetProfileIDByEmail", mock.Anything, "alice@example.com", nil).Return(0, dbErr).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, dbErr).Return(nil).Once()
+
+		report, err := svc.SyncEmployees(context.Background(), specs.SyncEmployeesOptions{Incremental: true})
+
+		assert.NoError(t, err)
+		assert.True(t, report.HasErrors())
+		mockSyncRepo.AssertNotCalled(t, "SetSyncWatermark", mock.Anything, mock.Anything, mock.Anything)
+		mockProfileRepo.AssertExpectations(t)
+	})
+}
+
+func TestRunEmployeeSync(t *testing.T) {
+	t.Run("Records_the_outcome_of_the_run", func(t *testing.T) {
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		mockSyncRepo := new(repomocks.IntranetSyncStorer)
+		mockIntranetClient := new(clientmocks.IntranetClient)
+		svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, IntranetSyncDeps: mockSyncRepo, IntranetClient: mockIntranetClient})
+
+		mockSyncRepo.On("FailStaleSyncRuns", mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil).Once()
+		mockSyncRepo.On("CreateSyncRun", mock.Anything, mock.MatchedBy(func(run repository.SyncRunRepo) bool {
+			return run.Trigger == constants.SyncTriggerManual && *run.TriggeredByID == 7 && !run.Incremental
+		})).Return(int64(3), nil).Once()
+		mockProfileRepo.On("ListProfileEmployments", mock.Anything).Return([]specs.ProfileEmployment{}, nil).Once()
+		expectIntranetEmployees(mockIntranetClient, []specs.IntranetEmployee{{EmployeeID: "EMP009", Email: "new@example.com"}})
+		mockProfileRepo.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+		mockProfileRepo.On("GetProfileIDByEmail", mock.Anything, "new@example.com", nil).Return(0, pkgerrors.ErrNoRecordFound).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockSyncRepo.On("SetSyncWatermark", mock.Anything, constants.SyncStateIntranetEmployees, mock.Anything).Return(nil).Once()
+		mockSyncRepo.On("FinishSyncRun", mock.Anything, int64(3), mock.MatchedBy(func(result repository.SyncRunResultRepo) bool {
+			return result.Status == constants.SyncRunSucceeded && result.Skipped == 1 && result.Error == ""
+		})).Return(nil).Once()
+
+		run, report, err := svc.RunEmployeeSync(context.Background(), constants.SyncTriggerManual, 7, specs.SyncEmployeesOptions{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), run.ID)
+		assert.Equal(t, constants.SyncRunSucceeded, run.Status)
+		assert.NotNil(t, run.FinishedAt)
+		assert.Equal(t, 1, report.Skipped)
+		mockProfileRepo.AssertExpectations(t)
+		mockSyncRepo.AssertExpectations(t)
+		mockIntranetClient.AssertExpectations(t)
+	})
+
+	t.Run("Records_a_failed_sync", func(t *testing.T) {
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		mockSyncRepo := new(repomocks.IntranetSyncStorer)
+		mockNotificationRepo := new(repomocks.NotificationStorer)
+		svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, IntranetSyncDeps: mockSyncRepo, NotificationDeps: mockNotificationRepo})
+
+		dbErr := errors.New("db error")
+		mockSyncRepo.On("FailStaleSyncRuns", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil).Once()
+		mockSyncRepo.On("CreateSyncRun", mock.Anything, mock.Anything).Return(int64(4), nil).Once()
+		mockProfileRepo.On("ListProfileEmployments", mock.Anything).Return(nil, dbErr).Once()
+		mockSyncRepo.On("FinishSyncRun", mock.Anything, int64(4), mock.MatchedBy(func(result repository.SyncRunResultRepo) bool {
+			return result.Status == constants.SyncRunFailed && result.Error == "db error"
+		})).Return(nil).Once()
+		mockProfileRepo.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+		mockNotificationRepo.On("CreateNotifications", mock.Anything, repository.NotificationRepo{
+			Permission: constants.PermIntranetSync,
+			Kind:       constants.NotificationIntranetSync,
+			Title:      "Intranet sync failed",
+			Body:       "Sync run 4 failed: db error",
+		}, nil).Return([]specs.Notification{{ID: 1, UserID: 2}}, nil).Once()
+		mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+
+		run, _, err := svc.RunEmployeeSync(context.Background(), constants.SyncTriggerSchedule, 0, specs.SyncEmployeesOptions{})
+
+		assert.Equal(t, dbErr, err)
+		assert.Equal(t, constants.SyncRunFailed, run.Status)
+		assert.Nil(t, run.TriggeredByID)
+		mockSyncRepo.AssertExpectations(t)
+		mockNotificationRepo.AssertExpectations(t)
+	})
+
+	t.Run("Does_not_sync_while_another_run_is_running", func(t *testing.T) {
+		mockSyncRepo := new(repomocks.IntranetSyncStorer)
+		mockIntranetClient := new(clientmocks.IntranetClient)
+		svc := service.NewServices(service.RepoDeps{IntranetSyncDeps: mockSyncRepo, IntranetClient: mockIntranetClient})
+
+		mockSyncRepo.On("FailStaleSyncRuns", mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil).Once()
+		mockSyncRepo.On("CreateSyncRun", mock.Anything, mock.Anything).Return(int64(0), pkgerrors.ErrSyncInProgress).Once()
+
+		_, _, err := svc.RunEmployeeSync(context.Background(), constants.SyncTriggerSchedule, 0, specs.SyncEmployeesOptions{Incremental: true})
+
+		assert.Equal(t, pkgerrors.ErrSyncInProgress, err)
+		mockSyncRepo.AssertNotCalled(t, "FinishSyncRun", mock.Anything, mock.Anything, mock.Anything)
+		mockIntranetClient.AssertNotCalled(t, "ListEmployees", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestStartEmployeeSync(t *testing.T) {
+	t.Run("Fails_the_run_when_the_sync_panics", func(t *testing.T) {
+		mockProfileRepo := new(repomocks.ProfileStorer)
+		mockSyncRepo := new(repomocks.IntranetSyncStorer)
+		svc := service.NewServices(service.RepoDeps{ProfileDeps: mockProfileRepo, IntranetSyncDeps: mockSyncRepo})
+
+		finished := make(chan repository.SyncRunResultRepo, 1)
+		mockSyncRepo.On("FailStaleSyncRuns", mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil).Once()
+		mockSyncRepo.On("CreateSyncRun", mock.Anything, mock.Anything).Return(int64(5), nil).Once()
+		mockProfileRepo.On("ListProfileEmployments", mock.Anything).Run(func(args mock.Arguments) {
+			panic("boom")
+		}).Return(nil, nil).Once()
+		mockSyncRepo.On("FinishSyncRun", mock.Anything, int64(5), mock.Anything).Run(func(args mock.Arguments) {
+			finished <- args.Get(2).(repository.SyncRunResultRepo)
+		}).Return(nil).Once()
+
+		run, err := svc.StartEmployeeSync(context.Background(), constants.SyncTriggerManual, 7, specs.SyncEmployeesOptions{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.SyncRunRunning, run.Status)
+		select {
+		case result := <-finished:
+			assert.Equal(t, constants.SyncRunFailed, result.Status)
+			assert.Equal(t, "panic: boom", result.Error)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Expected the run to be failed after the panic")
+		}
+	})
+}
+
+func TestListSyncRuns(t *testing.T) {
+	mockSyncRepo := new(repomocks.IntranetSyncStorer)
+	svc := service.NewServices(service.RepoDeps{IntranetSyncDeps: mockSyncRepo})
+
+	runs := []specs.SyncRun{{ID: 2, Trigger: constants.SyncTriggerSchedule, Status: constants.SyncRunSucceeded, Updated: 3}}
+	mockSyncRepo.On("ListSyncRuns", mock.Anything, 20).Return(runs, nil).Once()
+
+	resp, err := svc.ListSyncRuns(context.Background(), 20)
+
+	assert.NoError(t, err)
+	assert.Equal(t, runs, resp.Runs)
+	mockSyncRepo.AssertExpectations(t)
+}
@@ -29,8 +29,14 @@ type ServiceTestSuite struct {
 	emailRepo    *mocks.EmailStorer
 	loginRepo    *mocks.UserStorer
 	profileRepo  *mocks.ProfileStorer
+	outboxRepo   *mocks.EmailOutboxStorer
+	templateRepo *mocks.EmailTemplateStorer
+	notifyRepo   *mocks.NotificationStorer
 }
 
+// testEmailTemplate is the template every email is rendered with in the suite
+var testEmailTemplate = specs.EmailTemplate{Version: 1, Subject: "Hello {{.Name}}", HTMLBody: "<p>{{.ProfileLink}}</p>", TextBody: "{{.ProfileLink}}"}
+
 func TestServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(ServiceTestSuite))
 }
@@ -39,10 +45,18 @@ func (s *ServiceTestSuite) SetupTest() {
 	s.emailRepo = &mocks.EmailStorer{}
 	s.profileRepo = &mocks.ProfileStorer{}
 	s.loginRepo = &mocks.UserStorer{}
+	s.outboxRepo = &mocks.EmailOutboxStorer{}
+	s.outboxRepo.On("ClaimOutboxEmail", mock.Anything, mock.Anything, mock.Anything).Return(specs.OutboxEmail{}, errs.ErrNoRecordFound).Maybe()
+	s.templateRepo = &mocks.EmailTemplateStorer{}
+	s.templateRepo.On("GetEmailTemplate", mock.Anything, mock.Anything).Return(testEmailTemplate, nil).Maybe()
+	s.notifyRepo = &mocks.NotificationStorer{}
 	s.emailService = service.NewServices(service.RepoDeps{
-		UserEmailDeps: s.emailRepo,
-		UserLoginDeps: s.loginRepo,
-		ProfileDeps:   s.profileRepo,
+		UserEmailDeps:     s.emailRepo,
+		UserLoginDeps:     s.loginRepo,
+		ProfileDeps:       s.profileRepo,
+		EmailOutboxDeps:   s.outboxRepo,
+		EmailTemplateDeps: s.templateRepo,
+		NotificationDeps:  s.notifyRepo,
 	})
 }
 
@@ -50,6 +64,8 @@ func (s *ServiceTestSuite) TearDownSuite() {
 	s.emailRepo.AssertExpectations(s.T())
 	s.profileRepo.AssertExpectations(s.T())
 	s.loginRepo.AssertExpectations(s.T())
+	s.outboxRepo.AssertExpectations(s.T())
+	s.notifyRepo.AssertExpectations(s.T())
 }
 
 func (s *ServiceTestSuite) TestSendUserInvitation() {
@@ -96,6 +112,13 @@ func (s *ServiceTestSuite) TestSendUserInvitation() {
 		UpdatedByID:     UserID,
 	}
 
+	// the invitation expires after the default 14 days
+	matchInvitation := mock.MatchedBy(func(invitation repository.Invitations) bool {
+		expiry := time.Until(invitation.ExpiresAt)
+		invitation.ExpiresAt = time.Time{}
+		return invitation == mockInvitationRequest && expiry > 14*24*time.Hour-time.Minute && expiry <= 14*24*time.Hour
+	})
+
 	tests := []struct {
 		name    string
 		args    args
@@ -112,17 +135,19 @@ func (s *ServiceTestSuite) TestSendUserInvitation() {
 			},
 			wantErr: false,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
-				s.profileRepo.On("GetProfile", args.ctx, args.profileID, mock.Anything).Return(mockResponseProfile, nil).Once()
-
-				patch, _ := mpatch.PatchMethod(helpers.SendUserInvitation, func(email, name string, profileID int) error {
-					return nil
-				})
-				defer patch.Unpatch()
-
-				s.emailRepo.On("CreateInvitation", args.ctx, mockInvitationRequest, mock.Anything).Return(nil).Once()
-				s.loginRepo.On("CreateUser", args.ctx, mockResponseProfile.Name, mockResponseProfile.Email, constants.Employee, mock.Anything).Return(nil).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, nil).Return(nil).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
+				s.profileRepo.On("GetProfile", mock.Anything, args.profileID, mock.Anything).Return(mockResponseProfile, nil).Once()
+
+				s.outboxRepo.On("QueueEmail", mock.Anything, mock.MatchedBy(func(email repository.OutboxEmailRepo) bool {
+					return email.Kind == constants.EmailKindEmployeeInvitation && email.Recipient == mockResponseProfile.Email && email.Subject == "Hello "+mockResponseProfile.Name
+				}), mock.Anything).Return(int64(1), nil).Once()
+
+				s.emailRepo.On("CreateInvitation", mock.Anything, matchInvitation, mock.Anything).Return(nil).Once()
+				s.loginRepo.On("CreateUser", mock.Anything, mockResponseProfile.Name, mockResponseProfile.Email, constants.Employee, mock.Anything).Return(nil).Once()
+				s.notifyRepo.On("CreateNotifications", mock.Anything, mock.MatchedBy(func(notification repository.NotificationRepo) bool {
+					return notification.Kind == constants.NotificationInvitationSent && notification.Email == mockResponseProfile.Email && *notification.ProfileID == args.profileID
+				}), mock.Anything).Return([]specs.Notification{}, nil).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
 			},
 		},
 		// NEGATIVE || failed to get profile
@@ -135,9 +160,28 @@ func (s *ServiceTestSuite) TestSendUserInvitation() {
 			},
 			wantErr: true,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
-				s.profileRepo.On("GetProfile", args.ctx, args.profileID, mock.Anything).Return(mockResponseProfile, args.err).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, args.err).Return(args.err).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
+				s.profileRepo.On("GetProfile", mock.Anything, args.profileID, mock.Anything).Return(mockResponseProfile, args.err).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, args.err).Return(args.err).Once()
+			},
+		},
+
+		// NEGATIVE || failed to queue email
+		{
+			name: "Failed to queue email",
+			args: args{
+				ctx:       context.Background(),
+				profileID: ProfileID,
+				err:       errors.New("failed to queue email"),
+			},
+			wantErr: true,
+			prepare: func(args args) {
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
+				s.profileRepo.On("GetProfile", mock.Anything, args.profileID, mock.Anything).Return(mockResponseProfile, nil).Once()
+				s.emailRepo.On("CreateInvitation", mock.Anything, matchInvitation, mock.Anything).Return(nil).Once()
+				s.loginRepo.On("CreateUser", mock.Anything, mockResponseProfile.Name, mockResponseProfile.Email, constants.Employee, mock.Anything).Return(nil).Once()
+				s.outboxRepo.On("QueueEmail", mock.Anything, mock.Anything, mock.Anything).Return(int64(0), args.err).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, args.err).Return(args.err).Once()
 			},
 		},
 
@@ -151,16 +195,11 @@ func (s *ServiceTestSuite) TestSendUserInvitation() {
 			},
 			wantErr: true,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
-				s.profileRepo.On("GetProfile", args.ctx, args.profileID, mock.Anything).Return(mockResponseProfile, nil).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
+				s.profileRepo.On("GetProfile", mock.Anything, args.profileID, mock.Anything).Return(mockResponseProfile, nil).Once()
 
-				patch, _ := mpatch.PatchMethod(helpers.SendUserInvitation, func(email, name string, profileID int) error {
-					return nil
-				})
-				defer patch.Unpatch()
-
-				s.emailRepo.On("CreateInvitation", args.ctx, mockInvitationRequest, mock.Anything).Return(args.err).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, args.err).Return(args.err).Once()
+				s.emailRepo.On("CreateInvitation", mock.Anything, matchInvitation, mock.Anything).Return(args.err).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, args.err).Return(args.err).Once()
 			},
 		},
 
@@ -174,17 +213,26 @@ func (s *ServiceTestSuite) TestSendUserInvitation() {
 			},
 			wantErr: true,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
-				s.profileRepo.On("GetProfile", args.ctx, args.profileID, mock.Anything).Return(mockResponseProfile, nil).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
+				s.profileRepo.On("GetProfile", mock.Anything, args.profileID, mock.Anything).Return(mockResponseProfile, nil).Once()
 
-				patch, _ := mpatch.PatchMethod(helpers.SendUserInvitation, func(email, name string, profileID int) error {
-					return nil
-				})
-				defer patch.Unpatch()
+				s.emailRepo.On("CreateInvitation", mock.Anything, matchInvitation, mock.Anything).Return(nil).Once()
+				s.loginRepo.On("CreateUser", mock.Anything, mockResponseProfile.Name, mockResponseProfile.Email, constants.Employee, mock.Anything).Return(args.err).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, args.err).Return(args.err).Once()
+			},
+		},
 
-				s.emailRepo.On("CreateInvitation", args.ctx, mockInvitationRequest, mock.Anything).Return(nil).Once()
-				s.loginRepo.On("CreateUser", args.ctx, mockResponseProfile.Name, mockResponseProfile.Email, constants.Employee, mock.Anything).Return(args.err).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, args.err).Return(args.err).Once()
+		// NEGATIVE || failed to begin transaction
+		{
+			name: "Failed to begin transaction",
+			args: args{
+				ctx:       context.Background(),
+				profileID: ProfileID,
+				err:       errors.New("failed to set audit context"),
+			},
+			wantErr: true,
+			prepare: func(args args) {
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(nil, args.err).Once()
 			},
 		},
 	}
@@ -260,17 +308,24 @@ func (s *ServiceTestSuite) TestUpdateInvitation() {
 			},
 			wantErr: false,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
-				s.emailRepo.On("GetInvitations", args.ctx, mockRequest, mock.Anything).Return(mockInvitationRequest, nil).Once()
-				s.loginRepo.On(("GetUserInfo"), args.ctx, mockUserInfoFilter).Return(mockAdminInfo, nil).Once()
-				patch, _ := mpatch.PatchMethod(helpers.SendAdminInvitation, func(email, name string, profileID int) error {
-					return nil
-				})
-				defer patch.Unpatch()
-				s.emailRepo.On("UpdateProfileCompleteStatus", args.ctx, args.profileID, mockUpdateRequest, mock.Anything).Return(nil).Once()
-				s.profileRepo.On("GetProfile", args.ctx, args.profileID, mock.Anything).Return(mockResponseProfile, nil).Once()
-				s.loginRepo.On("RemoveUser", args.ctx, mockResponseProfile.Email, mock.Anything).Return(nil).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, nil).Return(nil).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
+				s.emailRepo.On("GetInvitations", mock.Anything, mockRequest, mock.Anything).Return(mockInvitationRequest, nil).Once()
+				s.loginRepo.On(("GetUserInfo"), mock.Anything, mockUserInfoFilter).Return(mockAdminInfo, nil).Once()
+				s.outboxRepo.On("QueueEmail", mock.Anything, mock.MatchedBy(func(email repository.OutboxEmailRepo) bool {
+					return email.Kind == constants.EmailKindProfileCompleted && email.Recipient == mockAdminInfo.Email && email.TextBody == helpers.ProfileLink(args.profileID)
+				}), mock.Anything).Return(int64(1), nil).Once()
+				s.emailRepo.On("UpdateProfileCompleteStatus", mock.Anything, args.profileID, mockUpdateRequest, mock.Anything).Return(nil).Once()
+				s.profileRepo.On("GetProfile", mock.Anything, args.profileID, mock.Anything).Return(mockResponseProfile, nil).Once()
+				s.loginRepo.On("RemoveUser", mock.Anything, mockResponseProfile.Email, mock.Anything).Return(nil).Once()
+				s.notifyRepo.On("CreateNotifications", mock.Anything, repository.NotificationRepo{
+					UserID:    UserID,
+					Kind:      constants.NotificationProfileSubmitted,
+					Title:     mockResponseProfile.Name + " submitted their profile",
+					Body:      "The profile of " + mockResponseProfile.Name + " is complete and ready to review.",
+					Link:      helpers.ProfileLink(args.profileID),
+					ProfileID: &args.profileID,
+				}, mock.Anything).Return([]specs.Notification{{ID: 3, UserID: UserID}}, nil).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
 			},
 		},
 
@@ -284,9 +339,9 @@ func (s *ServiceTestSuite) TestUpdateInvitation() {
 			},
 			wantErr: true,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
-				s.emailRepo.On("GetInvitations", args.ctx, mockRequest, mock.Anything).Return(mockInvitationRequest, args.err).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, args.err).Return(args.err).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
+				s.emailRepo.On("GetInvitations", mock.Anything, mockRequest, mock.Anything).Return(mockInvitationRequest, args.err).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, args.err).Return(args.err).Once()
 			},
 		},
 		{
@@ -298,10 +353,10 @@ func (s *ServiceTestSuite) TestUpdateInvitation() {
 			},
 			wantErr: true,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
-				s.emailRepo.On("GetInvitations", args.ctx, mockRequest, mock.Anything).Return(mockInvitationRequest, nil).Once()
-				s.loginRepo.On(("GetUserInfo"), args.ctx, mockUserInfoFilter).Return(mockAdminInfo, args.err).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, args.err).Return(args.err).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
+				s.emailRepo.On("GetInvitations", mock.Anything, mockRequest, mock.Anything).Return(mockInvitationRequest, nil).Once()
+				s.loginRepo.On(("GetUserInfo"), mock.Anything, mockUserInfoFilter).Return(mockAdminInfo, args.err).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, args.err).Return(args.err).Once()
 			},
 		},
 
@@ -315,15 +370,11 @@ func (s *ServiceTestSuite) TestUpdateInvitation() {
 			},
 			wantErr: true,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
-				s.emailRepo.On("GetInvitations", args.ctx, mockRequest, mock.Anything).Return(mockInvitationRequest, nil).Once()
-				s.loginRepo.On(("GetUserInfo"), args.ctx, mockUserInfoFilter).Return(mockAdminInfo, nil).Once()
-				patch, _ := mpatch.PatchMethod(helpers.SendAdminInvitation, func(email string, profileID int) error {
-					return nil
-				})
-				defer patch.Unpatch()
-				s.emailRepo.On("UpdateProfileCompleteStatus", args.ctx, args.profileID, mockUpdateRequest, mock.Anything).Return(args.err).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, args.err).Return(args.err).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
+				s.emailRepo.On("GetInvitations", mock.Anything, mockRequest, mock.Anything).Return(mockInvitationRequest, nil).Once()
+				s.loginRepo.On(("GetUserInfo"), mock.Anything, mockUserInfoFilter).Return(mockAdminInfo, nil).Once()
+				s.emailRepo.On("UpdateProfileCompleteStatus", mock.Anything, args.profileID, mockUpdateRequest, mock.Anything).Return(args.err).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, args.err).Return(args.err).Once()
 			},
 		},
 
@@ -337,16 +388,12 @@ func (s *ServiceTestSuite) TestUpdateInvitation() {
 			},
 			wantErr: true,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
-				s.emailRepo.On("GetInvitations", args.ctx, mockRequest, mock.Anything).Return(mockInvitationRequest, nil).Once()
-				s.loginRepo.On(("GetUserInfo"), args.ctx, mockUserInfoFilter).Return(mockAdminInfo, nil).Once()
-				patch, _ := mpatch.PatchMethod(helpers.SendAdminInvitation, func(email, name string, profileID int) error {
-					return nil
-				})
-				defer patch.Unpatch()
-				s.emailRepo.On("UpdateProfileCompleteStatus", args.ctx, args.profileID, mockUpdateRequest, mock.Anything).Return(nil).Once()
-				s.profileRepo.On("GetProfile", args.ctx, args.profileID, mock.Anything).Return(mockResponseProfile, args.err).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, args.err).Return(args.err).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
+				s.emailRepo.On("GetInvitations", mock.Anything, mockRequest, mock.Anything).Return(mockInvitationRequest, nil).Once()
+				s.loginRepo.On(("GetUserInfo"), mock.Anything, mockUserInfoFilter).Return(mockAdminInfo, nil).Once()
+				s.emailRepo.On("UpdateProfileCompleteStatus", mock.Anything, args.profileID, mockUpdateRequest, mock.Anything).Return(nil).Once()
+				s.profileRepo.On("GetProfile", mock.Anything, args.profileID, mock.Anything).Return(mockResponseProfile, args.err).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, args.err).Return(args.err).Once()
 			},
 		},
 
@@ -360,17 +407,13 @@ func (s *ServiceTestSuite) TestUpdateInvitation() {
 			},
 			wantErr: true,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
-				s.emailRepo.On("GetInvitations", args.ctx, mockRequest, mock.Anything).Return(mockInvitationRequest, nil).Once()
-				s.loginRepo.On(("GetUserInfo"), args.ctx, mockUserInfoFilter).Return(mockAdminInfo, nil).Once()
-				patch, _ := mpatch.PatchMethod(helpers.SendAdminInvitation, func(email, name string, profileID int) error {
-					return nil
-				})
-				defer patch.Unpatch()
-				s.emailRepo.On("UpdateProfileCompleteStatus", args.ctx, args.profileID, mockUpdateRequest, mock.Anything).Return(nil).Once()
-				s.profileRepo.On("GetProfile", args.ctx, args.profileID, mock.Anything).Return(mockResponseProfile, nil).Once()
-				s.loginRepo.On("RemoveUser", args.ctx, mockResponseProfile.Email, mock.Anything).Return(args.err).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, args.err).Return(args.err).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
+				s.emailRepo.On("GetInvitations", mock.Anything, mockRequest, mock.Anything).Return(mockInvitationRequest, nil).Once()
+				s.loginRepo.On(("GetUserInfo"), mock.Anything, mockUserInfoFilter).Return(mockAdminInfo, nil).Once()
+				s.emailRepo.On("UpdateProfileCompleteStatus", mock.Anything, args.profileID, mockUpdateRequest, mock.Anything).Return(nil).Once()
+				s.profileRepo.On("GetProfile", mock.Anything, args.profileID, mock.Anything).Return(mockResponseProfile, nil).Once()
+				s.loginRepo.On("RemoveUser", mock.Anything, mockResponseProfile.Email, mock.Anything).Return(args.err).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, args.err).Return(args.err).Once()
 			},
 		},
 	}
@@ -411,19 +454,18 @@ func (s *ServiceTestSuite) TestInviteAdmin() {
 			},
 			wantErr: false,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
 
 				// Mock GetUserInfo to simulate no existing user
 				filter := specs.UserInfoFilter{Email: args.req.Email}
-				s.loginRepo.On("GetUserInfo", args.ctx, filter).Return(repository.User{}, errs.ErrNoRecordFound).Once()
+				s.loginRepo.On("GetUserInfo", mock.Anything, filter).Return(repository.User{}, errs.ErrNoRecordFound).Once()
 
-				patch, _ := mpatch.PatchMethod(helpers.SendAdminWelcomeInvitation, func(email, name string) error {
-					return nil
-				})
-				defer patch.Unpatch()
+				s.outboxRepo.On("QueueEmail", mock.Anything, mock.MatchedBy(func(email repository.OutboxEmailRepo) bool {
+					return email.Kind == constants.EmailKindAdminInvitation && email.Recipient == args.req.Email && email.Subject == "Hello "+args.req.Name
+				}), mock.Anything).Return(int64(1), nil).Once()
 
-				s.loginRepo.On("CreateUser", args.ctx, args.req.Name, args.req.Email, constants.Admin, mock.Anything).Return(nil).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, nil).Return(nil).Once()
+				s.loginRepo.On("CreateUser", mock.Anything, args.req.Name, args.req.Email, constants.Admin, mock.Anything).Return(nil).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
 			},
 		},
 		// NEGATIVE || Failed duplicate email
@@ -439,13 +481,13 @@ func (s *ServiceTestSuite) TestInviteAdmin() {
 			},
 			wantErr: true,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
 
 				// Mock GetUserInfo to simulate existing user
 				filter := specs.UserInfoFilter{Email: args.req.Email}
-				s.loginRepo.On("GetUserInfo", args.ctx, filter).Return(repository.User{ID: 1}, nil).Once()
+				s.loginRepo.On("GetUserInfo", mock.Anything, filter).Return(repository.User{ID: 1}, nil).Once()
 
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, errs.ErrDuplicateKey).Return(errs.ErrDuplicateKey).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, errs.ErrDuplicateKey).Return(errs.ErrDuplicateKey).Once()
 			},
 		},
 		// NEGATIVE || Failed to create user
@@ -461,18 +503,13 @@ func (s *ServiceTestSuite) TestInviteAdmin() {
 			},
 			wantErr: true,
 			prepare: func(args args) {
-				s.profileRepo.On("BeginTransaction", args.ctx).Return(mockTx, nil).Once()
+				s.profileRepo.On("BeginTransaction", mock.Anything).Return(mockTx, nil).Once()
 
 				filter := specs.UserInfoFilter{Email: args.req.Email}
-				s.loginRepo.On("GetUserInfo", args.ctx, filter).Return(repository.User{}, errs.ErrNoRecordFound).Once()
-
-				patch, _ := mpatch.PatchMethod(helpers.SendAdminWelcomeInvitation, func(email, name string) error {
-					return nil
-				})
-				defer patch.Unpatch()
+				s.loginRepo.On("GetUserInfo", mock.Anything, filter).Return(repository.User{}, errs.ErrNoRecordFound).Once()
 
-				s.loginRepo.On("CreateUser", args.ctx, args.req.Name, args.req.Email, constants.Admin, mock.Anything).Return(errors.New("db error")).Once()
-				s.profileRepo.On("HandleTransaction", args.ctx, mock.Anything, errors.New("db error")).Return(errors.New("db error")).Once()
+				s.loginRepo.On("CreateUser", mock.Anything, args.req.Name, args.req.Email, constants.Admin, mock.Anything).Return(errors.New("db error")).Once()
+				s.profileRepo.On("HandleTransaction", mock.Anything, mock.Anything, errors.New("db error")).Return(errors.New("db error")).Once()
 			},
 		},
 	}
@@ -29,20 +29,32 @@ var (
 func TestUserLogin(t *testing.T) {
 	mockUserLogin := new(mocks.UserStorer)
 	mockProfileRepo := new(mocks.ProfileStorer)
+	mockAuditRepo := new(mocks.AuditStorer)
+	mockEmailRepo := new(mocks.EmailStorer)
 	var repodeps = service.RepoDeps{
 		ProfileDeps:   mockProfileRepo,
 		UserLoginDeps: mockUserLogin,
+		AuditDeps:     mockAuditRepo,
+		UserEmailDeps: mockEmailRepo,
 	}
 	userLoginService := service.NewServices(repodeps)
 
 	mockAdminInfo := repository.User{
-		ID:    1,
-		Email: TestAdminEmail,
-		Role:  constants.Admin,
+		ID:       1,
+		Email:    TestAdminEmail,
+		Role:     constants.Admin,
+		IsActive: true,
 	}
 
 	mockEmployeeInfo := repository.User{
-		ID:    2,
+		ID:       2,
+		Email:    TestEmployeeEmail,
+		Role:     constants.Employee,
+		IsActive: true,
+	}
+
+	mockInactiveInfo := repository.User{
+		ID:    3,
 		Email: TestEmployeeEmail,
 		Role:  constants.Employee,
 	}
@@ -77,6 +89,10 @@ func TestUserLogin(t *testing.T) {
 			MockSetup: func(mockUserStorer *mocks.UserStorer, profileMock *mocks.ProfileStorer, email string, role string) {
 				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
 				mockUserStorer.On("GetUserInfo", mock.Anything, specs.UserInfoFilter{Email: email}).Return(mockAdminInfo, nil).Once()
+				mockUserStorer.On("UpdateLastLogin", mock.Anything, int64(1), mock.Anything, mock.Anything).Return(nil).Once()
+				mockAuditRepo.On("CreateAuditEvent", mock.Anything, mock.MatchedBy(func(event repository.AuditEventRepo) bool {
+					return event.ActorID == 1 && event.Action == constants.AuditActionLogin
+				}), mock.Anything).Return(nil).Once()
 				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
 			},
 			MockTokenFunc: func(userID int64, profileID int, email string, role string) (string, error) {
@@ -93,6 +109,11 @@ func TestUserLogin(t *testing.T) {
 				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
 				mockUserStorer.On("GetUserInfo", mock.Anything, specs.UserInfoFilter{Email: email}).Return(mockEmployeeInfo, nil).Once()
 				profileMock.On("GetProfileIDByEmail", mock.Anything, email, mock.Anything).Return(2, nil).Once()
+				mockEmailRepo.On("GetPendingInvitation", mock.Anything, 2, mock.Anything).Return(specs.PendingInvitation{ProfileID: 2}, nil).Once()
+				mockUserStorer.On("UpdateLastLogin", mock.Anything, int64(2), mock.Anything, mock.Anything).Return(nil).Once()
+				mockAuditRepo.On("CreateAuditEvent", mock.Anything, mock.MatchedBy(func(event repository.AuditEventRepo) bool {
+					return event.ActorID == 2 && event.Action == constants.AuditActionLogin
+				}), mock.Anything).Return(nil).Once()
 				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
 			},
 			MockTokenFunc: func(userID int64, profileID int, role, email string) (string, error) {
@@ -113,6 +134,33 @@ func TestUserLogin(t *testing.T) {
 			ExpectedResponse: specs.LoginResponse{},
 			ExpectedError:    errors.New("repository error"),
 		},
+		{
+			Name:  "failed_for_deactivated_user",
+			Email: TestEmployeeEmail,
+			MockSetup: func(mockUserStorer *mocks.UserStorer, profileMock *mocks.ProfileStorer, email, role string) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				mockUserStorer.On("GetUserInfo", mock.Anything, specs.UserInfoFilter{Email: email}).Return(mockInactiveInfo, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, errs.ErrUserInactive).Return(errs.ErrUserInactive).Once()
+			},
+			MockTokenFunc:    nil,
+			ExpectedResponse: specs.LoginResponse{},
+			ExpectedError:    errs.ErrUserInactive,
+		},
+		{
+			Name:  "failed_for_expired_invitation",
+			Email: TestEmployeeEmail,
+			Role:  constants.Employee,
+			MockSetup: func(mockUserStorer *mocks.UserStorer, profileMock *mocks.ProfileStorer, email, role string) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				mockUserStorer.On("GetUserInfo", mock.Anything, specs.UserInfoFilter{Email: email}).Return(mockEmployeeInfo, nil).Once()
+				profileMock.On("GetProfileIDByEmail", mock.Anything, email, mock.Anything).Return(2, nil).Once()
+				mockEmailRepo.On("GetPendingInvitation", mock.Anything, 2, mock.Anything).Return(specs.PendingInvitation{ProfileID: 2, IsOverdue: true}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, errs.ErrInvitationExpired).Return(errs.ErrInvitationExpired).Once()
+			},
+			MockTokenFunc:    nil,
+			ExpectedResponse: specs.LoginResponse{},
+			ExpectedError:    errs.ErrInvitationExpired,
+		},
 		{
 			Name:  "failed_get_profile_id",
 			Email: TestEmployeeEmail,
@@ -158,6 +206,8 @@ func TestUserLogin(t *testing.T) {
 
 			mockUserLogin.AssertExpectations(t)
 			mockProfileRepo.AssertExpectations(t)
+			mockAuditRepo.AssertExpectations(t)
+			mockEmailRepo.AssertExpectations(t)
 		})
 
 	}
@@ -165,42 +215,69 @@ func TestUserLogin(t *testing.T) {
 
 func TestRemoveToken(t *testing.T) {
 	mockUserLogin := new(mocks.UserStorer)
+	mockProfileRepo := new(mocks.ProfileStorer)
+	mockAuditRepo := new(mocks.AuditStorer)
 	var repodeps = service.RepoDeps{
 		UserLoginDeps: mockUserLogin,
+		ProfileDeps:   mockProfileRepo,
+		AuditDeps:     mockAuditRepo,
 	}
 	userLoginService := service.NewServices(repodeps)
 	tests := []struct {
 		name        string
 		token       string
-		setupTokens map[string]struct{}
+		setupTokens map[string]int64
+		setup       func()
 		expectedErr error
+		tokenKept   bool
 	}{
 		{
 			name:        "Token exists",
 			token:       "validToken",
-			setupTokens: map[string]struct{}{"validToken": {}},
+			setupTokens: map[string]int64{"validToken": 1},
+			setup: func() {
+				mockProfileRepo.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				mockAuditRepo.On("CreateAuditEvent", mock.Anything, repository.AuditEventRepo{
+					ActorID: 1, Action: constants.AuditActionLogout, TargetType: constants.AuditTargetUsers, TargetID: 1,
+				}, mock.Anything).Return(nil).Once()
+				mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
 			expectedErr: nil,
 		},
 		{
 			name:        "Token does not exist",
 			token:       "invalidToken",
-			setupTokens: map[string]struct{}{},
+			setupTokens: map[string]int64{},
+			setup:       func() {},
 			expectedErr: errs.ErrTokenNotFound,
 		},
+		{
+			name:        "Audit event not recorded",
+			token:       "validToken",
+			setupTokens: map[string]int64{"validToken": 1},
+			setup: func() {
+				mockProfileRepo.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				mockAuditRepo.On("CreateAuditEvent", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+				mockProfileRepo.On("HandleTransaction", mock.Anything, mock.Anything, errors.New("db error")).Return(errors.New("db error")).Once()
+			},
+			expectedErr: errors.New("db error"),
+			tokenKept:   true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			helpers.TokenList = tt.setupTokens
-			err := userLoginService.RemoveToken(tt.token)
-			if err != tt.expectedErr {
-				t.Errorf("expected error %v, got %v", tt.expectedErr, err)
-			}
-			if tt.expectedErr == nil {
-				if _, found := helpers.TokenList[tt.token]; found {
-					t.Errorf("expected token %v to be removed", tt.token)
-				}
-			}
+			tt.setup()
+
+			err := userLoginService.RemoveToken(context.Background(), tt.token)
+			assert.Equal(t, tt.expectedErr, err)
+
+			_, found := helpers.TokenList[tt.token]
+			assert.Equal(t, tt.tokenKept, found)
+
+			mockAuditRepo.AssertExpectations(t)
+			mockProfileRepo.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,138 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	errs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateUserStatus(t *testing.T) {
+	inactive := false
+
+	tests := []struct {
+		name         string
+		actorID      int
+		userID       int
+		setup        func(userMock *mocks.UserStorer, profileMock *mocks.ProfileStorer)
+		expectedErr  error
+		tokenRevoked bool
+	}{
+		{
+			name:    "Success_for_deactivate_employee",
+			actorID: 1,
+			userID:  5,
+			setup: func(userMock *mocks.UserStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				userMock.On("GetUser", mock.Anything, 5, mock.Anything).Return(specs.UserResponse{ID: 5, Role: constants.Employee, IsActive: true}, nil).Once()
+				userMock.On("UpdateUserStatus", mock.Anything, 5, false, mock.Anything, mock.Anything).Return(nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+			tokenRevoked: true,
+		},
+		{
+			name:    "Fail_for_last_admin",
+			actorID: 1,
+			userID:  2,
+			setup: func(userMock *mocks.UserStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				userMock.On("GetUser", mock.Anything, 2, mock.Anything).Return(specs.UserResponse{ID: 2, Role: constants.Admin, IsActive: true}, nil).Once()
+				userMock.On("CountActiveAdmins", mock.Anything, mock.Anything).Return(1, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, errs.ErrLastAdmin).Return(nil).Once()
+			},
+			expectedErr: errs.ErrLastAdmin,
+		},
+		{
+			name:    "Fail_for_own_account",
+			actorID: 5,
+			userID:  5,
+			setup: func(userMock *mocks.UserStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				userMock.On("GetUser", mock.Anything, 5, mock.Anything).Return(specs.UserResponse{ID: 5, Role: constants.Admin, IsActive: true}, nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, errs.ErrSelfManagement).Return(nil).Once()
+			},
+			expectedErr: errs.ErrSelfManagement,
+		},
+		{
+			name:    "Fail_when_the_transaction_cannot_begin",
+			actorID: 1,
+			userID:  5,
+			setup: func(userMock *mocks.UserStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, assert.AnError).Once()
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := &mocks.UserStorer{}
+			mockProfileRepo := getProfileMock(t)
+			userService := service.NewServices(service.RepoDeps{UserLoginDeps: mockUserRepo, ProfileDeps: mockProfileRepo})
+
+			tt.setup(mockUserRepo, mockProfileRepo)
+			helpers.TokenList = map[string]int64{"user_token": int64(tt.userID), "other_token": 99}
+
+			err := userService.UpdateUserStatus(context.Background(), tt.actorID, tt.userID, specs.UpdateUserStatusRequest{IsActive: &inactive})
+			assert.Equal(t, tt.expectedErr, err)
+
+			_, found := helpers.TokenList["user_token"]
+			assert.Equal(t, tt.tokenRevoked, !found)
+			assert.Contains(t, helpers.TokenList, "other_token")
+
+			mockUserRepo.AssertExpectations(t)
+			mockProfileRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(userMock *mocks.UserStorer, profileMock *mocks.ProfileStorer)
+		expectedErr error
+	}{
+		{
+			name: "Success_for_admin_with_other_admins",
+			setup: func(userMock *mocks.UserStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				userMock.On("GetUser", mock.Anything, 4, mock.Anything).Return(specs.UserResponse{ID: 4, Role: constants.Admin, IsActive: true}, nil).Once()
+				userMock.On("CountActiveAdmins", mock.Anything, mock.Anything).Return(2, nil).Once()
+				userMock.On("DeleteUser", mock.Anything, 4, mock.Anything).Return(nil).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, nil).Return(nil).Once()
+			},
+		},
+		{
+			name: "Fail_for_missing_user",
+			setup: func(userMock *mocks.UserStorer, profileMock *mocks.ProfileStorer) {
+				profileMock.On("BeginTransaction", mock.Anything).Return(nil, nil).Once()
+				userMock.On("GetUser", mock.Anything, 4, mock.Anything).Return(specs.UserResponse{}, errs.ErrUserNotFound).Once()
+				profileMock.On("HandleTransaction", mock.Anything, mock.Anything, errs.ErrUserNotFound).Return(nil).Once()
+			},
+			expectedErr: errs.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := &mocks.UserStorer{}
+			mockProfileRepo := getProfileMock(t)
+			userService := service.NewServices(service.RepoDeps{UserLoginDeps: mockUserRepo, ProfileDeps: mockProfileRepo})
+
+			tt.setup(mockUserRepo, mockProfileRepo)
+
+			err := userService.DeleteUser(context.Background(), 1, 4)
+			assert.Equal(t, tt.expectedErr, err)
+
+			mockUserRepo.AssertExpectations(t)
+			mockProfileRepo.AssertExpectations(t)
+		})
+	}
+}
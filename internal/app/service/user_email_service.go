@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
@@ -20,13 +22,23 @@ type UserEmailService interface {
 
 // inviteUser sends an email to the user with the invitation link
 func (userService *service) SendUserInvitation(ctx context.Context, userID int, profileID int) (err error) {
-	tx, _ := userService.ProfileRepo.BeginTransaction(ctx)
+	ctx = helpers.WithAuditAction(ctx, constants.AuditActionInvitation)
+	var emailID int64
+	var notifications []specs.Notification
+	tx, err := userService.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
 	defer func() {
 		txErr := userService.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
 			err = txErr
 			return
 		}
+		if err == nil {
+			userService.sendQueuedEmails(ctx, emailID)
+			userService.publishNotifications(ctx, notifications)
+		}
 	}()
 
 	profile, err := userService.ProfileRepo.GetProfile(ctx, profileID, tx)
@@ -35,13 +47,8 @@ func (userService *service) SendUserInvitation(ctx context.Context, userID int,
 		return err
 	}
 
-	err = helpers.SendUserInvitation(profile.Email, profile.Name, profileID)
-	if err != nil {
-		zap.S().Errorf("Error sending invitation:%v  for email:%s and profile ID : %d ", err, profile.Email, profileID)
-		return err
-	}
-
 	now := helpers.GetCurrentISTTime()
+	expiresAt := time.Now().Add(invitationExpiry())
 	createInvitationRequest := repository.Invitations{
 		ProfileID:       profileID,
 		ProfileComplete: constants.ProfileIncomplete,
@@ -49,6 +56,8 @@ func (userService *service) SendUserInvitation(ctx context.Context, userID int,
 		UpdatedAt:       now,
 		CreatedByID:     userID,
 		UpdatedByID:     userID,
+		ExpiresAt:       expiresAt,
+		RemindersSent:   invitationRemindersDue(expiresAt, time.Now()),
 	}
 
 	err = userService.UserEmailRepo.CreateInvitation(ctx, createInvitationRequest, tx)
@@ -63,19 +72,51 @@ func (userService *service) SendUserInvitation(ctx context.Context, userID int,
 		return err
 	}
 
+	emailID, err = userService.queueEmail(ctx, constants.EmailKindEmployeeInvitation, profile.Email, specs.EmailTemplateData{
+		Name:      profile.Name,
+		Email:     profile.Email,
+		ProfileID: profileID,
+		ExpiresAt: formatInvitationExpiry(expiresAt),
+	}, &profileID, tx)
+	if err != nil {
+		return err
+	}
+
+	notifications, err = userService.notify(ctx, repository.NotificationRepo{
+		Email:     profile.Email,
+		Kind:      constants.NotificationInvitationSent,
+		Title:     "Complete your profile",
+		Body:      fmt.Sprintf("You have been invited to fill in your profile. Please complete it by %s.", formatInvitationExpiry(expiresAt)),
+		Link:      helpers.ProfileLink(profileID),
+		ProfileID: &profileID,
+	}, tx)
+	if err != nil {
+		return err
+	}
+
 	zap.S().Infof("Invitation sent to user : user ID : %d and profile ID : %d", userID, profileID)
 	return nil
 }
 
 // Update profile complete status with sending the email to the admin
 func (userService *service) UpdateInvitation(ctx context.Context, userID int, profileID int) (err error) {
-	tx, _ := userService.ProfileRepo.BeginTransaction(ctx)
+	ctx = helpers.WithAuditAction(ctx, constants.AuditActionStatusChange)
+	var emailID int64
+	var notifications []specs.Notification
+	tx, err := userService.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
 	defer func() {
 		txErr := userService.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
 			err = txErr
 			return
 		}
+		if err == nil {
+			userService.sendQueuedEmails(ctx, emailID)
+			userService.publishNotifications(ctx, notifications)
+		}
 	}()
 
 	getRequest := repository.GetRequest{
@@ -99,12 +140,6 @@ func (userService *service) UpdateInvitation(ctx context.Context, userID int, pr
 		return err
 	}
 
-	err = helpers.SendAdminInvitation(admin.Email, admin.Name, profileID)
-	if err != nil {
-		zap.S().Errorf("Error sending invitation %v for user email : %s and profile ID : %d: ", err, admin.Email, profileID)
-		return err
-	}
-
 	now := helpers.GetCurrentISTTime()
 	updateSendRequest := repository.UpdateRequest{
 		ProfileComplete: constants.ProfileComplete,
@@ -128,19 +163,49 @@ func (userService *service) UpdateInvitation(ctx context.Context, userID int, pr
 		return err
 	}
 
+	emailID, err = userService.queueEmail(ctx, constants.EmailKindProfileCompleted, admin.Email, specs.EmailTemplateData{
+		Name:         admin.Name,
+		Email:        admin.Email,
+		EmployeeName: profile.Name,
+		ProfileID:    profileID,
+	}, &profileID, tx)
+	if err != nil {
+		return err
+	}
+
+	notifications, err = userService.notify(ctx, repository.NotificationRepo{
+		UserID:    invitation.CreatedByID,
+		Kind:      constants.NotificationProfileSubmitted,
+		Title:     fmt.Sprintf("%s submitted their profile", profile.Name),
+		Body:      fmt.Sprintf("The profile of %s is complete and ready to review.", profile.Name),
+		Link:      helpers.ProfileLink(profileID),
+		ProfileID: &profileID,
+	}, tx)
+	if err != nil {
+		return err
+	}
+
 	zap.S().Infof("Profile completed successfully for user : user ID : %d and profile ID : %d", userID, profileID)
 	return nil
 }
 
 // InviteAdmin sends an invitation email to a new admin and creates their user record
 func (userService *service) InviteAdmin(ctx context.Context, userID int, req specs.AdminInviteRequest) (err error) {
-	tx, _ := userService.ProfileRepo.BeginTransaction(ctx)
+	ctx = helpers.WithAuditAction(ctx, constants.AuditActionInvitation)
+	var emailID int64
+	tx, err := userService.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
 	defer func() {
 		txErr := userService.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
 			err = txErr
 			return
 		}
+		if err == nil {
+			userService.sendQueuedEmails(ctx, emailID)
+		}
 	}()
 
 	filter := specs.UserInfoFilter{Email: req.Email}
@@ -153,15 +218,17 @@ func (userService *service) InviteAdmin(ctx context.Context, userID int, req spe
 		return checkErr
 	}
 
-	err = helpers.SendAdminWelcomeInvitation(req.Email, req.Name)
+	err = userService.UserLoginRepo.CreateUser(ctx, req.Name, req.Email, constants.Admin, tx)
 	if err != nil {
-		zap.S().Errorf("Error sending admin invitation: %v for email: %s", err, req.Email)
+		zap.S().Errorf("Error creating admin user: %v for user %s: ", err, req.Email)
 		return err
 	}
 
-	err = userService.UserLoginRepo.CreateUser(ctx, req.Name, req.Email, constants.Admin, tx)
+	emailID, err = userService.queueEmail(ctx, constants.EmailKindAdminInvitation, req.Email, specs.EmailTemplateData{
+		Name:  req.Name,
+		Email: req.Email,
+	}, nil, tx)
 	if err != nil {
-		zap.S().Errorf("Error creating admin user: %v for user %s: ", err, req.Email)
 		return err
 	}
 
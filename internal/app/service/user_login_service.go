@@ -8,17 +8,21 @@ import (
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
 	jwttoken "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/jwt_token"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
 	"go.uber.org/zap"
 )
 
 // UserLoginServive contains methods of creation of tokens
 type UserLoginServive interface {
 	GenerateLoginToken(ctx context.Context, filter specs.UserInfoFilter) (specs.LoginResponse, error)
-	RemoveToken(token string) error
+	RemoveToken(ctx context.Context, token string) error
 }
 
 func (userService *service) GenerateLoginToken(ctx context.Context, filter specs.UserInfoFilter) (res specs.LoginResponse, err error) {
-	tx, _ := userService.ProfileRepo.BeginTransaction(ctx)
+	tx, err := userService.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.LoginResponse{}, err
+	}
 	defer func() {
 		txErr := userService.ProfileRepo.HandleTransaction(ctx, tx, err)
 		if txErr != nil {
@@ -32,24 +36,64 @@ func (userService *service) GenerateLoginToken(ctx context.Context, filter specs
 		return specs.LoginResponse{}, err
 	}
 
+	if !userInfo.IsActive {
+		zap.S().Infof("Login rejected for deactivated user : %d", userInfo.ID)
+		return specs.LoginResponse{}, errors.ErrUserInactive
+	}
+
 	var profileID int
 	if userInfo.Role == constants.Admin {
 		profileID = constants.AdminProfileID
 	} else {
 		profileID, err = userService.ProfileRepo.GetProfileIDByEmail(ctx, filter.Email, tx)
+		if err == errors.ErrNoRecordFound && userInfo.Role != constants.Employee {
+			// staff roles such as viewer or recruiter need not have a profile of their own
+			profileID, err = constants.AdminProfileID, nil
+		}
 		if err != nil {
 			zap.S().Errorf("Error getting profile id : %v by email : %s ", err, filter.Email)
 			return specs.LoginResponse{}, err
 		}
 	}
 
+	if userInfo.Role == constants.Employee {
+		invitation, invitationErr := userService.UserEmailRepo.GetPendingInvitation(ctx, profileID, tx)
+		if invitationErr != nil && invitationErr != errors.ErrInvitationNotFound {
+			zap.S().Errorf("Error getting the invitation of profile %d : %v", profileID, invitationErr)
+			return specs.LoginResponse{}, invitationErr
+		}
+		if invitationErr == nil && invitation.IsOverdue {
+			zap.S().Infof("Login rejected for employee %d whose invitation expired at %s", userInfo.ID, invitation.ExpiresAt)
+			return specs.LoginResponse{}, errors.ErrInvitationExpired
+		}
+	}
+
 	token, err := jwttoken.CreateToken(userInfo.ID, profileID, userInfo.Role, filter.Email)
 	if err != nil {
 		return specs.LoginResponse{}, err
 	}
 
+	err = userService.UserLoginRepo.UpdateLastLogin(ctx, userInfo.ID, helpers.GetCurrentISTTime(), tx)
+	if err != nil {
+		zap.S().Errorf("Error updating last login of user %d : %v", userInfo.ID, err)
+		return specs.LoginResponse{}, err
+	}
+
+	err = userService.AuditRepo.CreateAuditEvent(ctx, repository.AuditEventRepo{
+		ActorID:    int(userInfo.ID),
+		ActorRole:  userInfo.Role,
+		Action:     constants.AuditActionLogin,
+		TargetType: constants.AuditTargetUsers,
+		TargetID:   int(userInfo.ID),
+		RequestID:  helpers.GetRequestID(ctx),
+	}, tx)
+	if err != nil {
+		zap.S().Errorf("Error recording login of user %d : %v", userInfo.ID, err)
+		return specs.LoginResponse{}, err
+	}
+
 	helpers.WhiteListMutext.Lock()
-	helpers.TokenList[token] = struct{}{}
+	helpers.TokenList[token] = userInfo.ID
 	helpers.WhiteListMutext.Unlock()
 
 	loginResponse := specs.LoginResponse{
@@ -63,15 +107,46 @@ func (userService *service) GenerateLoginToken(ctx context.Context, filter specs
 	return loginResponse, nil
 }
 
-func (userService *service) RemoveToken(token string) error {
+// RemoveToken signs out the session of the token and records the logout
+func (userService *service) RemoveToken(ctx context.Context, token string) (err error) {
 	helpers.WhiteListMutext.Lock()
-	defer helpers.WhiteListMutext.Unlock()
-	if _, found := helpers.TokenList[token]; !found {
+	userID, found := helpers.TokenList[token]
+	helpers.WhiteListMutext.Unlock()
+	if !found {
 		zap.S().Error("Token not found in whitelist")
 		return errors.ErrTokenNotFound
 	}
-	delete(helpers.TokenList, token)
 
-	zap.S().Info("Logout successfully")
+	tx, err := userService.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		txErr := userService.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil {
+			helpers.WhiteListMutext.Lock()
+			delete(helpers.TokenList, token)
+			helpers.WhiteListMutext.Unlock()
+			zap.S().Info("Logout successfully")
+		}
+	}()
+
+	role, _ := ctx.Value(constants.UserRoleKey).(string)
+	err = userService.AuditRepo.CreateAuditEvent(ctx, repository.AuditEventRepo{
+		ActorID:    int(userID),
+		ActorRole:  role,
+		Action:     constants.AuditActionLogout,
+		TargetType: constants.AuditTargetUsers,
+		TargetID:   int(userID),
+		RequestID:  helpers.GetRequestID(ctx),
+	}, tx)
+	if err != nil {
+		zap.S().Errorf("Error recording logout of user %d : %v", userID, err)
+		return err
+	}
 	return nil
 }
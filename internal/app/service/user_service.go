@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	"go.uber.org/zap"
+)
+
+// UserService contains methods for admins to manage user accounts
+type UserService interface {
+	ListUsers(ctx context.Context, filter specs.ListUsersFilter) (specs.ListUsersResponse, error)
+	GetUser(ctx context.Context, userID int) (specs.UserResponse, error)
+	CreateUser(ctx context.Context, req specs.CreateUserRequest) (userID int, err error)
+	UpdateUser(ctx context.Context, actorID int, userID int, req specs.UpdateUserRequest) (err error)
+	UpdateUserStatus(ctx context.Context, actorID int, userID int, req specs.UpdateUserStatusRequest) (err error)
+	DeleteUser(ctx context.Context, actorID int, userID int) (err error)
+}
+
+// ListUsers returns the users matching the filter
+func (userSvc *service) ListUsers(ctx context.Context, filter specs.ListUsersFilter) (values specs.ListUsersResponse, err error) {
+	tx, err := userSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.ListUsersResponse{}, err
+	}
+	defer func() {
+		txErr := userSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	users, err := userSvc.UserLoginRepo.ListUsers(ctx, filter, tx)
+	if err != nil {
+		zap.S().Error("Unable to list users : ", err)
+		return specs.ListUsersResponse{}, err
+	}
+
+	return specs.ListUsersResponse{Users: users}, nil
+}
+
+// GetUser returns a single user
+func (userSvc *service) GetUser(ctx context.Context, userID int) (value specs.UserResponse, err error) {
+	tx, err := userSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return specs.UserResponse{}, err
+	}
+	defer func() {
+		txErr := userSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	user, err := userSvc.UserLoginRepo.GetUser(ctx, userID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to get user %d : %v", userID, err)
+		return specs.UserResponse{}, err
+	}
+
+	return user, nil
+}
+
+// CreateUser adds a user account with the given role
+func (userSvc *service) CreateUser(ctx context.Context, req specs.CreateUserRequest) (userID int, err error) {
+	tx, err := userSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		txErr := userSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}()
+
+	now := helpers.GetCurrentISTTime()
+	userID, err = userSvc.UserLoginRepo.AddUser(ctx, repository.UserRepo{
+		Name:      req.Name,
+		Email:     req.Email,
+		Role:      req.Role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to create user %s : %v", req.Email, err)
+		return 0, err
+	}
+
+	zap.S().Infof("User %d created with role %s", userID, req.Role)
+	return userID, nil
+}
+
+// UpdateUser changes the name and role of a user. A role change signs the user out of every session.
+func (userSvc *service) UpdateUser(ctx context.Context, actorID int, userID int, req specs.UpdateUserRequest) (err error) {
+	roleChanged := false
+
+	tx, err := userSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		txErr := userSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil && roleChanged {
+			helpers.RevokeUserTokens(int64(userID))
+		}
+	}()
+
+	user, err := userSvc.UserLoginRepo.GetUser(ctx, userID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to get user %d : %v", userID, err)
+		return err
+	}
+
+	roleChanged = user.Role != req.Role
+	if roleChanged {
+		err = userSvc.checkUserRemoval(ctx, actorID, user, tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = userSvc.UserLoginRepo.UpdateUser(ctx, userID, repository.UpdateUserRepo{
+		Name:      req.Name,
+		Role:      req.Role,
+		UpdatedAt: helpers.GetCurrentISTTime(),
+	}, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to update user %d : %v", userID, err)
+		return err
+	}
+
+	zap.S().Infof("User %d updated by %d", userID, actorID)
+	return nil
+}
+
+// UpdateUserStatus activates or deactivates a user. Deactivation signs the user out of every session.
+func (userSvc *service) UpdateUserStatus(ctx context.Context, actorID int, userID int, req specs.UpdateUserStatusRequest) (err error) {
+	ctx = helpers.WithAuditAction(ctx, constants.AuditActionStatusChange)
+	isActive := *req.IsActive
+
+	tx, err := userSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		txErr := userSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil && !isActive {
+			helpers.RevokeUserTokens(int64(userID))
+		}
+	}()
+
+	user, err := userSvc.UserLoginRepo.GetUser(ctx, userID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to get user %d : %v", userID, err)
+		return err
+	}
+
+	if !isActive {
+		err = userSvc.checkUserRemoval(ctx, actorID, user, tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = userSvc.UserLoginRepo.UpdateUserStatus(ctx, userID, isActive, helpers.GetCurrentISTTime(), tx)
+	if err != nil {
+		zap.S().Errorf("Unable to update status of user %d : %v", userID, err)
+		return err
+	}
+
+	zap.S().Infof("User %d active status set to %t by %d", userID, isActive, actorID)
+	return nil
+}
+
+// DeleteUser deletes a user and signs them out of every session
+func (userSvc *service) DeleteUser(ctx context.Context, actorID int, userID int) (err error) {
+	tx, err := userSvc.ProfileRepo.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		txErr := userSvc.ProfileRepo.HandleTransaction(ctx, tx, err)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+		if err == nil {
+			helpers.RevokeUserTokens(int64(userID))
+		}
+	}()
+
+	user, err := userSvc.UserLoginRepo.GetUser(ctx, userID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to get user %d : %v", userID, err)
+		return err
+	}
+
+	err = userSvc.checkUserRemoval(ctx, actorID, user, tx)
+	if err != nil {
+		return err
+	}
+
+	err = userSvc.UserLoginRepo.DeleteUser(ctx, userID, tx)
+	if err != nil {
+		zap.S().Errorf("Unable to delete user %d : %v", userID, err)
+		return err
+	}
+
+	zap.S().Infof("User %d deleted by %d", userID, actorID)
+	return nil
+}
+
+// checkUserRemoval guards changes that take away a user's current access: admins cannot apply them
+// to their own account, and the last active admin cannot lose admin access.
+func (userSvc *service) checkUserRemoval(ctx context.Context, actorID int, user specs.UserResponse, tx pgx.Tx) error {
+	if user.ID == actorID {
+		return errors.ErrSelfManagement
+	}
+
+	if user.Role != constants.Admin || !user.IsActive {
+		return nil
+	}
+
+	count, err := userSvc.UserLoginRepo.CountActiveAdmins(ctx, tx)
+	if err != nil {
+		zap.S().Error("Unable to count active admins : ", err)
+		return err
+	}
+
+	if count <= 1 {
+		return errors.ErrLastAdmin
+	}
+	return nil
+}
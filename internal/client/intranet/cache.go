@@ -0,0 +1,275 @@
+package intranet
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// allEmployeesKey is the cache key of the full employee list
+const allEmployeesKey = "employees"
+
+// Cache stores intranet responses by key. MemoryCache keeps them in the process; a shared store can be plugged in
+// by implementing Cache.
+type Cache interface {
+	Get(key string) (CacheEntry, bool)
+	Set(key string, entry CacheEntry)
+	Delete(key string)
+	Clear()
+	Len() int
+}
+
+// CacheEntry is a cached intranet response, JSON encoded, and when it was fetched.
+type CacheEntry struct {
+	Data      []byte
+	FetchedAt time.Time
+}
+
+// CacheConfig holds the settings of the intranet cache.
+type CacheConfig struct {
+	// TTL is how long a response is served without asking the intranet again; zero turns the cache off
+	TTL time.Duration
+	// StaleTTL is how much longer an expired response is kept, to be served while the intranet is unavailable
+	StaleTTL time.Duration
+	// MaxEntries bounds the number of responses kept in memory
+	MaxEntries int
+}
+
+// CacheConfigFromEnv returns the cache settings, taking each from its environment variable when set.
+func CacheConfigFromEnv() CacheConfig {
+	return CacheConfig{
+		TTL:        helpers.ConvertStringToTimeDuration("INTRANET_CACHE_TTL", 5*time.Minute),
+		StaleTTL:   helpers.ConvertStringToTimeDuration("INTRANET_CACHE_STALE_TTL", 24*time.Hour),
+		MaxEntries: int(helpers.ConvertStringToIntWithDefault("INTRANET_CACHE_MAX_ENTRIES", 10000)),
+	}
+}
+
+// CachingClient is an IntranetClient that caches employee lookups and can be told to forget them.
+type CachingClient interface {
+	IntranetClient
+	// Invalidate forgets the cached employee and the cached employee list
+	Invalidate(employeeID string)
+	// InvalidateAll forgets every cached response
+	InvalidateAll()
+	Stats() specs.IntranetCacheStats
+}
+
+// cachedClient wraps an IntranetClient with a Cache.
+type cachedClient struct {
+	client IntranetClient
+	cache  Cache
+	config CacheConfig
+
+	hits      atomic.Int64
+	misses    atomic.Int64
+	staleHits atomic.Int64
+	errors    atomic.Int64
+}
+
+// NewCachedClient wraps client so that GetEmployeeByID and GetEmployees are answered from cache for config.TTL. An
+// expired response is fetched again, but is still served for config.StaleTTL when the intranet is unavailable.
+// Employees read by ListEmployees refresh the cache but the list itself is always fetched.
+func NewCachedClient(client IntranetClient, cache Cache, config CacheConfig) CachingClient {
+	return &cachedClient{client: client, cache: cache, config: config}
+}
+
+// GetEmployeeByID returns the employee from cache, or fetches and caches it.
+func (c *cachedClient) GetEmployeeByID(ctx context.Context, employeeID string) (*specs.IntranetEmployee, error) {
+	var employee specs.IntranetEmployee
+	err := c.fetch(ctx, employeeKey(employeeID), &employee, func() (interface{}, error) {
+		return c.client.GetEmployeeByID(ctx, employeeID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &employee, nil
+}
+
+// GetEmployees returns the employee list from cache, or fetches and caches it along with each employee in it.
+func (c *cachedClient) GetEmployees(ctx context.Context) ([]specs.IntranetEmployee, error) {
+	var employees []specs.IntranetEmployee
+	err := c.fetch(ctx, allEmployeesKey, &employees, func() (interface{}, error) {
+		employees, err := c.client.GetEmployees(ctx)
+		if err == nil {
+			c.storeEmployees(employees)
+		}
+		return employees, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return employees, nil
+}
+
+// ListEmployees always asks the intranet, caching each employee it reads.
+func (c *cachedClient) ListEmployees(ctx context.Context, opts specs.ListIntranetEmployeesOptions, handlePage func(page []specs.IntranetEmployee) error) error {
+	return c.client.ListEmployees(ctx, opts, func(page []specs.IntranetEmployee) error {
+		c.storeEmployees(page)
+		return handlePage(page)
+	})
+}
+
+// Invalidate forgets the cached employee and the cached employee list.
+func (c *cachedClient) Invalidate(employeeID string) {
+	c.cache.Delete(employeeKey(employeeID))
+	c.cache.Delete(allEmployeesKey)
+}
+
+// InvalidateAll forgets every cached response.
+func (c *cachedClient) InvalidateAll() {
+	c.cache.Clear()
+}
+
+// Stats returns how often lookups were answered from cache.
+func (c *cachedClient) Stats() specs.IntranetCacheStats {
+	stats := specs.IntranetCacheStats{
+		Enabled:   true,
+		Entries:   c.cache.Len(),
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		StaleHits: c.staleHits.Load(),
+		Errors:    c.errors.Load(),
+	}
+	if lookups := stats.Hits + stats.Misses + stats.StaleHits + stats.Errors; lookups > 0 {
+		stats.HitRate = float64(stats.Hits+stats.StaleHits) / float64(lookups)
+	}
+	return stats
+}
+
+// fetch decodes the cached response for key into target when it is fresh. Otherwise it calls load and caches the
+// result, falling back to the expired response when the intranet is unavailable.
+func (c *cachedClient) fetch(ctx context.Context, key string, target interface{}, load func() (interface{}, error)) error {
+	entry, cached := c.cache.Get(key)
+	age := time.Since(entry.FetchedAt)
+	if cached && age < c.config.TTL && json.Unmarshal(entry.Data, target) == nil {
+		c.hits.Add(1)
+		return nil
+	}
+
+	value, err := load()
+	if err != nil {
+		if cached && errors.IsIntranetUnavailable(err) && age < c.config.TTL+c.config.StaleTTL && json.Unmarshal(entry.Data, target) == nil {
+			zap.S().Warnf("Intranet is unavailable, serving %s cached %s ago", key, age.Round(time.Second))
+			c.staleHits.Add(1)
+			return nil
+		}
+		c.errors.Add(1)
+		return err
+	}
+
+	c.misses.Add(1)
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c.cache.Set(key, CacheEntry{Data: data, FetchedAt: time.Now()})
+	return json.Unmarshal(data, target)
+}
+
+// storeEmployees caches each employee under its own key
+func (c *cachedClient) storeEmployees(employees []specs.IntranetEmployee) {
+	now := time.Now()
+	for _, employee := range employees {
+		if employee.EmployeeID == "" {
+			continue
+		}
+		data, err := json.Marshal(employee)
+		if err != nil {
+			continue
+		}
+		c.cache.Set(employeeKey(employee.EmployeeID), CacheEntry{Data: data, FetchedAt: now})
+	}
+}
+
+// employeeKey is the cache key of an employee
+func employeeKey(employeeID string) string {
+	return "employee:" + strings.TrimSpace(employeeID)
+}
+
+// MemoryCache is an in-process Cache. Entries older than maxAge are dropped, and once it holds maxEntries the
+// oldest entry makes way for a new one.
+type MemoryCache struct {
+	mu         sync.Mutex
+	entries    map[string]CacheEntry
+	maxEntries int
+	maxAge     time.Duration
+}
+
+// NewMemoryCache creates an empty MemoryCache.
+func NewMemoryCache(maxEntries int, maxAge time.Duration) *MemoryCache {
+	return &MemoryCache{entries: map[string]CacheEntry{}, maxEntries: maxEntries, maxAge: maxAge}
+}
+
+// Get returns the entry stored under key, unless it is older than maxAge.
+func (m *MemoryCache) Get(key string) (CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+	if time.Since(entry.FetchedAt) >= m.maxAge {
+		delete(m.entries, key)
+		return CacheEntry{}, false
+	}
+	return entry, true
+}
+
+// Set stores entry under key, making room for it when the cache is full.
+func (m *MemoryCache) Set(key string, entry CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.entries[key]; !ok && m.maxEntries > 0 && len(m.entries) >= m.maxEntries {
+		m.evict()
+	}
+	m.entries[key] = entry
+}
+
+// Delete removes the entry stored under key.
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+}
+
+// Clear removes every entry.
+func (m *MemoryCache) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = map[string]CacheEntry{}
+}
+
+// Len returns the number of entries stored.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.entries)
+}
+
+// evict drops the entries older than maxAge, or the oldest entry if none is. The caller holds the lock.
+func (m *MemoryCache) evict() {
+	oldestKey := ""
+	var oldest time.Time
+	for key, entry := range m.entries {
+		if time.Since(entry.FetchedAt) >= m.maxAge {
+			delete(m.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.FetchedAt.Before(oldest) {
+			oldestKey, oldest = key, entry.FetchedAt
+		}
+	}
+	if len(m.entries) >= m.maxEntries && oldestKey != "" {
+		delete(m.entries, oldestKey)
+	}
+}
@@ -1,14 +1,19 @@
 package intranet
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
 	"go.uber.org/zap"
 )
@@ -16,93 +21,279 @@ import (
 // IntranetClient is the interface for fetching employee data from the Intranet API.
 type IntranetClient interface {
 	GetEmployees(ctx context.Context) ([]specs.IntranetEmployee, error)
+	ListEmployees(ctx context.Context, opts specs.ListIntranetEmployeesOptions, handlePage func(page []specs.IntranetEmployee) error) error
 	GetEmployeeByID(ctx context.Context, employeeID string) (*specs.IntranetEmployee, error)
 }
 
+// Config holds the settings of the Intranet API client.
+type Config struct {
+	BaseURL string
+	APIKey  string
+	// Timeout limits each attempt
+	Timeout time.Duration
+	// MaxRetries is the number of attempts made after the first one fails
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry; it doubles on every retry, up to MaxBackoff
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+	// BreakerThreshold consecutive failed requests open the circuit breaker for BreakerCooldown
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// PageSize is the number of employees asked for in each page of a paginated list
+	PageSize int
+}
+
+// ConfigFromEnv returns the client settings, taking each from its environment variable when set.
+func ConfigFromEnv(baseURL, apiKey string) Config {
+	return Config{
+		BaseURL:          baseURL,
+		APIKey:           apiKey,
+		Timeout:          helpers.ConvertStringToTimeDuration("INTRANET_TIMEOUT", 30*time.Second),
+		MaxRetries:       int(helpers.ConvertStringToIntWithDefault("INTRANET_MAX_RETRIES", 3)),
+		RetryBackoff:     helpers.ConvertStringToTimeDuration("INTRANET_RETRY_BACKOFF", 500*time.Millisecond),
+		MaxBackoff:       helpers.ConvertStringToTimeDuration("INTRANET_MAX_BACKOFF", 10*time.Second),
+		BreakerThreshold: int(helpers.ConvertStringToIntWithDefault("INTRANET_BREAKER_THRESHOLD", 5)),
+		BreakerCooldown:  helpers.ConvertStringToTimeDuration("INTRANET_BREAKER_COOLDOWN", 30*time.Second),
+		PageSize:         int(helpers.ConvertStringToIntWithDefault("INTRANET_PAGE_SIZE", 200)),
+	}
+}
+
 // httpClient is the concrete implementation of IntranetClient.
 type httpClient struct {
-	baseURL    string
-	apiKey     string
+	config     Config
 	httpClient *http.Client
+	breaker    *circuitBreaker
 }
 
-// NewClient creates a new IntranetClient backed by an HTTP client.
+// NewClient creates a new IntranetClient backed by an HTTP client, configured from the environment.
 func NewClient(baseURL, apiKey string) IntranetClient {
+	return NewClientWithConfig(ConfigFromEnv(baseURL, apiKey))
+}
+
+// NewClientWithConfig creates a new IntranetClient backed by an HTTP client with the given settings.
+func NewClientWithConfig(config Config) IntranetClient {
 	return &httpClient{
-		baseURL: baseURL,
-		apiKey:  apiKey,
+		config: config,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: config.Timeout,
 		},
+		breaker: newCircuitBreaker(config.BreakerThreshold, config.BreakerCooldown),
 	}
 }
 
-// GetEmployees calls the Intranet API and returns a slice of IntranetEmployee records.
+// GetEmployees calls the Intranet API and returns every IntranetEmployee record, reading all of the pages.
 func (c *httpClient) GetEmployees(ctx context.Context) ([]specs.IntranetEmployee, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL, nil)
+	employees := []specs.IntranetEmployee{}
+	err := c.ListEmployees(ctx, specs.ListIntranetEmployeesOptions{}, func(page []specs.IntranetEmployee) error {
+		employees = append(employees, page...)
+		return nil
+	})
 	if err != nil {
-		zap.S().Error("Error creating intranet HTTP request: ", err)
-		return nil, fmt.Errorf("creating request: %w", err)
+		return nil, err
 	}
 
-	req.Header.Set("X-API-Key", c.apiKey)
-	req.Header.Set("Accept", "application/json")
+	return employees, nil
+}
 
-	resp, err := c.httpClient.Do(req)
+// ListEmployees calls the Intranet API and hands each page of IntranetEmployee records to handlePage, so that
+// only one page is held in memory at a time. An intranet that does not paginate returns everyone as one page.
+func (c *httpClient) ListEmployees(ctx context.Context, opts specs.ListIntranetEmployeesOptions, handlePage func(page []specs.IntranetEmployee) error) error {
+	listURL, err := url.Parse(c.config.BaseURL)
 	if err != nil {
-		zap.S().Error("Error executing intranet HTTP request: ", err)
-		return nil, fmt.Errorf("executing request: %w", err)
+		zap.S().Error("Error parsing intranet API base URL: ", err)
+		return fmt.Errorf("parsing base URL: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		body, _ := io.ReadAll(resp.Body)
-		zap.S().Errorf("Intranet API returned non-2xx status %d: %s", resp.StatusCode, string(body))
-		return nil, fmt.Errorf("intranet API returned status %d", resp.StatusCode)
+	query := listURL.Query()
+	if c.config.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(c.config.PageSize))
+	}
+	if opts.UpdatedSince != nil {
+		query.Set("updated_since", opts.UpdatedSince.UTC().Format(time.RFC3339))
 	}
 
-	var employees []specs.IntranetEmployee
-	if err := json.NewDecoder(resp.Body).Decode(&employees); err != nil {
-		zap.S().Error("Error decoding intranet API response: ", err)
-		return nil, fmt.Errorf("decoding response: %w", err)
+	seen := map[string]bool{}
+	for {
+		listURL.RawQuery = query.Encode()
+
+		var body json.RawMessage
+		if err := c.get(ctx, listURL.String(), &body); err != nil {
+			return err
+		}
+
+		page, err := decodeEmployeesPage(body)
+		if err != nil {
+			zap.S().Error("Error decoding intranet API response: ", err)
+			return fmt.Errorf("decoding response: %w", err)
+		}
+
+		if err := handlePage(page.Employees); err != nil {
+			return err
+		}
+
+		switch {
+		case page.NextCursor != "":
+			query.Set("cursor", page.NextCursor)
+		case page.NextPage > 0:
+			query.Set("page", strconv.Itoa(page.NextPage))
+		default:
+			return nil
+		}
+
+		// an intranet that keeps handing out the same page would otherwise be read forever
+		next := query.Encode()
+		if seen[next] {
+			return fmt.Errorf("intranet API returned the same page twice: %s", next)
+		}
+		seen[next] = true
 	}
+}
 
-	return employees, nil
+// decodeEmployeesPage decodes a page of employees, which is either a bare list or a page with a link to the next
+func decodeEmployeesPage(body json.RawMessage) (specs.IntranetEmployeesPage, error) {
+	var page specs.IntranetEmployeesPage
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		err := json.Unmarshal(trimmed, &page.Employees)
+		return page, err
+	}
+
+	err := json.Unmarshal(body, &page)
+	return page, err
 }
 
 // GetEmployeeByID calls the Intranet API and returns a single IntranetEmployee record.
 func (c *httpClient) GetEmployeeByID(ctx context.Context, employeeID string) (*specs.IntranetEmployee, error) {
-	url := fmt.Sprintf("%s/%s", c.baseURL, employeeID)
+	var employee specs.IntranetEmployee
+	if err := c.get(ctx, fmt.Sprintf("%s/%s", c.config.BaseURL, employeeID), &employee); err != nil {
+		return nil, err
+	}
+
+	return &employee, nil
+}
+
+// get fetches url into out, retrying timeouts, network errors, 5xx and 429 responses with exponential backoff.
+// It fails fast while the circuit breaker is open. When ctx is cancelled or its deadline passes, ctx.Err() is
+// returned and not counted against the breaker.
+func (c *httpClient) get(ctx context.Context, url string, out interface{}) error {
+	if !c.breaker.allow() {
+		zap.S().Warn("Intranet API circuit breaker is open, not calling ", url)
+		return ErrCircuitOpen
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			wait := c.backoff(attempt, lastErr)
+			zap.S().Warnf("Retrying intranet API request to %s in %s (attempt %d of %d): %v", url, wait, attempt+1, c.config.MaxRetries+1, lastErr)
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				c.breaker.release()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		var retry bool
+		retry, lastErr = c.attempt(ctx, url, out)
+		if lastErr != nil && ctx.Err() != nil {
+			// the caller gave up, which says nothing about the intranet
+			c.breaker.release()
+			return ctx.Err()
+		}
+		if lastErr == nil || !retry {
+			// only an unavailable intranet counts against the breaker, not a missing employee or a bad request
+			c.breaker.record(!errors.IsIntranetUnavailable(lastErr))
+			return lastErr
+		}
+	}
+
+	c.breaker.record(false)
+	zap.S().Errorf("Intranet API request to %s failed after %d attempts: %v", url, c.config.MaxRetries+1, lastErr)
+	return lastErr
+}
+
+// attempt makes a single request and reports whether a failure is worth retrying
+func (c *httpClient) attempt(ctx context.Context, url string, out interface{}) (retry bool, err error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		zap.S().Error("Error creating intranet HTTP request: ", err)
-		return nil, fmt.Errorf("creating request: %w", err)
+		return false, fmt.Errorf("creating request: %w", err)
 	}
 
-	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("X-API-Key", c.config.APIKey)
 	req.Header.Set("Accept", "application/json")
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		zap.S().Error("Error executing intranet HTTP request: ", err)
-		return nil, fmt.Errorf("executing request: %w", err)
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		return true, fmt.Errorf("%w: %v", errors.ErrIntranetUnavailable, err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		if resp.StatusCode == http.StatusNotFound {
-			return nil, errors.ErrNoRecordFound
+			return false, errors.ErrNoRecordFound
 		}
-		body, _ := io.ReadAll(resp.Body)
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
 		zap.S().Errorf("Intranet API returned non-2xx status %d: %s", resp.StatusCode, string(body))
-		return nil, fmt.Errorf("intranet API returned status %d", resp.StatusCode)
+
+		statusErr := &StatusError{StatusCode: resp.StatusCode, Body: string(body)}
+		if resp.StatusCode == http.StatusTooManyRequests {
+			statusErr.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+		}
+		return statusErr.Temporary(), statusErr
 	}
 
-	var employee specs.IntranetEmployee
-	if err := json.NewDecoder(resp.Body).Decode(&employee); err != nil {
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
 		zap.S().Error("Error decoding intranet API response: ", err)
-		return nil, fmt.Errorf("decoding response: %w", err)
+		return false, fmt.Errorf("decoding response: %w", err)
 	}
 
-	return &employee, nil
+	return false, nil
+}
+
+// backoff returns how long to wait before the given retry: the Retry-After of a 429 response when it sent one,
+// and otherwise RetryBackoff doubled for every earlier retry with up to half of it again added as jitter
+func (c *httpClient) backoff(attempt int, lastErr error) time.Duration {
+	if statusErr, ok := lastErr.(*StatusError); ok && statusErr.RetryAfter > 0 {
+		return minDuration(statusErr.RetryAfter, c.config.MaxBackoff)
+	}
+
+	wait := c.config.RetryBackoff << (attempt - 1)
+	if wait <= 0 || wait > c.config.MaxBackoff {
+		wait = c.config.MaxBackoff
+	}
+	if half := int64(wait / 2); half > 0 {
+		wait += time.Duration(rand.Int63n(half))
+	}
+	return minDuration(wait, c.config.MaxBackoff)
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(at); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
 }
@@ -0,0 +1,34 @@
+package intranet
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+)
+
+// ErrCircuitOpen is returned without calling the intranet while the circuit breaker is open.
+var ErrCircuitOpen = fmt.Errorf("%w: circuit breaker is open", errors.ErrIntranetUnavailable)
+
+// StatusError is returned when the Intranet API responds with an unexpected status.
+type StatusError struct {
+	StatusCode int
+	Body       string
+	// RetryAfter is how long a 429 response asked us to wait, if it said
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("intranet API returned status %d", e.StatusCode)
+}
+
+// Temporary reports whether the request may succeed if retried: on a 5xx or 429 response.
+func (e *StatusError) Temporary() bool {
+	return e.StatusCode >= http.StatusInternalServerError || e.StatusCode == http.StatusTooManyRequests
+}
+
+// Is lets errors.Is match a temporary StatusError against ErrIntranetUnavailable.
+func (e *StatusError) Is(target error) bool {
+	return target == errors.ErrIntranetUnavailable && e.Temporary()
+}
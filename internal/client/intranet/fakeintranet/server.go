@@ -0,0 +1,275 @@
+// Package fakeintranet serves employees from a fixture the way the Intranet API does, so that the intranet client,
+// the sync and the lookups can be run and tested without a real intranet. Server is an http.Handler and can be
+// started with httptest.NewServer or http.ListenAndServe.
+package fakeintranet
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// Ways of paginating the employee list
+const (
+	// PagingCursor answers with {"employees": [...], "next_cursor": "..."}
+	PagingCursor = "cursor"
+	// PagingPage answers with {"employees": [...], "next_page": N}
+	PagingPage = "page"
+	// PagingNone answers with a plain list of every employee
+	PagingNone = "none"
+)
+
+// Employee is an employee served by the fake intranet. UpdatedAt is when the employee last changed, used to answer
+// updated_since; an employee without it is taken never to have changed.
+type Employee struct {
+	specs.IntranetEmployee
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// Config holds the settings of the fake intranet.
+type Config struct {
+	// APIKey is the X-API-Key every request must send; any key is accepted when empty
+	APIKey string
+	// PathPrefix is put before /employees, e.g. /api/internal/v1
+	PathPrefix string
+	// Latency delays every response
+	Latency time.Duration
+	// ErrorRate is the share of requests, from 0 to 1, answered with ErrorStatus
+	ErrorRate float64
+	// FailFirst requests are answered with ErrorStatus before any succeeds
+	FailFirst int
+	// ErrorStatus is the status of a simulated error, 503 when not set. A 429 is sent with a Retry-After of 1 second
+	ErrorStatus int
+	// Paging is PagingCursor, PagingPage or PagingNone; PagingCursor when not set
+	Paging string
+	// PageSize is the number of employees in a page when the request does not send page_size; 50 when not set
+	PageSize int
+}
+
+// Server is a fake Intranet API serving GET /employees and GET /employees/{id}.
+type Server struct {
+	config  Config
+	router  *mux.Router
+	pending atomic.Int64
+
+	mu        sync.RWMutex
+	employees []Employee
+	requests  int64
+	random    *rand.Rand
+}
+
+// NewServer creates a fake intranet serving employees.
+func NewServer(config Config, employees []Employee) *Server {
+	if config.ErrorStatus == 0 {
+		config.ErrorStatus = http.StatusServiceUnavailable
+	}
+	if config.Paging == "" {
+		config.Paging = PagingCursor
+	}
+	if config.PageSize <= 0 {
+		config.PageSize = 50
+	}
+
+	s := &Server{
+		config:    config,
+		employees: employees,
+		random:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	s.pending.Store(int64(config.FailFirst))
+
+	s.router = mux.NewRouter()
+	prefix := strings.TrimRight(config.PathPrefix, "/")
+	s.router.HandleFunc(prefix+"/employees", s.listEmployees).Methods(http.MethodGet)
+	s.router.HandleFunc(prefix+"/employees/{id}", s.getEmployee).Methods(http.MethodGet)
+	return s
+}
+
+// LoadFixture reads employees from a JSON file holding a list of them.
+func LoadFixture(path string) ([]Employee, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var employees []Employee
+	if err := json.Unmarshal(data, &employees); err != nil {
+		return nil, fmt.Errorf("decoding fixture %s: %w", path, err)
+	}
+	return employees, nil
+}
+
+// SetEmployees replaces the employees served, e.g. to have a test see an employee change or leave.
+func (s *Server) SetEmployees(employees []Employee) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.employees = employees
+}
+
+// Requests returns the number of requests received, including rejected and failed ones.
+func (s *Server) Requests() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return int(s.requests)
+}
+
+// ServeHTTP checks the API key and simulates latency and errors before serving the request.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	fail := s.config.ErrorRate > 0 && s.random.Float64() < s.config.ErrorRate
+	s.mu.Unlock()
+
+	if s.config.Latency > 0 {
+		select {
+		case <-time.After(s.config.Latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if s.config.APIKey != "" && r.Header.Get("X-API-Key") != s.config.APIKey {
+		writeError(w, http.StatusUnauthorized, "invalid API key")
+		return
+	}
+
+	if s.pending.Add(-1) >= 0 || fail {
+		if s.config.ErrorStatus == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "1")
+		}
+		writeError(w, s.config.ErrorStatus, "simulated error")
+		return
+	}
+
+	s.router.ServeHTTP(w, r)
+}
+
+// listEmployees serves a page of the employees changed since updated_since, or of everyone
+func (s *Server) listEmployees(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var updatedSince time.Time
+	if value := query.Get("updated_since"); value != "" {
+		var err error
+		if updatedSince, err = time.Parse(time.RFC3339, value); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid updated_since")
+			return
+		}
+	}
+
+	s.mu.RLock()
+	employees := []Employee{}
+	for _, employee := range s.employees {
+		if updatedSince.IsZero() || (employee.UpdatedAt != nil && employee.UpdatedAt.After(updatedSince)) {
+			employees = append(employees, employee)
+		}
+	}
+	s.mu.RUnlock()
+
+	if s.config.Paging == PagingNone {
+		writeJSON(w, http.StatusOK, employees)
+		return
+	}
+
+	pageSize := s.config.PageSize
+	if value := query.Get("page_size"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid page_size")
+			return
+		}
+		pageSize = size
+	}
+
+	offset, err := s.offset(query.Get("cursor"), query.Get("page"), pageSize)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	end := offset + pageSize
+	if offset > len(employees) {
+		offset = len(employees)
+	}
+	if end > len(employees) {
+		end = len(employees)
+	}
+
+	page := struct {
+		Employees  []Employee `json:"employees"`
+		NextCursor string     `json:"next_cursor,omitempty"`
+		NextPage   int        `json:"next_page,omitempty"`
+	}{Employees: employees[offset:end]}
+	if end < len(employees) {
+		if s.config.Paging == PagingPage {
+			page.NextPage = end/pageSize + 1
+		} else {
+			page.NextCursor = encodeCursor(end)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+// getEmployee serves the employee with the id in the path, or 404
+func (s *Server) getEmployee(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, employee := range s.employees {
+		if employee.EmployeeID == id {
+			writeJSON(w, http.StatusOK, employee)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "employee not found")
+}
+
+// offset returns the position of the first employee of the page asked for with cursor or page
+func (s *Server) offset(cursor string, page string, pageSize int) (int, error) {
+	switch {
+	case s.config.Paging == PagingCursor && cursor != "":
+		return decodeCursor(cursor)
+	case s.config.Paging == PagingPage && page != "":
+		number, err := strconv.Atoi(page)
+		if err != nil || number < 1 {
+			return 0, fmt.Errorf("invalid page")
+		}
+		return (number - 1) * pageSize, nil
+	}
+	return 0, nil
+}
+
+// encodeCursor hides the offset of the next page in an opaque cursor
+func encodeCursor(offset int) string {
+	return "after-" + strconv.Itoa(offset)
+}
+
+func decodeCursor(cursor string) (int, error) {
+	offset, err := strconv.Atoi(strings.TrimPrefix(cursor, "after-"))
+	if err != nil || offset < 0 || !strings.HasPrefix(cursor, "after-") {
+		return 0, fmt.Errorf("invalid cursor")
+	}
+	return offset, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
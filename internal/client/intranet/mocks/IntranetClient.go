@@ -75,6 +75,24 @@ func (_m *IntranetClient) GetEmployees(ctx context.Context) ([]specs.IntranetEmp
 	return r0, r1
 }
 
+// ListEmployees provides a mock function with given fields: ctx, opts, handlePage
+func (_m *IntranetClient) ListEmployees(ctx context.Context, opts specs.ListIntranetEmployeesOptions, handlePage func([]specs.IntranetEmployee) error) error {
+	ret := _m.Called(ctx, opts, handlePage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmployees")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListIntranetEmployeesOptions, func([]specs.IntranetEmployee) error) error); ok {
+		r0 = rf(ctx, opts, handlePage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewIntranetClient creates a new instance of IntranetClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIntranetClient(t interface {
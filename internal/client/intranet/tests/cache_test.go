@@ -0,0 +1,134 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testCacheConfig = intranet.CacheConfig{TTL: time.Minute, StaleTTL: time.Hour, MaxEntries: 100}
+
+func cacheEmployee(t *testing.T, cache intranet.Cache, employee specs.IntranetEmployee, age time.Duration) {
+	data, err := json.Marshal(employee)
+	assert.NoError(t, err)
+	cache.Set("employee:"+employee.EmployeeID, intranet.CacheEntry{Data: data, FetchedAt: time.Now().Add(-age)})
+}
+
+func TestCachedClientGetEmployeeByID(t *testing.T) {
+	employee := specs.IntranetEmployee{EmployeeID: "EMP001", Name: "Alice"}
+
+	t.Run("Fetches_once_and_answers_from_cache", func(t *testing.T) {
+		mockClient := new(mocks.IntranetClient)
+		mockClient.On("GetEmployeeByID", mock.Anything, "EMP001").Return(&employee, nil).Once()
+		client := intranet.NewCachedClient(mockClient, intranet.NewMemoryCache(100, time.Hour), testCacheConfig)
+
+		for i := 0; i < 3; i++ {
+			got, err := client.GetEmployeeByID(context.Background(), "EMP001")
+			assert.NoError(t, err)
+			assert.Equal(t, employee, *got)
+		}
+
+		stats := client.Stats()
+		assert.Equal(t, int64(2), stats.Hits)
+		assert.Equal(t, int64(1), stats.Misses)
+		assert.InDelta(t, 2.0/3.0, stats.HitRate, 0.001)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("Serves_an_expired_entry_while_the_intranet_is_unavailable", func(t *testing.T) {
+		mockClient := new(mocks.IntranetClient)
+		mockClient.On("GetEmployeeByID", mock.Anything, "EMP001").Return(nil, intranet.ErrCircuitOpen).Once()
+		cache := intranet.NewMemoryCache(100, 2*time.Hour)
+		cacheEmployee(t, cache, employee, 10*time.Minute)
+		client := intranet.NewCachedClient(mockClient, cache, testCacheConfig)
+
+		got, err := client.GetEmployeeByID(context.Background(), "EMP001")
+
+		assert.NoError(t, err)
+		assert.Equal(t, employee, *got)
+		assert.Equal(t, int64(1), client.Stats().StaleHits)
+	})
+
+	t.Run("Does_not_serve_an_expired_entry_for_other_errors", func(t *testing.T) {
+		mockClient := new(mocks.IntranetClient)
+		mockClient.On("GetEmployeeByID", mock.Anything, "EMP001").Return(nil, errors.ErrNoRecordFound).Once()
+		cache := intranet.NewMemoryCache(100, 2*time.Hour)
+		cacheEmployee(t, cache, employee, 10*time.Minute)
+		client := intranet.NewCachedClient(mockClient, cache, testCacheConfig)
+
+		_, err := client.GetEmployeeByID(context.Background(), "EMP001")
+
+		assert.Equal(t, errors.ErrNoRecordFound, err)
+		assert.Equal(t, int64(1), client.Stats().Errors)
+	})
+
+	t.Run("Does_not_serve_an_entry_past_the_stale_window", func(t *testing.T) {
+		mockClient := new(mocks.IntranetClient)
+		mockClient.On("GetEmployeeByID", mock.Anything, "EMP001").Return(nil, intranet.ErrCircuitOpen).Once()
+		cache := intranet.NewMemoryCache(100, 24*time.Hour)
+		cacheEmployee(t, cache, employee, 2*time.Hour)
+		client := intranet.NewCachedClient(mockClient, cache, testCacheConfig)
+
+		_, err := client.GetEmployeeByID(context.Background(), "EMP001")
+
+		assert.True(t, errors.IsIntranetUnavailable(err))
+	})
+
+	t.Run("Fetches_again_after_invalidation", func(t *testing.T) {
+		mockClient := new(mocks.IntranetClient)
+		mockClient.On("GetEmployeeByID", mock.Anything, "EMP001").Return(&employee, nil).Twice()
+		client := intranet.NewCachedClient(mockClient, intranet.NewMemoryCache(100, time.Hour), testCacheConfig)
+
+		_, err := client.GetEmployeeByID(context.Background(), "EMP001")
+		assert.NoError(t, err)
+		client.Invalidate("EMP001")
+		_, err = client.GetEmployeeByID(context.Background(), "EMP001")
+		assert.NoError(t, err)
+
+		assert.Equal(t, int64(2), client.Stats().Misses)
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestCachedClientListEmployeesRefreshesEmployees(t *testing.T) {
+	employee := specs.IntranetEmployee{EmployeeID: "EMP002", Name: "Bob"}
+	mockClient := new(mocks.IntranetClient)
+	mockClient.On("ListEmployees", mock.Anything, specs.ListIntranetEmployeesOptions{}, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(2).(func(page []specs.IntranetEmployee) error)([]specs.IntranetEmployee{employee})
+	}).Return(nil).Once()
+	client := intranet.NewCachedClient(mockClient, intranet.NewMemoryCache(100, time.Hour), testCacheConfig)
+
+	var listed []specs.IntranetEmployee
+	err := client.ListEmployees(context.Background(), specs.ListIntranetEmployeesOptions{}, func(page []specs.IntranetEmployee) error {
+		listed = append(listed, page...)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []specs.IntranetEmployee{employee}, listed)
+
+	got, err := client.GetEmployeeByID(context.Background(), "EMP002")
+	assert.NoError(t, err)
+	assert.Equal(t, employee, *got)
+	mockClient.AssertNotCalled(t, "GetEmployeeByID", mock.Anything, mock.Anything)
+}
+
+func TestMemoryCacheEvictsTheOldestEntry(t *testing.T) {
+	cache := intranet.NewMemoryCache(2, time.Hour)
+	cache.Set("a", intranet.CacheEntry{FetchedAt: time.Now().Add(-2 * time.Minute)})
+	cache.Set("b", intranet.CacheEntry{FetchedAt: time.Now().Add(-time.Minute)})
+	cache.Set("c", intranet.CacheEntry{FetchedAt: time.Now()})
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+	_, ok = cache.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 2, cache.Len())
+}
@@ -0,0 +1,250 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/assert"
+)
+
+func testConfig(baseURL string) intranet.Config {
+	return intranet.Config{
+		BaseURL:          baseURL,
+		APIKey:           "secret",
+		Timeout:          time.Second,
+		MaxRetries:       2,
+		RetryBackoff:     time.Millisecond,
+		MaxBackoff:       5 * time.Millisecond,
+		BreakerThreshold: 2,
+		BreakerCooldown:  time.Hour,
+	}
+}
+
+func TestGetEmployeesRetriesServerErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("X-API-Key"))
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`[{"employee_id":"EMP001","email":"alice@example.com"}]`))
+	}))
+	defer server.Close()
+
+	employees, err := intranet.NewClientWithConfig(testConfig(server.URL)).GetEmployees(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, employees, 1)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestGetEmployeesHonoursRetryAfter(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	_, err := intranet.NewClientWithConfig(testConfig(server.URL)).GetEmployees(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestGetEmployeesGivesUpAsUnavailable(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := intranet.NewClientWithConfig(testConfig(server.URL)).GetEmployees(context.Background())
+
+	assert.True(t, errors.IsIntranetUnavailable(err))
+	statusErr, ok := err.(*intranet.StatusError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusServiceUnavailable, statusErr.StatusCode)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestGetEmployeeByIDDoesNotRetryClientErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path == "/EMP404" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := intranet.NewClientWithConfig(testConfig(server.URL))
+
+	_, err := client.GetEmployeeByID(context.Background(), "EMP404")
+	assert.Equal(t, errors.ErrNoRecordFound, err)
+
+	_, err = client.GetEmployeeByID(context.Background(), "EMP001")
+	assert.False(t, errors.IsIntranetUnavailable(err))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestCircuitBreakerFailsFast(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	config := testConfig(server.URL)
+	config.MaxRetries = 0
+	client := intranet.NewClientWithConfig(config)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.GetEmployees(context.Background())
+		assert.True(t, errors.IsIntranetUnavailable(err))
+	}
+
+	_, err := client.GetEmployees(context.Background())
+	assert.Equal(t, intranet.ErrCircuitOpen, err)
+	assert.True(t, errors.IsIntranetUnavailable(err))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestCircuitBreakerClosesAfterCooldown(t *testing.T) {
+	var healthy int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&healthy) == 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	config := testConfig(server.URL)
+	config.MaxRetries = 0
+	config.BreakerCooldown = 10 * time.Millisecond
+	client := intranet.NewClientWithConfig(config)
+
+	for i := 0; i < 2; i++ {
+		client.GetEmployees(context.Background())
+	}
+	_, err := client.GetEmployees(context.Background())
+	assert.Equal(t, intranet.ErrCircuitOpen, err)
+
+	atomic.StoreInt32(&healthy, 1)
+	time.Sleep(20 * time.Millisecond)
+
+	_, err = client.GetEmployees(context.Background())
+	assert.NoError(t, err)
+	_, err = client.GetEmployees(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestCircuitBreakerIgnoresCancelledCallers(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= 3 {
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	config := testConfig(server.URL)
+	config.MaxRetries = 0
+	client := intranet.NewClientWithConfig(config)
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		_, err := client.GetEmployees(ctx)
+		cancel()
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.False(t, errors.IsIntranetUnavailable(err))
+	}
+
+	_, err := client.GetEmployees(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+}
+
+func TestListEmployeesFollowsCursors(t *testing.T) {
+	since := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2024-08-01T10:00:00Z", r.URL.Query().Get("updated_since"))
+		assert.Equal(t, "200", r.URL.Query().Get("page_size"))
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"employees":[{"employee_id":"EMP001"},{"employee_id":"EMP002"}],"next_cursor":"abc"}`))
+		case "abc":
+			w.Write([]byte(`{"employees":[{"employee_id":"EMP003"}],"next_cursor":""}`))
+		default:
+			t.Errorf("unexpected cursor %s", r.URL.Query().Get("cursor"))
+		}
+	}))
+	defer server.Close()
+
+	config := testConfig(server.URL)
+	config.PageSize = 200
+
+	pages := [][]string{}
+	err := intranet.NewClientWithConfig(config).ListEmployees(context.Background(), specs.ListIntranetEmployeesOptions{UpdatedSince: &since}, func(page []specs.IntranetEmployee) error {
+		ids := []string{}
+		for _, employee := range page {
+			ids = append(ids, employee.EmployeeID)
+		}
+		pages = append(pages, ids)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"EMP001", "EMP002"}, {"EMP003"}}, pages)
+}
+
+func TestGetEmployeesFollowsPageNumbers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Write([]byte(`{"employees":[{"employee_id":"EMP001"}],"next_page":2}`))
+		case "2":
+			w.Write([]byte(`{"employees":[{"employee_id":"EMP002"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	employees, err := intranet.NewClientWithConfig(testConfig(server.URL)).GetEmployees(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, employees, 2)
+}
+
+func TestListEmployeesStopsOnARepeatedPage(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`{"employees":[],"next_cursor":"same"}`))
+	}))
+	defer server.Close()
+
+	_, err := intranet.NewClientWithConfig(testConfig(server.URL)).GetEmployees(context.Background())
+
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
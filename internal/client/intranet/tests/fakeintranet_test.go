@@ -0,0 +1,147 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/intranet/fakeintranet"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/assert"
+)
+
+const fakeAPIKey = "fake-key"
+
+func fakeEmployees() []fakeintranet.Employee {
+	changed := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	return []fakeintranet.Employee{
+		{IntranetEmployee: specs.IntranetEmployee{EmployeeID: "EMP001", Name: "Alice"}},
+		{IntranetEmployee: specs.IntranetEmployee{EmployeeID: "EMP002", Name: "Bob"}, UpdatedAt: &changed},
+		{IntranetEmployee: specs.IntranetEmployee{EmployeeID: "EMP003", Name: "Carol"}},
+	}
+}
+
+func startFakeIntranet(t *testing.T, config fakeintranet.Config) (*fakeintranet.Server, intranet.IntranetClient) {
+	config.APIKey = fakeAPIKey
+	fake := fakeintranet.NewServer(config, fakeEmployees())
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client := intranet.NewClientWithConfig(intranet.Config{
+		BaseURL:          server.URL + "/employees",
+		APIKey:           fakeAPIKey,
+		Timeout:          time.Second,
+		MaxRetries:       2,
+		RetryBackoff:     time.Millisecond,
+		MaxBackoff:       5 * time.Millisecond,
+		BreakerThreshold: 5,
+		BreakerCooldown:  time.Minute,
+		PageSize:         2,
+	})
+	return fake, client
+}
+
+func employeeIDs(employees []specs.IntranetEmployee) []string {
+	ids := []string{}
+	for _, employee := range employees {
+		ids = append(ids, employee.EmployeeID)
+	}
+	return ids
+}
+
+func TestFakeIntranetListsEveryPage(t *testing.T) {
+	for _, paging := range []string{fakeintranet.PagingCursor, fakeintranet.PagingPage, fakeintranet.PagingNone} {
+		t.Run(paging, func(t *testing.T) {
+			fake, client := startFakeIntranet(t, fakeintranet.Config{Paging: paging})
+
+			employees, err := client.GetEmployees(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"EMP001", "EMP002", "EMP003"}, employeeIDs(employees))
+			if paging == fakeintranet.PagingNone {
+				assert.Equal(t, 1, fake.Requests())
+			} else {
+				assert.Equal(t, 2, fake.Requests())
+			}
+		})
+	}
+}
+
+func TestFakeIntranetListsEmployeesUpdatedSince(t *testing.T) {
+	_, client := startFakeIntranet(t, fakeintranet.Config{})
+	since := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	var listed []specs.IntranetEmployee
+	err := client.ListEmployees(context.Background(), specs.ListIntranetEmployeesOptions{UpdatedSince: &since}, func(page []specs.IntranetEmployee) error {
+		listed = append(listed, page...)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"EMP002"}, employeeIDs(listed))
+}
+
+func TestFakeIntranetGetEmployeeByID(t *testing.T) {
+	t.Run("Found", func(t *testing.T) {
+		_, client := startFakeIntranet(t, fakeintranet.Config{})
+
+		employee, err := client.GetEmployeeByID(context.Background(), "EMP003")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Carol", employee.Name)
+	})
+
+	t.Run("Not_found", func(t *testing.T) {
+		_, client := startFakeIntranet(t, fakeintranet.Config{})
+
+		_, err := client.GetEmployeeByID(context.Background(), "EMP404")
+
+		assert.Equal(t, errors.ErrNoRecordFound, err)
+	})
+}
+
+func TestFakeIntranetRejectsWrongAPIKey(t *testing.T) {
+	fake := fakeintranet.NewServer(fakeintranet.Config{APIKey: fakeAPIKey}, fakeEmployees())
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/employees/EMP001", nil)
+	req.Header.Set("X-API-Key", "wrong")
+
+	fake.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func TestFakeIntranetSimulatesErrors(t *testing.T) {
+	t.Run("Recovers_after_the_first_failures", func(t *testing.T) {
+		fake, client := startFakeIntranet(t, fakeintranet.Config{FailFirst: 2})
+
+		employee, err := client.GetEmployeeByID(context.Background(), "EMP001")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Alice", employee.Name)
+		assert.Equal(t, 3, fake.Requests())
+	})
+
+	t.Run("Unavailable_when_every_request_fails", func(t *testing.T) {
+		_, client := startFakeIntranet(t, fakeintranet.Config{ErrorRate: 1})
+
+		_, err := client.GetEmployeeByID(context.Background(), "EMP001")
+
+		assert.True(t, errors.IsIntranetUnavailable(err))
+	})
+
+	t.Run("Returns_the_callers_deadline_on_latency", func(t *testing.T) {
+		_, client := startFakeIntranet(t, fakeintranet.Config{Latency: 2 * time.Second})
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := client.GetEmployeeByID(ctx, "EMP001")
+
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.False(t, errors.IsIntranetUnavailable(err))
+	})
+}
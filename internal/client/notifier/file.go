@@ -0,0 +1,61 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"go.uber.org/zap"
+)
+
+// unsafeFileChars matches what may not appear in the name of a written email
+var unsafeFileChars = regexp.MustCompile(`[^a-zA-Z0-9@._-]`)
+
+// fileNotifier writes each email to a file instead of sending it.
+type fileNotifier struct {
+	config Config
+}
+
+// NewFileNotifier creates a Notifier that writes each email to an .eml file in FileDir, for local development.
+func NewFileNotifier(config Config) Notifier {
+	return &fileNotifier{config: config}
+}
+
+// Send writes the email to a new file named after the time and the recipient.
+func (n *fileNotifier) Send(ctx context.Context, msg Message) error {
+	email := helpers.ConvertToLowerCase(msg.To)
+
+	err := os.MkdirAll(n.config.FileDir, 0o755)
+	if err != nil {
+		return fmt.Errorf("%w : %v", errors.ErrUnableToSendEmail, err)
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102T150405.000000000"), unsafeFileChars.ReplaceAllString(email, "_"))
+	path := filepath.Join(n.config.FileDir, name)
+	err = os.WriteFile(path, buildMIMEMessage(n.config, email, msg), 0o644)
+	if err != nil {
+		return fmt.Errorf("%w : %v", errors.ErrUnableToSendEmail, err)
+	}
+
+	zap.S().Info("Email for ", email, " written to ", path)
+	return nil
+}
+
+// logNotifier only logs each email.
+type logNotifier struct{}
+
+// NewLogNotifier creates a Notifier that logs each email instead of sending it.
+func NewLogNotifier() Notifier {
+	return logNotifier{}
+}
+
+// Send logs the recipient, subject and body of the email.
+func (logNotifier) Send(ctx context.Context, msg Message) error {
+	zap.S().Infof("Email not sent, logged only. to : %s, subject : %s, body : %s", helpers.ConvertToLowerCase(msg.To), msg.Subject, msg.Body)
+	return nil
+}
@@ -0,0 +1,96 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+)
+
+// Backends that emails can be sent through, chosen with EMAIL_BACKEND
+const (
+	BackendSendGrid = "sendgrid"
+	BackendSMTP     = "smtp"
+	BackendFile     = "file"
+	BackendLog      = "log"
+)
+
+// Message is an email to a single recipient. The body is HTML; TextBody, if set, is sent along with it as the
+// plain-text alternative.
+type Message struct {
+	To       string
+	Subject  string
+	Body     string
+	TextBody string
+}
+
+// Notifier sends emails.
+type Notifier interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Config holds the settings of every backend; only those of the chosen Backend are used.
+type Config struct {
+	Backend  string
+	From     string
+	FromName string
+	// SendGridAPIKey is used by the sendgrid backend, which calls SendGridHost, or the SendGrid API when empty
+	SendGridAPIKey string
+	SendGridHost   string
+	// SMTPHost and SMTPPort locate the server used by the smtp backend. SMTPUsername is optional; without it
+	// mail is sent unauthenticated, as local stand-ins such as MailHog expect.
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	// SMTPTimeout limits how long sending one email through the smtp backend may take
+	SMTPTimeout time.Duration
+	// FileDir is where the file backend writes each email
+	FileDir string
+}
+
+// ConfigFromEnv returns the notifier settings, taking each from its environment variable.
+func ConfigFromEnv() Config {
+	backend := strings.ToLower(strings.TrimSpace(os.Getenv("EMAIL_BACKEND")))
+	if backend == "" {
+		backend = BackendSendGrid
+	}
+	return Config{
+		Backend:        backend,
+		From:           os.Getenv("FROM_EMAIL"),
+		FromName:       constants.Intranet,
+		SendGridAPIKey: os.Getenv("SENDGRID_API_KEY"),
+		SMTPHost:       os.Getenv("SMTP_HOST"),
+		SMTPPort:       int(helpers.ConvertStringToIntWithDefault("SMTP_PORT", 1025)),
+		SMTPUsername:   os.Getenv("SMTP_USERNAME"),
+		SMTPPassword:   os.Getenv("SMTP_PASSWORD"),
+		SMTPTimeout:    helpers.ConvertStringToTimeDuration("SMTP_TIMEOUT", defaultSMTPTimeout),
+		FileDir:        os.Getenv("EMAIL_FILE_DIR"),
+	}
+}
+
+// New returns the Notifier of the configured backend.
+func New(config Config) (Notifier, error) {
+	switch config.Backend {
+	case BackendSendGrid:
+		return NewSendGridNotifier(config), nil
+	case BackendSMTP:
+		if config.SMTPHost == "" {
+			return nil, fmt.Errorf("%w : SMTP_HOST is required by the smtp email backend", errors.ErrInvalidEnv)
+		}
+		return NewSMTPNotifier(config), nil
+	case BackendFile:
+		if config.FileDir == "" {
+			return nil, fmt.Errorf("%w : EMAIL_FILE_DIR is required by the file email backend", errors.ErrInvalidEnv)
+		}
+		return NewFileNotifier(config), nil
+	case BackendLog:
+		return NewLogNotifier(), nil
+	}
+	return nil, fmt.Errorf("%w : %s", errors.ErrUnknownEmailBackend, config.Backend)
+}
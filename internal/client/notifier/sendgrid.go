@@ -0,0 +1,55 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/sendgrid/sendgrid-go"
+	"github.com/sendgrid/sendgrid-go/helpers/mail"
+	"go.uber.org/zap"
+)
+
+// sendGridNotifier sends emails through the SendGrid API. It only holds the configuration; each send builds a
+// request of its own, so that concurrent sends do not share a request body.
+type sendGridNotifier struct {
+	from   *mail.Email
+	apiKey string
+	host   string
+}
+
+// NewSendGridNotifier creates a Notifier that sends emails through the SendGrid API.
+func NewSendGridNotifier(config Config) Notifier {
+	return &sendGridNotifier{
+		from:   mail.NewEmail(config.FromName, config.From),
+		apiKey: config.SendGridAPIKey,
+		host:   config.SendGridHost,
+	}
+}
+
+// Send sends the email, failing unless SendGrid accepts it with a 2xx response.
+func (n *sendGridNotifier) Send(ctx context.Context, msg Message) error {
+	email := helpers.ConvertToLowerCase(msg.To)
+	text := msg.TextBody
+	if text == "" {
+		text = msg.Body
+	}
+	message := mail.NewSingleEmail(n.from, msg.Subject, mail.NewEmail("", email), text, msg.Body)
+
+	request := sendgrid.GetRequest(n.apiKey, "/v3/mail/send", n.host)
+	request.Method = http.MethodPost
+	request.Body = mail.GetRequestBody(message)
+	response, err := sendgrid.MakeRequestWithContext(ctx, request)
+	if err != nil {
+		return fmt.Errorf("%w : %v", errors.ErrUnableToSendEmail, err)
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("%w : sendgrid returned status %d : %s", errors.ErrUnableToSendEmail, response.StatusCode, response.Body)
+	}
+
+	zap.S().Info("Email sent successfully for email : ", email)
+	return nil
+}
@@ -0,0 +1,147 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"go.uber.org/zap"
+)
+
+// defaultSMTPTimeout limits sending one email when SMTP_TIMEOUT is not set
+const defaultSMTPTimeout = 30 * time.Second
+
+// smtpNotifier sends emails through an SMTP server.
+type smtpNotifier struct {
+	config Config
+	addr   string
+}
+
+// NewSMTPNotifier creates a Notifier that sends emails through the configured SMTP server.
+func NewSMTPNotifier(config Config) Notifier {
+	return &smtpNotifier{
+		config: config,
+		addr:   net.JoinHostPort(config.SMTPHost, strconv.Itoa(config.SMTPPort)),
+	}
+}
+
+// Send sends the email, giving up once ctx is done or SMTPTimeout has passed. It upgrades the connection with
+// STARTTLS when the server offers it, and authenticates only when a username is configured.
+func (n *smtpNotifier) Send(ctx context.Context, msg Message) error {
+	email := helpers.ConvertToLowerCase(msg.To)
+
+	err := n.send(ctx, email, buildMIMEMessage(n.config, email, msg))
+	if err != nil {
+		return fmt.Errorf("%w : %v", errors.ErrUnableToSendEmail, err)
+	}
+
+	zap.S().Info("Email sent successfully for email : ", email)
+	return nil
+}
+
+// send delivers data to the recipient over a new connection to the server
+func (n *smtpNotifier) send(ctx context.Context, to string, data []byte) error {
+	timeout := n.config.SMTPTimeout
+	if timeout <= 0 {
+		timeout = defaultSMTPTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", n.addr)
+	if err != nil {
+		return err
+	}
+	// the deadline bounds every read and write, and closing the connection ends them early when ctx is cancelled
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, n.config.SMTPHost)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: n.config.SMTPHost})
+		if err != nil {
+			return err
+		}
+	}
+	if n.config.SMTPUsername != "" {
+		err = client.Auth(smtp.PlainAuth("", n.config.SMTPUsername, n.config.SMTPPassword, n.config.SMTPHost))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(n.config.From)
+	if err != nil {
+		return err
+	}
+	err = client.Rcpt(to)
+	if err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	if err != nil {
+		return err
+	}
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// buildMIMEMessage formats the email as an HTML message with its headers. With a plain-text body the message is
+// multipart/alternative, with the text part first as clients show the last part they support.
+func buildMIMEMessage(config Config, to string, msg Message) []byte {
+	from := mail.Address{Name: config.FromName, Address: config.From}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	if msg.TextBody == "" {
+		buf.WriteString("Content-Type: text/html; charset=\"utf-8\"\r\n")
+		buf.WriteString("\r\n")
+		buf.WriteString(msg.Body)
+		return buf.Bytes()
+	}
+
+	parts := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n", parts.Boundary())
+	buf.WriteString("\r\n")
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=\"utf-8\"", msg.TextBody},
+		{"text/html; charset=\"utf-8\"", msg.Body},
+	} {
+		w, _ := parts.CreatePart(textproto.MIMEHeader{"Content-Type": {part.contentType}})
+		io.WriteString(w, part.body)
+	}
+	parts.Close()
+	return buf.Bytes()
+}
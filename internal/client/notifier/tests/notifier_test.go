@@ -0,0 +1,298 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/client/notifier"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMessage = notifier.Message{To: "Alice@Example.com", Subject: "Welcome", Body: "<p>Hello Alice</p>"}
+
+func TestSendGridNotifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantErr    bool
+	}{
+		{name: "Success_for_accepted_email", statusCode: http.StatusAccepted},
+		{name: "Fail_for_rejected_email", statusCode: http.StatusUnauthorized, wantErr: true},
+		{name: "Fail_for_server_error", statusCode: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v3/mail/send", r.URL.Path)
+				assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			n, err := notifier.New(notifier.Config{Backend: notifier.BackendSendGrid, From: "pb@example.com", SendGridAPIKey: "key", SendGridHost: server.URL})
+			require.NoError(t, err)
+
+			err = n.Send(context.Background(), testMessage)
+
+			assert.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errors.ErrUnableToSendEmail)
+				assert.Contains(t, err.Error(), strconv.Itoa(tt.statusCode))
+			}
+		})
+	}
+}
+
+func TestSendGridNotifierConcurrentSends(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Personalizations []struct {
+				To []struct {
+					Email string `json:"email"`
+				} `json:"to"`
+			} `json:"personalizations"`
+			Subject string `json:"subject"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		// every email must reach the recipient its subject was written for
+		assert.Equal(t, "Welcome "+body.Personalizations[0].To[0].Email, body.Subject)
+		mu.Lock()
+		received[body.Personalizations[0].To[0].Email]++
+		mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	n, err := notifier.New(notifier.Config{Backend: notifier.BackendSendGrid, From: "pb@example.com", SendGridAPIKey: "key", SendGridHost: server.URL})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			to := fmt.Sprintf("user%d@example.com", i)
+			assert.NoError(t, n.Send(context.Background(), notifier.Message{To: to, Subject: "Welcome " + to, Body: "<p>Hello</p>"}))
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Len(t, received, 20)
+}
+
+// fakeSMTPServer accepts a single email and returns the recipient and data it was given.
+func fakeSMTPServer(t *testing.T) (addr string, received chan []string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	received = make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+		reply("220 localhost ready")
+
+		var rcpt string
+		var data []string
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				rcpt = strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>")
+				reply("250 OK")
+			case command == "DATA":
+				reply("354 send the data")
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil || strings.TrimSpace(dataLine) == "." {
+						break
+					}
+					data = append(data, strings.TrimRight(dataLine, "\r\n"))
+				}
+				reply("250 OK")
+			case command == "QUIT":
+				reply("221 bye")
+				received <- append([]string{rcpt}, data...)
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+	return listener.Addr().String(), received
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	addr, received := fakeSMTPServer(t)
+	host, portStr, _ := net.SplitHostPort(addr)
+	port, _ := strconv.Atoi(portStr)
+
+	n, err := notifier.New(notifier.Config{Backend: notifier.BackendSMTP, From: "pb@example.com", FromName: "intranet", SMTPHost: host, SMTPPort: port})
+	require.NoError(t, err)
+
+	err = n.Send(context.Background(), testMessage)
+	require.NoError(t, err)
+
+	lines := <-received
+	assert.Equal(t, "alice@example.com", lines[0])
+	assert.Contains(t, lines, "To: alice@example.com")
+	assert.Contains(t, lines, "Subject: Welcome")
+	assert.Contains(t, lines, "<p>Hello Alice</p>")
+}
+
+func TestSMTPNotifierFailsWhenServerIsDown(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	n, err := notifier.New(notifier.Config{Backend: notifier.BackendSMTP, From: "pb@example.com", SMTPHost: "127.0.0.1", SMTPPort: port})
+	require.NoError(t, err)
+
+	err = n.Send(context.Background(), testMessage)
+
+	assert.ErrorIs(t, err, errors.ErrUnableToSendEmail)
+}
+
+// silentSMTPServer accepts connections but never replies, and returns its port
+func silentSMTPServer(t *testing.T) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func TestSMTPNotifierTimesOut(t *testing.T) {
+	port := silentSMTPServer(t)
+	n, err := notifier.New(notifier.Config{Backend: notifier.BackendSMTP, From: "pb@example.com", SMTPHost: "127.0.0.1", SMTPPort: port, SMTPTimeout: 100 * time.Millisecond})
+	require.NoError(t, err)
+
+	start := time.Now()
+	err = n.Send(context.Background(), testMessage)
+
+	assert.ErrorIs(t, err, errors.ErrUnableToSendEmail)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestSMTPNotifierStopsWhenCancelled(t *testing.T) {
+	port := silentSMTPServer(t)
+	n, err := notifier.New(notifier.Config{Backend: notifier.BackendSMTP, From: "pb@example.com", SMTPHost: "127.0.0.1", SMTPPort: port})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start := time.Now()
+	err = n.Send(ctx, testMessage)
+
+	assert.ErrorIs(t, err, errors.ErrUnableToSendEmail)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestFileNotifier(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "emails")
+	n, err := notifier.New(notifier.Config{Backend: notifier.BackendFile, From: "pb@example.com", FileDir: dir})
+	require.NoError(t, err)
+
+	err = n.Send(context.Background(), testMessage)
+	require.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.True(t, strings.HasSuffix(files[0].Name(), "-alice@example.com.eml"))
+
+	content, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "Subject: Welcome")
+	assert.Contains(t, string(content), "<p>Hello Alice</p>")
+}
+
+func TestFileNotifierWithPlainTextAlternative(t *testing.T) {
+	dir := t.TempDir()
+	n, err := notifier.New(notifier.Config{Backend: notifier.BackendFile, From: "pb@example.com", FileDir: dir})
+	require.NoError(t, err)
+
+	msg := testMessage
+	msg.TextBody = "Hello Alice"
+	err = n.Send(context.Background(), msg)
+	require.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	content, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+
+	email := string(content)
+	assert.Contains(t, email, "Content-Type: multipart/alternative; boundary=")
+	text := strings.Index(email, "Content-Type: text/plain")
+	html := strings.Index(email, "Content-Type: text/html")
+	require.True(t, text > 0 && html > text, "the plain-text part comes before the HTML part")
+	assert.Contains(t, email[text:html], "Hello Alice")
+	assert.Contains(t, email[html:], "<p>Hello Alice</p>")
+}
+
+func TestNewNotifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  notifier.Config
+		wantErr error
+	}{
+		{name: "Success_for_log_backend", config: notifier.Config{Backend: notifier.BackendLog}},
+		{name: "Fail_for_smtp_without_host", config: notifier.Config{Backend: notifier.BackendSMTP}, wantErr: errors.ErrInvalidEnv},
+		{name: "Fail_for_file_without_directory", config: notifier.Config{Backend: notifier.BackendFile}, wantErr: errors.ErrInvalidEnv},
+		{name: "Fail_for_unknown_backend", config: notifier.Config{Backend: "pigeon"}, wantErr: errors.ErrUnknownEmailBackend},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := notifier.New(tt.config)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NoError(t, n.Send(context.Background(), testMessage))
+		})
+	}
+}
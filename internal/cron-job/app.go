@@ -1,7 +1,14 @@
 package cronjob
 
 import (
+	"context"
+	"os"
+	"strings"
+
 	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
 	"github.com/robfig/cron/v3"
 	"go.uber.org/zap"
 )
@@ -9,15 +16,149 @@ import (
 // InitCronJob initializes Cron Job for Backup purpose
 func InitCronJob(svc service.Service) {
 	zap.S().Info("Cron Job Initiated!")
-	c := cron.New()
-
+	c := cron.New(cron.WithChain(cron.Recover(cron.DefaultLogger)))
 	BackupAllProfilesJob(svc, c)
-
+	VerifyBackupJob(svc, c)
+	SyncEmployeesJob(svc, c)
+	ProcessIntranetWebhookEventsJob(svc, c)
+	SendOutboxEmailsJob(svc, c)
+	SendInvitationRemindersJob(svc, c)
+	SendStaleProfileRemindersJob(svc, c)
+	SendProfileDigestJob(svc, c)
 	zap.S().Info("Cron Job Started...")
 	c.Start()
 }
 
-// BackupAllProfilesJob returns a service func that runs cron job
+// BackupAllProfilesJob backs the database up to BACKUP_DIR every midnight and then deletes the archives the
+// retention policy no longer keeps. It is not scheduled while BACKUP_DIR is not set.
 func BackupAllProfilesJob(svc service.Service, cron *cron.Cron) {
-	cron.AddFunc("0 0 * * *", func() { svc.BackupAllProfiles() }) // FOR EVERY MIDNIGHT BACKUP
+	if os.Getenv(constants.BackupDirEnvVar) == "" {
+		zap.S().Info("BACKUP_DIR is not set, backups are not scheduled")
+		return
+	}
+
+	cron.AddFunc(constants.BackupSchedule, func() {
+		ctx := context.Background()
+		_, err := svc.BackupAllProfiles(ctx)
+		if err != nil {
+			zap.S().Error("Scheduled backup failed: ", err)
+			return
+		}
+		_, err = svc.RotateBackups(ctx)
+		if err != nil {
+			zap.S().Error("Rotating backups failed: ", err)
+		}
+	})
+}
+
+// VerifyBackupJob test restores the latest backup, daily by default, on BACKUP_VERIFY_SCHEDULE. It is not scheduled
+// while BACKUP_DIR is not set.
+func VerifyBackupJob(svc service.Service, cron *cron.Cron) {
+	if os.Getenv(constants.BackupDirEnvVar) == "" {
+		return
+	}
+
+	addScheduledJob(cron, constants.BackupVerifyScheduleEnvVar, constants.DefaultBackupVerifySchedule, func() {
+		_, err := svc.VerifyLatestBackup(context.Background())
+		if err != nil && err != errors.ErrNoBackup {
+			zap.S().Error("Scheduled backup verification failed: ", err)
+		}
+	})
+}
+
+// SyncEmployeesJob schedules the intranet employee sync: an incremental sync on INTRANET_SYNC_SCHEDULE and a full
+// sync, which also looks for leavers, on INTRANET_FULL_SYNC_SCHEDULE. Either schedule can be turned off with "off",
+// and neither runs when the intranet is not configured.
+func SyncEmployeesJob(svc service.Service, cron *cron.Cron) {
+	if os.Getenv("INTRANET_API_BASE_URL") == "" || os.Getenv("INTRANET_API_KEY") == "" {
+		zap.S().Info("Intranet is not configured, the employee sync is not scheduled")
+		return
+	}
+
+	deactivateLeavers := os.Getenv(constants.IntranetSyncDeactivateLeaversEnvVar) == "true"
+	addSyncEmployeesJob(svc, cron, constants.IntranetSyncScheduleEnvVar, constants.DefaultIntranetSyncSchedule,
+		specs.SyncEmployeesOptions{Incremental: true, DeactivateLeavers: deactivateLeavers})
+	addSyncEmployeesJob(svc, cron, constants.IntranetFullSyncScheduleEnvVar, constants.DefaultIntranetFullSyncSchedule,
+		specs.SyncEmployeesOptions{DeactivateLeavers: deactivateLeavers})
+}
+
+// addSyncEmployeesJob schedules a sync with the given options on the schedule read from envVar
+func addSyncEmployeesJob(svc service.Service, cron *cron.Cron, envVar string, defaultSchedule string, opts specs.SyncEmployeesOptions) {
+	schedule, ok := scheduleFromEnv(envVar, defaultSchedule)
+	if !ok {
+		zap.S().Info(envVar, " is off, not scheduling this employee sync")
+		return
+	}
+
+	_, err := cron.AddFunc(schedule, func() {
+		_, _, err := svc.RunEmployeeSync(context.Background(), constants.SyncTriggerSchedule, 0, opts)
+		if err != nil && err != errors.ErrSyncInProgress {
+			zap.S().Error("Scheduled employee sync failed: ", err)
+		}
+	})
+	if err != nil {
+		zap.S().Errorf("Invalid %s %q, the employee sync is not scheduled: %v", envVar, schedule, err)
+	}
+}
+
+// ProcessIntranetWebhookEventsJob retries the intranet events that failed, and processes any the server did not
+// get to, every minute. It is not scheduled while the webhook is not configured.
+func ProcessIntranetWebhookEventsJob(svc service.Service, cron *cron.Cron) {
+	if os.Getenv(constants.IntranetWebhookSecretEnvVar) == "" {
+		zap.S().Info("Intranet webhook is not configured, intranet events are not retried")
+		return
+	}
+
+	cron.AddFunc(constants.WebhookRetrySchedule, func() { svc.ProcessIntranetWebhookEvents(context.Background()) })
+}
+
+// SendOutboxEmailsJob sends the emails in the outbox that are due every minute: those the server did not get to
+// straight away and the failed ones whose retry is due.
+func SendOutboxEmailsJob(svc service.Service, cron *cron.Cron) {
+	cron.AddFunc(constants.EmailOutboxSchedule, func() { svc.SendOutboxEmails(context.Background()) })
+}
+
+// SendInvitationRemindersJob reminds employees every hour of the invitations they have not completed as their
+// expiry gets closer.
+func SendInvitationRemindersJob(svc service.Service, cron *cron.Cron) {
+	cron.AddFunc(constants.InvitationReminderSchedule, func() { svc.SendInvitationReminders(context.Background()) })
+}
+
+// SendStaleProfileRemindersJob reminds the employees of stale profiles to update them, daily by default, on
+// STALE_PROFILE_REMINDER_SCHEDULE.
+func SendStaleProfileRemindersJob(svc service.Service, cron *cron.Cron) {
+	addScheduledJob(cron, constants.StaleProfileReminderScheduleEnvVar, constants.DefaultStaleProfileReminderSchedule, func() {
+		svc.SendStaleProfileReminders(context.Background())
+	})
+}
+
+// SendProfileDigestJob emails the profile digest, every Monday morning by default, on PROFILE_DIGEST_SCHEDULE.
+func SendProfileDigestJob(svc service.Service, cron *cron.Cron) {
+	addScheduledJob(cron, constants.ProfileDigestScheduleEnvVar, constants.DefaultProfileDigestSchedule, func() {
+		svc.SendProfileDigest(context.Background())
+	})
+}
+
+// addScheduledJob schedules job on the schedule read from envVar, unless it is off
+func addScheduledJob(cron *cron.Cron, envVar string, defaultSchedule string, job func()) {
+	schedule, ok := scheduleFromEnv(envVar, defaultSchedule)
+	if !ok {
+		zap.S().Info(envVar, " is off, not scheduling this job")
+		return
+	}
+
+	_, err := cron.AddFunc(schedule, job)
+	if err != nil {
+		zap.S().Errorf("Invalid %s %q, the job is not scheduled: %v", envVar, schedule, err)
+	}
+}
+
+// scheduleFromEnv returns the cron schedule set in envVar, or defaultSchedule if it is not set. It returns false
+// when the schedule is turned off.
+func scheduleFromEnv(envVar string, defaultSchedule string) (string, bool) {
+	schedule := strings.TrimSpace(os.Getenv(envVar))
+	if schedule == "" {
+		schedule = defaultSchedule
+	}
+	return schedule, !strings.EqualFold(schedule, constants.SyncScheduleOff)
 }
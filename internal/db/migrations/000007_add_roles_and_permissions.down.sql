@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_profiles_manager_id;
+ALTER TABLE profiles DROP CONSTRAINT IF EXISTS fk_profile_manager_id;
+ALTER TABLE profiles DROP COLUMN IF EXISTS manager_id;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS fk_user_role;
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'admin';
+
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,80 @@
+CREATE TABLE IF NOT EXISTS roles (
+	id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	name VARCHAR(50) NOT NULL UNIQUE,
+	description TEXT NOT NULL DEFAULT '',
+	is_system BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- scope limits which profiles a permission applies to: every profile, the
+-- profiles of the user's reportees, or only the user's own profile
+CREATE TABLE IF NOT EXISTS role_permissions (
+	role_id INT NOT NULL,
+	permission VARCHAR(100) NOT NULL,
+	scope VARCHAR(20) NOT NULL DEFAULT 'all' CHECK (scope IN ('all', 'reportees', 'own')),
+
+	PRIMARY KEY (role_id, permission),
+
+	CONSTRAINT fk_role_id
+		FOREIGN KEY(role_id)
+		REFERENCES roles(id)
+		ON DELETE CASCADE
+);
+
+INSERT INTO roles (name, description, is_system) VALUES
+	('admin', 'Full access to every profile and to user and role management', TRUE),
+	('employee', 'Maintains their own profile after being invited', TRUE),
+	('viewer', 'Read-only access to all profiles, e.g. for sales', FALSE),
+	('recruiter', 'Creates, edits and invites profiles', FALSE),
+	('manager', 'Read-only access to the profiles of their reportees', FALSE);
+
+INSERT INTO role_permissions (role_id, permission, scope)
+SELECT r.id, p.permission, p.scope
+FROM roles r
+JOIN (VALUES
+	('admin', 'profiles:create', 'all'),
+	('admin', 'profiles:list', 'all'),
+	('admin', 'profiles:read', 'all'),
+	('admin', 'profiles:update', 'all'),
+	('admin', 'profiles:delete', 'all'),
+	('admin', 'profiles:status', 'all'),
+	('admin', 'profiles:invite', 'all'),
+	('admin', 'profiles:submit', 'all'),
+	('admin', 'profiles:assign_manager', 'all'),
+	('admin', 'sections:read', 'all'),
+	('admin', 'sections:write', 'all'),
+	('admin', 'skills:read', 'all'),
+	('admin', 'intranet:read', 'all'),
+	('admin', 'admins:invite', 'all'),
+	('admin', 'roles:manage', 'all'),
+	('employee', 'profiles:read', 'own'),
+	('employee', 'profiles:update', 'own'),
+	('employee', 'profiles:submit', 'own'),
+	('employee', 'sections:read', 'own'),
+	('employee', 'sections:write', 'own'),
+	('viewer', 'profiles:list', 'all'),
+	('viewer', 'profiles:read', 'all'),
+	('viewer', 'sections:read', 'all'),
+	('viewer', 'skills:read', 'all'),
+	('recruiter', 'profiles:create', 'all'),
+	('recruiter', 'profiles:list', 'all'),
+	('recruiter', 'profiles:read', 'all'),
+	('recruiter', 'profiles:update', 'all'),
+	('recruiter', 'profiles:invite', 'all'),
+	('recruiter', 'sections:read', 'all'),
+	('recruiter', 'sections:write', 'all'),
+	('recruiter', 'skills:read', 'all'),
+	('recruiter', 'intranet:read', 'all'),
+	('manager', 'profiles:list', 'reportees'),
+	('manager', 'profiles:read', 'reportees'),
+	('manager', 'sections:read', 'reportees'),
+	('manager', 'skills:read', 'all')
+) AS p(role, permission, scope) ON p.role = r.name;
+
+ALTER TABLE users ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE users ADD CONSTRAINT fk_user_role FOREIGN KEY (role) REFERENCES roles(name) ON UPDATE CASCADE;
+
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS manager_id INT;
+ALTER TABLE profiles ADD CONSTRAINT fk_profile_manager_id FOREIGN KEY (manager_id) REFERENCES users(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_profiles_manager_id ON profiles (manager_id);
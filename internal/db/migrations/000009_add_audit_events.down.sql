@@ -0,0 +1,17 @@
+DELETE FROM role_permissions WHERE permission = 'audit:read';
+
+DO $$
+DECLARE
+	audited TEXT;
+BEGIN
+	FOREACH audited IN ARRAY ARRAY['profiles', 'educations', 'certificates', 'projects', 'experiences', 'achievements', 'invitations', 'users', 'roles', 'role_permissions']
+	LOOP
+		EXECUTE format('DROP TRIGGER IF EXISTS audit_%1$s ON %1$s', audited);
+	END LOOP;
+END;
+$$;
+
+DROP FUNCTION IF EXISTS record_audit_event();
+DROP TABLE IF EXISTS audit_ignored_columns;
+DROP TABLE IF EXISTS audit_events;
+DROP FUNCTION IF EXISTS reject_audit_event_change();
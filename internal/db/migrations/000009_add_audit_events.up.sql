@@ -0,0 +1,106 @@
+-- audit_events is append-only: rows are written by the record_audit_event
+-- trigger for every change to an audited table and by the application for
+-- logins and logouts, and can never be changed or removed
+CREATE TABLE IF NOT EXISTS audit_events (
+	id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	actor_id INT,
+	actor_role VARCHAR(50),
+	action VARCHAR(50) NOT NULL,
+	target_type VARCHAR(50) NOT NULL,
+	target_id INT,
+	profile_id INT,
+	before JSONB,
+	after JSONB,
+	request_id VARCHAR(64),
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_id ON audit_events(actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_profile_id ON audit_events(profile_id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_action ON audit_events(action);
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events(created_at);
+
+CREATE OR REPLACE FUNCTION reject_audit_event_change() RETURNS TRIGGER AS $$
+BEGIN
+	RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+	BEFORE UPDATE OR DELETE ON audit_events
+	FOR EACH ROW EXECUTE FUNCTION reject_audit_event_change();
+
+CREATE TRIGGER audit_events_no_truncate
+	BEFORE TRUNCATE ON audit_events
+	FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_event_change();
+
+-- columns that only record bookkeeping, such as the time of the last login;
+-- an update changing nothing else is not audited. Later migrations add the
+-- bookkeeping columns of their tables here
+CREATE TABLE IF NOT EXISTS audit_ignored_columns (
+	name VARCHAR(100) PRIMARY KEY
+);
+
+INSERT INTO audit_ignored_columns (name) VALUES ('updated_at'), ('last_login_at')
+ON CONFLICT DO NOTHING;
+
+-- the actor, role, request id and action are set for the transaction by the
+-- application with set_config('audit.*', ..., true); changes made outside the
+-- API (migrations, cron jobs, psql) are recorded without an actor
+CREATE OR REPLACE FUNCTION record_audit_event() RETURNS TRIGGER AS $$
+DECLARE
+	old_row JSONB;
+	new_row JSONB;
+	target JSONB;
+	ignored TEXT[];
+BEGIN
+	IF TG_OP <> 'INSERT' THEN
+		old_row := to_jsonb(OLD);
+	END IF;
+	IF TG_OP <> 'DELETE' THEN
+		new_row := to_jsonb(NEW);
+	END IF;
+
+	-- bookkeeping-only updates such as recording a login are not changes
+	IF TG_OP = 'UPDATE' THEN
+		ignored := ARRAY(SELECT name FROM audit_ignored_columns);
+		IF old_row - ignored = new_row - ignored THEN
+			RETURN NULL;
+		END IF;
+	END IF;
+
+	target := COALESCE(new_row, old_row);
+
+	INSERT INTO audit_events (actor_id, actor_role, action, target_type, target_id, profile_id, before, after, request_id)
+	VALUES (
+		NULLIF(current_setting('audit.actor_id', true), '')::INT,
+		NULLIF(current_setting('audit.actor_role', true), ''),
+		COALESCE(
+			NULLIF(current_setting('audit.action', true), ''),
+			CASE TG_OP WHEN 'INSERT' THEN 'create' WHEN 'UPDATE' THEN 'update' ELSE 'delete' END
+		),
+		TG_TABLE_NAME,
+		(target->>'id')::INT,
+		CASE WHEN TG_TABLE_NAME = 'profiles' THEN (target->>'id')::INT ELSE (target->>'profile_id')::INT END,
+		old_row,
+		new_row,
+		NULLIF(current_setting('audit.request_id', true), '')
+	);
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DO $$
+DECLARE
+	audited TEXT;
+BEGIN
+	FOREACH audited IN ARRAY ARRAY['profiles', 'educations', 'certificates', 'projects', 'experiences', 'achievements', 'invitations', 'users', 'roles', 'role_permissions']
+	LOOP
+		EXECUTE format('CREATE TRIGGER audit_%1$s AFTER INSERT OR UPDATE OR DELETE ON %1$s FOR EACH ROW EXECUTE FUNCTION record_audit_event()', audited);
+	END LOOP;
+END;
+$$;
+
+INSERT INTO role_permissions (role_id, permission, scope)
+SELECT id, 'audit:read', 'all' FROM roles WHERE name = 'admin'
+ON CONFLICT DO NOTHING;
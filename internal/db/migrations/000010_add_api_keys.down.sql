@@ -0,0 +1,6 @@
+DELETE FROM role_permissions WHERE permission = 'api_keys:manage';
+
+DROP TRIGGER IF EXISTS audit_api_keys ON api_keys;
+DROP TABLE IF EXISTS api_keys;
+
+DELETE FROM audit_ignored_columns WHERE name IN ('last_used_at', 'last_used_ip');
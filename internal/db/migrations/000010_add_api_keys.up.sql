@@ -0,0 +1,30 @@
+-- API keys authenticate other company services calling /api/internal. Only a
+-- SHA-256 hash of each key is stored; the prefix identifies the key without
+-- revealing it and is shown to admins
+CREATE TABLE IF NOT EXISTS api_keys (
+	id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	name VARCHAR(100) NOT NULL UNIQUE,
+	prefix VARCHAR(16) NOT NULL UNIQUE,
+	key_hash CHAR(64) NOT NULL,
+	scopes TEXT[] NOT NULL DEFAULT '{}',
+	allowed_ips TEXT[] NOT NULL DEFAULT '{}',
+	expires_at TIMESTAMP,
+	last_used_at TIMESTAMP,
+	last_used_ip VARCHAR(64),
+	revoked_at TIMESTAMP,
+	created_by_id INT,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- recording the use of an API key is not a change worth auditing
+INSERT INTO audit_ignored_columns (name) VALUES ('last_used_at'), ('last_used_ip')
+ON CONFLICT DO NOTHING;
+
+CREATE TRIGGER audit_api_keys
+	AFTER INSERT OR UPDATE OR DELETE ON api_keys
+	FOR EACH ROW EXECUTE FUNCTION record_audit_event();
+
+INSERT INTO role_permissions (role_id, permission, scope)
+SELECT id, 'api_keys:manage', 'all' FROM roles WHERE name = 'admin'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,19 @@
+DO $$
+DECLARE
+	section TEXT;
+BEGIN
+	FOREACH section IN ARRAY ARRAY['educations', 'certificates', 'projects', 'experiences', 'achievements']
+	LOOP
+		EXECUTE format('DROP TRIGGER IF EXISTS touch_profile_%1$s ON %1$s', section);
+	END LOOP;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS touch_profiles ON profiles;
+DROP FUNCTION IF EXISTS touch_parent_profile();
+DROP FUNCTION IF EXISTS touch_profile();
+
+DROP INDEX IF EXISTS idx_profiles_last_modified_at;
+ALTER TABLE profiles DROP COLUMN IF EXISTS last_modified_at;
+
+DELETE FROM audit_ignored_columns WHERE name = 'last_modified_at';
@@ -0,0 +1,43 @@
+-- last_modified_at records when a profile or any of its sections last changed
+-- so that other services can fetch only the profiles changed since their last sync
+ALTER TABLE profiles ADD COLUMN IF NOT EXISTS last_modified_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_profiles_last_modified_at ON profiles(last_modified_at, id);
+
+CREATE OR REPLACE FUNCTION touch_profile() RETURNS TRIGGER AS $$
+BEGIN
+	NEW.last_modified_at := CURRENT_TIMESTAMP;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION touch_parent_profile() RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP <> 'INSERT' THEN
+		UPDATE profiles SET last_modified_at = CURRENT_TIMESTAMP WHERE id = OLD.profile_id;
+	END IF;
+	IF TG_OP <> 'DELETE' AND (TG_OP = 'INSERT' OR NEW.profile_id IS DISTINCT FROM OLD.profile_id) THEN
+		UPDATE profiles SET last_modified_at = CURRENT_TIMESTAMP WHERE id = NEW.profile_id;
+	END IF;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER touch_profiles
+	BEFORE UPDATE ON profiles
+	FOR EACH ROW EXECUTE FUNCTION touch_profile();
+
+DO $$
+DECLARE
+	section TEXT;
+BEGIN
+	FOREACH section IN ARRAY ARRAY['educations', 'certificates', 'projects', 'experiences', 'achievements']
+	LOOP
+		EXECUTE format('CREATE TRIGGER touch_profile_%1$s AFTER INSERT OR UPDATE OR DELETE ON %1$s FOR EACH ROW EXECUTE FUNCTION touch_parent_profile()', section);
+	END LOOP;
+END;
+$$;
+
+-- touching a profile is not a change worth auditing
+INSERT INTO audit_ignored_columns (name) VALUES ('last_modified_at')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,15 @@
+-- profile_sync_changes records every profile field changed by the intranet
+-- sync, along with the policy that allowed the change
+CREATE TABLE IF NOT EXISTS profile_sync_changes (
+	id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	profile_id INT NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+	employee_id VARCHAR(255) NOT NULL,
+	field VARCHAR(50) NOT NULL,
+	old_value TEXT NOT NULL DEFAULT '',
+	new_value TEXT NOT NULL DEFAULT '',
+	policy VARCHAR(20) NOT NULL,
+	reason TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_profile_sync_changes_profile_id ON profile_sync_changes(profile_id, created_at);
@@ -0,0 +1,7 @@
+-- sync_state keeps the watermark of each incremental sync: the time the last
+-- successful run started, so that the next run only fetches later changes
+CREATE TABLE IF NOT EXISTS sync_state (
+	name VARCHAR(50) PRIMARY KEY,
+	watermark TIMESTAMPTZ NOT NULL,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
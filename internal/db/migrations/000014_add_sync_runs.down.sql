@@ -0,0 +1,2 @@
+DELETE FROM role_permissions WHERE permission = 'intranet:sync';
+DROP TABLE IF EXISTS sync_runs;
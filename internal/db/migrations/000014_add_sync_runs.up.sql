@@ -0,0 +1,27 @@
+-- sync_runs keeps the history of intranet sync runs. At most one run can be
+-- running at a time, which keeps the scheduled sync, a manual "sync now" and
+-- the sync-employees command from overlapping across server instances
+CREATE TABLE IF NOT EXISTS sync_runs (
+	id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	trigger VARCHAR(20) NOT NULL,
+	triggered_by_id INT,
+	incremental BOOLEAN NOT NULL DEFAULT FALSE,
+	deactivate_leavers BOOLEAN NOT NULL DEFAULT FALSE,
+	status VARCHAR(20) NOT NULL DEFAULT 'running',
+	started_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	finished_at TIMESTAMPTZ,
+	updated INT NOT NULL DEFAULT 0,
+	unchanged INT NOT NULL DEFAULT 0,
+	skipped INT NOT NULL DEFAULT 0,
+	leavers INT NOT NULL DEFAULT 0,
+	rejoiners INT NOT NULL DEFAULT 0,
+	errors INT NOT NULL DEFAULT 0,
+	error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sync_runs_running ON sync_runs ((TRUE)) WHERE status = 'running';
+CREATE INDEX IF NOT EXISTS idx_sync_runs_started_at ON sync_runs (started_at DESC);
+
+INSERT INTO role_permissions (role_id, permission, scope)
+SELECT id, 'intranet:sync', 'all' FROM roles WHERE name = 'admin'
+ON CONFLICT DO NOTHING;
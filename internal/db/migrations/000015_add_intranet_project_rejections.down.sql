@@ -0,0 +1 @@
+DROP TABLE IF EXISTS intranet_project_rejections;
@@ -0,0 +1,14 @@
+-- intranet_project_rejections remembers the intranet projects an employee chose
+-- not to import into their profile, with the values they were proposed with, so
+-- that the same proposal is not made again until the intranet changes it
+CREATE TABLE IF NOT EXISTS intranet_project_rejections (
+	id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	profile_id INT NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+	name_key VARCHAR(255) NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	working_start_date VARCHAR(50) NOT NULL DEFAULT '',
+	working_end_date VARCHAR(50) NOT NULL DEFAULT '',
+	rejected_by_id INT,
+	rejected_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (profile_id, name_key)
+);
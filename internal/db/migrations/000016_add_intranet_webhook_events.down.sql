@@ -0,0 +1 @@
+DROP TABLE IF EXISTS intranet_webhook_events;
@@ -0,0 +1,22 @@
+-- intranet_webhook_events stores the employee lifecycle events pushed by the
+-- intranet. The event id sent by the intranet is unique, so an event delivered
+-- more than once is stored and processed once. Events are processed in the
+-- background and retried until max attempts, after which they are left as
+-- 'dead' for an admin to look at and retry
+CREATE TABLE IF NOT EXISTS intranet_webhook_events (
+	id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	event_id VARCHAR(255) NOT NULL UNIQUE,
+	event_type VARCHAR(50) NOT NULL,
+	employee_id VARCHAR(255) NOT NULL,
+	payload JSONB NOT NULL,
+	status VARCHAR(20) NOT NULL DEFAULT 'pending',
+	attempts INT NOT NULL DEFAULT 0,
+	last_error TEXT NOT NULL DEFAULT '',
+	result JSONB,
+	received_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	processed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_intranet_webhook_events_due ON intranet_webhook_events (next_attempt_at) WHERE status IN ('pending', 'failed', 'processing');
+CREATE INDEX IF NOT EXISTS idx_intranet_webhook_events_status ON intranet_webhook_events (status, received_at DESC);
@@ -0,0 +1,2 @@
+DELETE FROM role_permissions WHERE permission = 'emails:manage';
+DROP TABLE IF EXISTS email_outbox;
@@ -0,0 +1,26 @@
+-- email_outbox holds every email the application sends. Emails are queued in
+-- the same transaction as the change they are about, so an email only goes out
+-- once that change is committed. They are sent in the background and retried
+-- until max attempts, after which they are left as 'dead' for an admin to look
+-- at and resend
+CREATE TABLE IF NOT EXISTS email_outbox (
+	id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	kind VARCHAR(50) NOT NULL,
+	recipient VARCHAR(255) NOT NULL,
+	subject TEXT NOT NULL,
+	body TEXT NOT NULL,
+	profile_id INT,
+	status VARCHAR(20) NOT NULL DEFAULT 'pending',
+	attempts INT NOT NULL DEFAULT 0,
+	last_error TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	sent_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_outbox_due ON email_outbox (next_attempt_at) WHERE status IN ('pending', 'failed', 'sending');
+CREATE INDEX IF NOT EXISTS idx_email_outbox_status ON email_outbox (status, created_at DESC);
+
+INSERT INTO role_permissions (role_id, permission, scope)
+SELECT id, 'emails:manage', 'all' FROM roles WHERE name = 'admin'
+ON CONFLICT DO NOTHING;
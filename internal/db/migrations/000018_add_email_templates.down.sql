@@ -0,0 +1,3 @@
+DELETE FROM role_permissions WHERE permission = 'email_templates:manage';
+ALTER TABLE email_outbox DROP COLUMN IF EXISTS text_body;
+DROP TABLE IF EXISTS email_templates;
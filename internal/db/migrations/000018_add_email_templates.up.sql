@@ -0,0 +1,117 @@
+-- email_templates holds the subject and bodies of every kind of email the
+-- application sends. Editing a template adds a new version, so earlier
+-- versions are kept; emails are rendered with the latest version of their kind.
+-- Templates use Go template syntax, e.g. {{.Name}}, and the HTML body is
+-- escaped as HTML when rendered
+CREATE TABLE IF NOT EXISTS email_templates (
+	id INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	kind VARCHAR(50) NOT NULL,
+	version INT NOT NULL,
+	subject TEXT NOT NULL,
+	html_body TEXT NOT NULL,
+	text_body TEXT NOT NULL,
+	created_by_id INT,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (kind, version)
+);
+
+-- the plain-text alternative sent along with the HTML body
+ALTER TABLE email_outbox ADD COLUMN IF NOT EXISTS text_body TEXT NOT NULL DEFAULT '';
+
+INSERT INTO email_templates (kind, version, subject, html_body, text_body) VALUES
+('employee_invitation', 1,
+'Action Required: Profile Successfully Created - Please Complete Your Profile',
+'<html>
+<body>
+	<div class="email-content">
+		<p>Hello {{.Name}},</p>
+		<p>We are pleased to inform you that your Josh profile has been successfully created in Profile Builder.</p>
+		<p>Please <a href="{{.ProfileLink}}">click here</a> to review your profile and update the remaining details as soon as possible.</p>
+		<p>Once all the required information has been provided, kindly submit your profile for final approval.</p>
+		<p>Feel free to reach out to Talent Acquisition Group if you have any questions or need assistance.</p>
+		<p>Best Regards,</p>
+		<p>Profile Builder Team</p>
+	</div>
+</body>
+</html>',
+'Hello {{.Name}},
+
+We are pleased to inform you that your Josh profile has been successfully created in Profile Builder.
+
+Please review your profile and update the remaining details as soon as possible: {{.ProfileLink}}
+
+Once all the required information has been provided, kindly submit your profile for final approval.
+
+Feel free to reach out to Talent Acquisition Group if you have any questions or need assistance.
+
+Best Regards,
+Profile Builder Team
+'),
+('profile_completed', 1,
+'Profile Update: Employee Profile Completed - Please Review and Download',
+'<html>
+<body>
+	<div class="email-content">
+		<p>Hello {{.Name}},</p>
+		<p>The candidate has completed their profile. Please <a href="{{.ProfileLink}}">click here</a> to review and download the profile.</p>
+		<p>Best Regards,</p>
+		<p>Profile Builder Team</p>
+	</div>
+</body>
+</html>',
+'Hello {{.Name}},
+
+The candidate has completed their profile. Please review and download the profile: {{.ProfileLink}}
+
+Best Regards,
+Profile Builder Team
+'),
+('admin_invitation', 1,
+'You have been invited as an Admin on Profile Builder',
+'<html>
+<body>
+	<div class="email-content">
+		<p>Hello {{.Name}},</p>
+		<p>You have been invited as an Admin on Profile Builder.</p>
+		<p>Please <a href="{{.LoginLink}}">click here</a> to log in and access the admin dashboard.</p>
+		<p>Best Regards,</p>
+		<p>Profile Builder Team</p>
+	</div>
+</body>
+</html>',
+'Hello {{.Name}},
+
+You have been invited as an Admin on Profile Builder.
+
+Please log in to access the admin dashboard: {{.LoginLink}}
+
+Best Regards,
+Profile Builder Team
+'),
+('leaver_notification', 1,
+'Profile Builder: An employee has left',
+'<html>
+<body>
+	<div class="email-content">
+		<p>Hello {{.Name}},</p>
+		<p>{{.EmployeeName}} is no longer listed as an employee on the intranet, so their profile has been marked as a former employee.</p>
+		<p>Please <a href="{{.ProfileLink}}">click here</a> to review the profile.</p>
+		<p>Best Regards,</p>
+		<p>Profile Builder Team</p>
+	</div>
+</body>
+</html>',
+'Hello {{.Name}},
+
+{{.EmployeeName}} is no longer listed as an employee on the intranet, so their profile has been marked as a former employee.
+
+Please review the profile: {{.ProfileLink}}
+
+Best Regards,
+Profile Builder Team
+')
+ON CONFLICT (kind, version) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission, scope)
+SELECT id, 'email_templates:manage', 'all' FROM roles WHERE name = 'admin'
+ON CONFLICT DO NOTHING;
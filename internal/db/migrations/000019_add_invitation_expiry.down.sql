@@ -0,0 +1,6 @@
+DELETE FROM email_templates WHERE kind = 'invitation_reminder';
+DROP INDEX IF EXISTS idx_invitations_pending_expiry;
+ALTER TABLE invitations DROP COLUMN IF EXISTS resent_count;
+ALTER TABLE invitations DROP COLUMN IF EXISTS last_reminded_at;
+ALTER TABLE invitations DROP COLUMN IF EXISTS reminders_sent;
+ALTER TABLE invitations DROP COLUMN IF EXISTS expires_at;
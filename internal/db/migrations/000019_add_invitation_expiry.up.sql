@@ -0,0 +1,42 @@
+-- An invitation expires at expires_at, after which the employee can no longer
+-- log in until it is resent. Reminders are emailed as the expiry gets closer;
+-- reminders_sent counts those sent since the invitation was last (re)issued.
+-- Invitations already open get the default 14 days from now, so that nobody is
+-- locked out by the upgrade
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS reminders_sent INT NOT NULL DEFAULT 0;
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS last_reminded_at TIMESTAMPTZ;
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS resent_count INT NOT NULL DEFAULT 0;
+
+UPDATE invitations SET expires_at = CURRENT_TIMESTAMP + INTERVAL '14 days' WHERE expires_at IS NULL;
+ALTER TABLE invitations ALTER COLUMN expires_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_invitations_pending_expiry ON invitations (expires_at) WHERE is_profile_complete = 0;
+
+INSERT INTO email_templates (kind, version, subject, html_body, text_body) VALUES
+('invitation_reminder', 1,
+'Reminder: Please Complete Your Profile by {{.ExpiresAt}}',
+'<html>
+<body>
+	<div class="email-content">
+		<p>Hello {{.Name}},</p>
+		<p>This is a reminder that your Josh profile in Profile Builder is still waiting for your details.</p>
+		<p>Please <a href="{{.ProfileLink}}">click here</a> to complete and submit your profile before your invitation expires on {{.ExpiresAt}}.</p>
+		<p>Feel free to reach out to Talent Acquisition Group if you have any questions or need assistance.</p>
+		<p>Best Regards,</p>
+		<p>Profile Builder Team</p>
+	</div>
+</body>
+</html>',
+'Hello {{.Name}},
+
+This is a reminder that your Josh profile in Profile Builder is still waiting for your details.
+
+Please complete and submit your profile before your invitation expires on {{.ExpiresAt}}: {{.ProfileLink}}
+
+Feel free to reach out to Talent Acquisition Group if you have any questions or need assistance.
+
+Best Regards,
+Profile Builder Team
+')
+ON CONFLICT (kind, version) DO NOTHING;
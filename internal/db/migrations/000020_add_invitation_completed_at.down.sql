@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_invitations_profile_id;
+DROP INDEX IF EXISTS idx_invitations_created_at;
+ALTER TABLE invitations DROP COLUMN IF EXISTS completed_at;
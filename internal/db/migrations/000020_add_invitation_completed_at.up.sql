@@ -0,0 +1,10 @@
+-- completed_at records when the employee completed the profile, in IST like
+-- created_at. updated_at cannot be used for it as resending an invitation
+-- updates it too. Invitations completed before this are taken to have been
+-- completed when they were last updated
+ALTER TABLE invitations ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP;
+
+UPDATE invitations SET completed_at = updated_at WHERE is_profile_complete = 1 AND completed_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_invitations_created_at ON invitations (created_at);
+CREATE INDEX IF NOT EXISTS idx_invitations_profile_id ON invitations (profile_id);
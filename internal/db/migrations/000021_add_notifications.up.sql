@@ -0,0 +1,25 @@
+-- In-app notifications shown to a user, e.g. when a profile they invited is
+-- submitted. read_at is set once the user has read the notification
+CREATE TABLE IF NOT EXISTS notifications (
+	id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	user_id INT NOT NULL,
+	kind VARCHAR(50) NOT NULL,
+	title VARCHAR(255) NOT NULL,
+	body TEXT NOT NULL DEFAULT '',
+	link TEXT NOT NULL DEFAULT '',
+	profile_id INT,
+	read_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	CONSTRAINT fk_notification_user
+		FOREIGN KEY(user_id)
+		REFERENCES users(id)
+		ON DELETE CASCADE,
+	CONSTRAINT fk_notification_profile
+		FOREIGN KEY(profile_id)
+		REFERENCES profiles(id)
+		ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications (user_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_notifications_unread ON notifications (user_id) WHERE read_at IS NULL;
@@ -0,0 +1,3 @@
+DELETE FROM role_permissions WHERE permission = 'profiles:digest';
+DELETE FROM email_templates WHERE kind IN ('stale_profile_reminder', 'profile_digest');
+DROP TABLE IF EXISTS stale_profile_reminders;
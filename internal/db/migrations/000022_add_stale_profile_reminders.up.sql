@@ -0,0 +1,75 @@
+-- stale_profile_reminders records how often, and when last, the employee of a
+-- profile that has gone stale was reminded to update it, so that they are not
+-- reminded every day. It is kept apart from profiles so that sending a reminder
+-- does not count as a change to the profile
+CREATE TABLE IF NOT EXISTS stale_profile_reminders (
+	profile_id INT PRIMARY KEY REFERENCES profiles(id) ON DELETE CASCADE,
+	reminders_sent INT NOT NULL DEFAULT 0,
+	last_reminded_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO email_templates (kind, version, subject, html_body, text_body) VALUES
+('stale_profile_reminder', 1,
+'Reminder: Please Keep Your Profile Up to Date',
+'<html>
+<body>
+	<div class="email-content">
+		<p>Hello {{.Name}},</p>
+		<p>Your Josh profile in Profile Builder was last updated on {{.LastUpdatedAt}}{{if .LatestProjectEndedAt}} and your latest project ended on {{.LatestProjectEndedAt}}{{end}}.</p>
+		<p>Please <a href="{{.ProfileLink}}">click here</a> to add the projects, skills and certifications you have worked on since.</p>
+		<p>Best Regards,</p>
+		<p>Profile Builder Team</p>
+	</div>
+</body>
+</html>',
+'Hello {{.Name}},
+
+Your Josh profile in Profile Builder was last updated on {{.LastUpdatedAt}}{{if .LatestProjectEndedAt}} and your latest project ended on {{.LatestProjectEndedAt}}{{end}}.
+
+Please add the projects, skills and certifications you have worked on since: {{.ProfileLink}}
+
+Best Regards,
+Profile Builder Team
+'),
+('profile_digest', 1,
+'Profile Builder digest: {{len .Digest.StaleProfiles}} stale, {{len .Digest.IncompleteProfiles}} incomplete, {{len .Digest.CompletedProfiles}} completed',
+'<html>
+<body>
+	<div class="email-content">
+		<p>Hello {{.Name}},</p>
+		<p>Here is how the profiles stand from {{.Digest.From}} to {{.Digest.To}}.</p>
+		<h3>Stale profiles ({{len .Digest.StaleProfiles}})</h3>
+		<ul>{{range .Digest.StaleProfiles}}<li><a href="{{.ProfileLink}}">{{.Name}}</a> - {{.Note}}</li>{{else}}<li>None</li>{{end}}</ul>
+		<h3>Incomplete profiles ({{len .Digest.IncompleteProfiles}})</h3>
+		<ul>{{range .Digest.IncompleteProfiles}}<li><a href="{{.ProfileLink}}">{{.Name}}</a> - {{.Note}}</li>{{else}}<li>None</li>{{end}}</ul>
+		<h3>Newly completed profiles ({{len .Digest.CompletedProfiles}})</h3>
+		<ul>{{range .Digest.CompletedProfiles}}<li><a href="{{.ProfileLink}}">{{.Name}}</a> - {{.Note}}</li>{{else}}<li>None</li>{{end}}</ul>
+		<p>Best Regards,</p>
+		<p>Profile Builder Team</p>
+	</div>
+</body>
+</html>',
+'Hello {{.Name}},
+
+Here is how the profiles stand from {{.Digest.From}} to {{.Digest.To}}.
+
+Stale profiles ({{len .Digest.StaleProfiles}}):
+{{range .Digest.StaleProfiles}}- {{.Name}} - {{.Note}}: {{.ProfileLink}}
+{{else}}None
+{{end}}
+Incomplete profiles ({{len .Digest.IncompleteProfiles}}):
+{{range .Digest.IncompleteProfiles}}- {{.Name}} - {{.Note}}: {{.ProfileLink}}
+{{else}}None
+{{end}}
+Newly completed profiles ({{len .Digest.CompletedProfiles}}):
+{{range .Digest.CompletedProfiles}}- {{.Name}} - {{.Note}}: {{.ProfileLink}}
+{{else}}None
+{{end}}
+Best Regards,
+Profile Builder Team
+')
+ON CONFLICT (kind, version) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission, scope)
+SELECT id, 'profiles:digest', 'all' FROM roles WHERE name = 'admin'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DELETE FROM role_permissions WHERE permission = 'backups:read';
+DROP TABLE IF EXISTS backup_verifications;
@@ -0,0 +1,18 @@
+-- backup_verifications records every test restore of a backup archive into a
+-- scratch schema: whether the archive matched its checksum and restored, the
+-- rows it held and, when it failed, why
+CREATE TABLE IF NOT EXISTS backup_verifications (
+	id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+	file_name TEXT NOT NULL,
+	checksum VARCHAR(64) NOT NULL DEFAULT '',
+	status VARCHAR(20) NOT NULL CHECK (status IN ('passed', 'failed')),
+	error TEXT NOT NULL DEFAULT '',
+	row_counts JSONB NOT NULL DEFAULT '{}',
+	verified_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_backup_verifications_file_name ON backup_verifications (file_name, id DESC);
+
+INSERT INTO role_permissions (role_id, permission, scope)
+SELECT id, 'backups:read', 'all' FROM roles WHERE name = 'admin'
+ON CONFLICT DO NOTHING;
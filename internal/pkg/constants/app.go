@@ -20,6 +20,7 @@ var CorsOptions = cors.Options{
 	AllowCredentials: true,
 	AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions, http.MethodPatch},
 	AllowedHeaders:   []string{"*"},
+	ExposedHeaders:   []string{RequestIDHeader},
 }
 
 // CreateUserColumns defines the columns required for creating a new user profile.
@@ -116,14 +117,99 @@ var RequestInvitationColumns = []string{
 	"profile_id", "is_profile_complete", "created_at", "updated_at", "created_by_id", "updated_by_id",
 }
 
-// BackupTables defines the table names required for returning a backup.
-var BackupTables = []string{"users", "profiles", "educations", "certificates", "projects", "experiences", "achievements"}
+// BackupTables defines the table names required for returning a backup, in the order they are restored in. The
+// roles come before the users that reference them by name. Audit events, API keys and the state of the intranet sync,
+// the email outbox and notifications are left out: they are history and running state, not data to restore.
+var BackupTables = []string{"roles", "role_permissions", "users", "profiles", "educations", "certificates", "projects",
+	"experiences", "achievements", "invitations", "email_templates"}
+
+// BackupMergedTables are the backup tables migrations seed rows into. Their rows are merged into the ones in the
+// database on restore, matched by role name or template kind and version, instead of being loaded into empty tables
+// with their ids.
+var BackupMergedTables = []string{"roles", "role_permissions", "email_templates"}
+
+// Backups are NDJSON archives written to BACKUP_DIR, gzipped unless BACKUP_COMPRESS is "false". The first line is
+// the header, each table row is a line of its own and the last line is the trailer with the number of rows.
+const (
+	BackupDirEnvVar      = "BACKUP_DIR"
+	BackupCompressEnvVar = "BACKUP_COMPRESS"
+	BackupFormat         = "profile_builder_backup"
+	BackupFormatVersion  = 1
+	BackupFilePrefix     = "profile_builder_backup_"
+	BackupFileExt        = ".ndjson"
+	BackupGzipExt        = ".gz"
+	// BackupPartialExt marks an archive that is still being written
+	BackupPartialExt  = ".partial"
+	BackupTimeFormat  = "20060102150405"
+	BackupFileMode    = 0o600
+	BackupDirMode     = 0o750
+	BackupImportBatch = 500
+	// BackupSchedule is the cron schedule of the backup, every midnight
+	BackupSchedule = "0 0 * * *"
+	// BackupChecksumExt is added to the name of an archive for the file with its SHA-256 checksum, written in the
+	// format of sha256sum
+	BackupChecksumExt = ".sha256"
+)
+
+// After every backup the archives in BACKUP_DIR are rotated: the latest archive of each of the last
+// BACKUP_KEEP_DAILY days, BACKUP_KEEP_WEEKLY weeks and BACKUP_KEEP_MONTHLY months that have one is kept, along with
+// the latest archive overall, and the rest are deleted. A count of 0 keeps none of that period.
+const (
+	BackupKeepDailyEnvVar    = "BACKUP_KEEP_DAILY"
+	DefaultBackupKeepDaily   = 7
+	BackupKeepWeeklyEnvVar   = "BACKUP_KEEP_WEEKLY"
+	DefaultBackupKeepWeekly  = 4
+	BackupKeepMonthlyEnvVar  = "BACKUP_KEEP_MONTHLY"
+	DefaultBackupKeepMonthly = 6
+	// BackupVerifyScheduleEnvVar is the cron schedule of the job test restoring the latest backup
+	BackupVerifyScheduleEnvVar  = "BACKUP_VERIFY_SCHEDULE"
+	DefaultBackupVerifySchedule = "0 2 * * *"
+	// BackupVerifySchema is the scratch schema a backup is test restored into. It is dropped with the transaction.
+	BackupVerifySchema = "backup_verification"
+)
+
+// Outcomes of the verification of a backup
+const (
+	BackupVerificationPassed     = "passed"
+	BackupVerificationFailed     = "failed"
+	BackupVerificationUnverified = "unverified"
+)
 
 // RequestUserColumns defines the columns required for creating a new user.
 var RequestUserColumns = []string{
-	"id", "email", "role", "name",
+	"id", "email", "role", "name", "is_active",
 }
 
+// ListUsersColumns defines the columns returned when listing users.
+var ListUsersColumns = []string{
+	"id", "name", "email", "role", "is_active", "last_login_at", "created_at", "updated_at",
+}
+
+// ListQueryParams for users
+var (
+	UserRoleStr     = "role"
+	UserIsActiveStr = "is_active"
+)
+
+// ListQueryParams for audit events
+var (
+	AuditActorIDStr    = "actor_id"
+	AuditProfileIDStr  = "profile_id"
+	AuditActionStr     = "action"
+	AuditTargetTypeStr = "target_type"
+	AuditFromStr       = "from"
+	AuditToStr         = "to"
+	AuditPageStr       = "page"
+	AuditLimitStr      = "limit"
+)
+
+// ListQueryParams for internal profile changes
+var (
+	ProfileChangesSinceStr   = "since"
+	ProfileChangesAfterIDStr = "after_id"
+	ProfileChangesLimitStr   = "limit"
+)
+
 // ListQueryParams for acheivements
 var (
 	AchievementIDsStr   = "achievement_ids"
@@ -157,6 +243,16 @@ var (
 // profileID for getting query params.
 var (
 	ProfileID = "profile_id"
+	RoleID    = "role_id"
+	APIKeyID  = "api_key_id"
+	UserID    = "user_id"
+	SyncRunID = "sync_run_id"
+	// WebhookEventID is the id of a stored intranet webhook event, not the event id sent by the intranet
+	WebhookEventID = "webhook_event_id"
+	EmailID        = "email_id"
+	// EmailTemplateKind is the kind of email a template is for, e.g. employee_invitation
+	EmailTemplateKind = "kind"
+	NotificationID    = "notification_id"
 )
 
 // ContextKey Define a custom type for context key
@@ -169,6 +265,10 @@ const (
 	AchievementIDKey ContextKey = "achievement_id"
 	UserRoleKey      ContextKey = "role"
 	Email            ContextKey = "email"
+	PermissionScope  ContextKey = "permission_scope"
+	RequestIDKey     ContextKey = "request_id"
+	AuditActionKey   ContextKey = "audit_action"
+	APIKeyIDKey      ContextKey = "api_key_id"
 )
 
 // define default values for the environment variables
@@ -221,20 +321,405 @@ var (
 	Intranet = "intranet"
 )
 
+// Permissions that can be granted to a role. Route handlers are guarded by exactly one of these.
+const (
+	PermProfilesCreate        = "profiles:create"
+	PermProfilesList          = "profiles:list"
+	PermProfilesRead          = "profiles:read"
+	PermProfilesUpdate        = "profiles:update"
+	PermProfilesDelete        = "profiles:delete"
+	PermProfilesStatus        = "profiles:status"
+	PermProfilesInvite        = "profiles:invite"
+	PermProfilesSubmit        = "profiles:submit"
+	PermProfilesAssignManager = "profiles:assign_manager"
+	PermSectionsRead          = "sections:read"
+	PermSectionsWrite         = "sections:write"
+	PermSkillsRead            = "skills:read"
+	PermIntranetRead          = "intranet:read"
+	PermAdminsInvite          = "admins:invite"
+	PermRolesManage           = "roles:manage"
+	PermUsersManage           = "users:manage"
+	PermAuditRead             = "audit:read"
+	PermAPIKeysManage         = "api_keys:manage"
+	PermIntranetSync          = "intranet:sync"
+	PermEmailsManage          = "emails:manage"
+	PermEmailTemplatesManage  = "email_templates:manage"
+	PermProfilesDigest        = "profiles:digest"
+	PermBackupsRead           = "backups:read"
+)
+
+// Permissions lists every permission known to the application along with its description.
+var Permissions = map[string]string{
+	PermProfilesCreate:        "Create profiles",
+	PermProfilesList:          "List profiles",
+	PermProfilesRead:          "View a profile",
+	PermProfilesUpdate:        "Edit basic profile details",
+	PermProfilesDelete:        "Delete profiles",
+	PermProfilesStatus:        "Change employment and active status of a profile",
+	PermProfilesInvite:        "Invite an employee to complete their profile",
+	PermProfilesSubmit:        "Submit a profile as complete",
+	PermProfilesAssignManager: "Assign the reporting manager of a profile",
+	PermSectionsRead:          "View educations, projects, experiences, certificates and achievements",
+	PermSectionsWrite:         "Add, edit, reorder and delete profile sections",
+	PermSkillsRead:            "List skills",
+	PermIntranetRead:          "Look up employees in the intranet",
+	PermAdminsInvite:          "Invite new admins",
+	PermRolesManage:           "Manage roles and assign them to users",
+	PermUsersManage:           "Create, edit, deactivate and delete users",
+	PermAuditRead:             "View the audit log",
+	PermAPIKeysManage:         "Create, rotate and revoke API keys for other services",
+	PermIntranetSync:          "Run the intranet employee sync, view its run history and retry failed intranet events",
+	PermEmailsManage:          "View queued and failed emails and resend them",
+	PermEmailTemplatesManage:  "Edit and preview the templates of the emails sent by the application",
+	PermProfilesDigest:        "Receive the weekly digest of stale, incomplete and newly completed profiles",
+	PermBackupsRead:           "View the backups of the database and whether they restore",
+}
+
+// Permission scopes limit which profiles a granted permission applies to.
+const (
+	ScopeAll       = "all"
+	ScopeReportees = "reportees"
+	ScopeOwn       = "own"
+)
+
+// Audit actions recorded in audit_events. Row changes default to create, update or delete
+// unless the service marks the transaction with a more specific action.
+const (
+	AuditActionCreate         = "create"
+	AuditActionUpdate         = "update"
+	AuditActionDelete         = "delete"
+	AuditActionStatusChange   = "status_change"
+	AuditActionInvitation     = "invitation"
+	AuditActionLogin          = "login"
+	AuditActionLogout         = "logout"
+	AuditActionIntranetSync   = "intranet_sync"
+	AuditActionIntranetImport = "intranet_import"
+	AuditActionIntranetEvent  = "intranet_event"
+)
+
+// AuditTargetUsers is the target type of audit events about user accounts, matching the table name used by the triggers
+const AuditTargetUsers = "users"
+
+// Audit log listing defaults
+const (
+	DefaultAuditEventsLimit = 100
+	MaxAuditEventsLimit     = 1000
+)
+
+// Internal profile API limits
+const (
+	DefaultProfileChangesLimit = 100
+	MaxProfileChangesLimit     = 1000
+	MaxResolveEmployeeIDs      = 500
+	// ProfileChangesLag keeps the changes made in the last few minutes out of the change feed. A change is stamped
+	// with the start of its transaction, so one still to commit may later show up behind a position already handed out.
+	ProfileChangesLag = 5 * time.Minute
+)
+
+// Intranet sync run history listing defaults
+const (
+	DefaultSyncRunsLimit = 50
+	MaxSyncRunsLimit     = 500
+)
+
+// RequestIDHeader carries the request id to and from clients
+const RequestIDHeader = "X-Request-ID"
+
+// RolePermissionsCacheTTL bounds how long role grants are cached before being re-read from the database.
+var RolePermissionsCacheTTL = 1 * time.Minute
+
 // Internal API key authentication constants.
 const (
 	APIKeyHeader = "X-API-Key"
-	APIKeyEnvVar = "PROFILE_BUILDER_API_KEY"
+	// APIKeyPrefix starts every API key so that leaked keys are easy to recognise
+	APIKeyPrefix = "pbk"
+	// APIKeyLastUsedInterval throttles how often the last use of a key is written to the database
+	APIKeyLastUsedInterval = 1 * time.Minute
+	// TrustProxyHeadersEnvVar makes API key IP allowlists use the first X-Forwarded-For address
+	TrustProxyHeadersEnvVar = "TRUST_PROXY_HEADERS"
+	// LegacyAPIKeyEnvVar is the single shared key used before API keys were managed in the database. It is still
+	// accepted, only to resolve employee ids, until it is removed in the next release.
+	// Deprecated: create an API key with the profiles:resolve scope instead.
+	LegacyAPIKeyEnvVar = "PROFILE_BUILDER_API_KEY"
 )
 
-// Default profileID for the admin is 0
+// Scopes that can be granted to an API key. Internal routes are guarded by exactly one of these.
+const (
+	APIScopeProfilesResolve = "profiles:resolve"
+	APIScopeProfilesRead    = "profiles:read"
+)
+
+// APIKeyScopes lists every API key scope along with its description.
+var APIKeyScopes = map[string]string{
+	APIScopeProfilesResolve: "Resolve employee ids to profile ids",
+	APIScopeProfilesRead:    "Read full profiles and their sections",
+}
+
+// Intranet sync policies decide, field by field, which side wins when a profile and the intranet disagree.
+// The intranet never clears a value: an empty intranet value is always ignored.
+const (
+	// SyncPolicyIntranetWins overwrites the profile value with the intranet value
+	SyncPolicyIntranetWins = "intranet_wins"
+	// SyncPolicyProfileWins keeps the profile value
+	SyncPolicyProfileWins = "profile_wins"
+	// SyncPolicyFillIfEmpty copies the intranet value only into an empty profile field
+	SyncPolicyFillIfEmpty = "fill_if_empty"
+	// IntranetSyncPoliciesEnvVar overrides the default policies, e.g. "designation=profile_wins,mobile=fill_if_empty"
+	IntranetSyncPoliciesEnvVar = "INTRANET_SYNC_POLICIES"
+)
+
+// Outcome of the intranet sync for one employee, and why it was skipped
+const (
+	SyncStatusUpdated           = "updated"
+	SyncStatusWouldUpdate       = "would_update"
+	SyncStatusUnchanged         = "unchanged"
+	SyncStatusSkipped           = "skipped"
+	SyncSkipNoProfile           = "no_profile"
+	SyncSkipDuplicateEmployeeID = "duplicate_employee_id"
+	SyncSkipUpdateError         = "update_error"
+	EmploymentLeft              = "left"
+	EmploymentRejoined          = "rejoined"
+	// IntranetStatusExited is the status the intranet gives employees who have left
+	IntranetStatusExited = "exited"
+	// SyncStateIntranetEmployees names the watermark of the intranet employee sync in sync_state
+	SyncStateIntranetEmployees = "intranet_employees"
+)
+
+// Intranet projects are proposed to an existing profile as an addition, or as an update of the profile project
+// with the same name
+const (
+	IntranetProjectAdd    = "add"
+	IntranetProjectUpdate = "update"
+)
+
+// Leavers are only looked for when the intranet lists employees, and a run that would mark more than
+// MaxLeaverFraction of the current employees as leavers, and more than MinLeaversChecked of them, is
+// taken to be a broken intranet response and marks nobody
+const (
+	MaxLeaverFraction = 0.2
+	MinLeaversChecked = 5
+)
+
+// An intranet sync run is started by the server's schedule, by an admin from the API or by the sync-employees
+// command, and only one runs at a time. A run still marked as running after SyncRunStaleAfter is taken to have
+// died with its process and is marked as failed, so that it does not block later runs.
+const (
+	SyncTriggerSchedule = "schedule"
+	SyncTriggerManual   = "manual"
+	SyncTriggerCommand  = "command"
+	SyncRunRunning      = "running"
+	SyncRunSucceeded    = "succeeded"
+	SyncRunFailed       = "failed"
+	SyncRunsLimitStr    = "limit"
+	SyncRunStaleAfter   = 6 * time.Hour
+	// IntranetSyncScheduleEnvVar is the cron schedule of the incremental sync; "off" disables it
+	IntranetSyncScheduleEnvVar = "INTRANET_SYNC_SCHEDULE"
+	// IntranetFullSyncScheduleEnvVar is the cron schedule of the full sync, which also looks for leavers; "off" disables it
+	IntranetFullSyncScheduleEnvVar = "INTRANET_FULL_SYNC_SCHEDULE"
+	// IntranetSyncDeactivateLeaversEnvVar makes scheduled syncs also deactivate the profiles of leavers
+	IntranetSyncDeactivateLeaversEnvVar = "INTRANET_SYNC_DEACTIVATE_LEAVERS"
+	DefaultIntranetSyncSchedule         = "0 * * * *"
+	DefaultIntranetFullSyncSchedule     = "30 1 * * *"
+	SyncScheduleOff                     = "off"
+)
+
+// The intranet pushes employee lifecycle events to the webhook, signed with HMAC-SHA256 of the body under
+// INTRANET_WEBHOOK_SECRET and sent as "sha256=<hex>" in IntranetSignatureHeader. Events are stored and processed in
+// the background, and a failed event is retried after WebhookRetryBackoff, doubling up to MaxWebhookRetryBackoff,
+// until it has been tried INTRANET_WEBHOOK_MAX_ATTEMPTS times, after which it is dead.
+const (
+	IntranetEventEmployeeCreated         = "employee.created"
+	IntranetEventEmployeeUpdated         = "employee.updated"
+	IntranetEventEmployeeExited          = "employee.exited"
+	IntranetEventEmployeeProjectAssigned = "employee.project_assigned"
+	WebhookEventPending                  = "pending"
+	WebhookEventProcessing               = "processing"
+	WebhookEventProcessed                = "processed"
+	WebhookEventFailed                   = "failed"
+	WebhookEventDead                     = "dead"
+	WebhookEventsStatusStr               = "status"
+	WebhookEventsLimitStr                = "limit"
+	DefaultWebhookEventsLimit            = 50
+	MaxWebhookEventsLimit                = 500
+	// WebhookEventsBatchSize is the number of due events processed by each run of the retry job
+	WebhookEventsBatchSize = 50
+	// WebhookProcessingLease is how long an event being processed is left alone before it is taken to have been abandoned
+	WebhookProcessingLease      = 5 * time.Minute
+	WebhookRetryBackoff         = time.Minute
+	MaxWebhookRetryBackoff      = time.Hour
+	DefaultWebhookMaxAttempts   = 5
+	MaxWebhookPayloadBytes      = 1 << 20
+	IntranetSignatureHeader     = "X-Intranet-Signature"
+	IntranetWebhookSecretEnvVar = "INTRANET_WEBHOOK_SECRET"
+	// IntranetWebhookMaxAttemptsEnvVar is the number of times an event is tried before it is dead
+	IntranetWebhookMaxAttemptsEnvVar = "INTRANET_WEBHOOK_MAX_ATTEMPTS"
+	// WebhookRetrySchedule is the cron schedule of the job retrying failed events
+	WebhookRetrySchedule = "* * * * *"
+)
+
+// Emails are queued in the email outbox along with the change they are about and sent in the background once it is
+// committed. A failed email is retried after EmailRetryBackoff, doubling up to MaxEmailRetryBackoff, until it has
+// been tried EMAIL_MAX_ATTEMPTS times, after which it is dead.
+const (
+	EmailPending            = "pending"
+	EmailSending            = "sending"
+	EmailSent               = "sent"
+	EmailFailed             = "failed"
+	EmailDead               = "dead"
+	EmailsStatusStr         = "status"
+	EmailsLimitStr          = "limit"
+	DefaultEmailsLimit      = 50
+	MaxEmailsLimit          = 500
+	EmailOutboxBatchSize    = 50
+	EmailSendingLease       = 5 * time.Minute
+	EmailRetryBackoff       = time.Minute
+	MaxEmailRetryBackoff    = time.Hour
+	DefaultEmailMaxAttempts = 5
+	// EmailMaxAttemptsEnvVar is the number of times an email is tried before it is dead
+	EmailMaxAttemptsEnvVar = "EMAIL_MAX_ATTEMPTS"
+	// EmailOutboxSchedule is the cron schedule of the job sending due emails
+	EmailOutboxSchedule = "* * * * *"
+)
+
+// Kinds of email sent by the application
+const (
+	EmailKindEmployeeInvitation = "employee_invitation"
+	EmailKindProfileCompleted   = "profile_completed"
+	EmailKindAdminInvitation    = "admin_invitation"
+	EmailKindLeaverNotification = "leaver_notification"
+	EmailKindInvitationReminder = "invitation_reminder"
+	EmailKindStaleProfile       = "stale_profile_reminder"
+	EmailKindProfileDigest      = "profile_digest"
+)
+
+// EmailKinds lists the kinds of email that have a template
+var EmailKinds = map[string]bool{
+	EmailKindEmployeeInvitation: true,
+	EmailKindProfileCompleted:   true,
+	EmailKindAdminInvitation:    true,
+	EmailKindLeaverNotification: true,
+	EmailKindInvitationReminder: true,
+	EmailKindStaleProfile:       true,
+	EmailKindProfileDigest:      true,
+}
+
+// An employee invitation expires INVITATION_EXPIRY_DAYS after it is sent or resent, after which the employee cannot
+// log in until it is resent. Reminders are emailed INVITATION_REMINDER_DAYS days before it expires, e.g. "7,3,1".
+const (
+	InvitationExpiryDaysEnvVar    = "INVITATION_EXPIRY_DAYS"
+	DefaultInvitationExpiryDays   = 14
+	InvitationReminderDaysEnvVar  = "INVITATION_REMINDER_DAYS"
+	DefaultInvitationReminderDays = "7,3,1"
+	// InvitationReminderSchedule is the cron schedule of the job sending invitation reminders
+	InvitationReminderSchedule = "0 * * * *"
+	// InvitationExpiryFormat is how the expiry of an invitation is written in emails
+	InvitationExpiryFormat = "02 Jan 2006"
+)
+
+// A profile of a current employee is stale when it has not been updated for STALE_PROFILE_DAYS or their latest
+// project ended STALE_PROJECT_DAYS ago. Its employee is reminded at most every STALE_PROFILE_REMINDER_INTERVAL_DAYS,
+// and the holders of profiles:digest are emailed a digest of the stale, incomplete and newly completed profiles.
+// Either job can be turned off by setting its schedule to "off".
+const (
+	StaleProfileDaysEnvVar                  = "STALE_PROFILE_DAYS"
+	DefaultStaleProfileDays                 = 180
+	StaleProjectDaysEnvVar                  = "STALE_PROJECT_DAYS"
+	DefaultStaleProjectDays                 = 180
+	StaleProfileReminderIntervalDaysEnvVar  = "STALE_PROFILE_REMINDER_INTERVAL_DAYS"
+	DefaultStaleProfileReminderIntervalDays = 30
+	// StaleProfileReminderScheduleEnvVar is the cron schedule of the job reminding employees of stale profiles
+	StaleProfileReminderScheduleEnvVar  = "STALE_PROFILE_REMINDER_SCHEDULE"
+	DefaultStaleProfileReminderSchedule = "0 10 * * *"
+	// ProfileDigestScheduleEnvVar is the cron schedule of the profile digest
+	ProfileDigestScheduleEnvVar  = "PROFILE_DIGEST_SCHEDULE"
+	DefaultProfileDigestSchedule = "0 9 * * 1"
+	// ProfileDigestPeriod is how far back the digest looks for newly completed profiles
+	ProfileDigestPeriod = 7 * 24 * time.Hour
+	// ProfileDateFormat is how the dates of a profile are written in emails
+	ProfileDateFormat = "02 Jan 2006"
+)
+
+// Layouts the working end date of a project is read with. A project without a readable end date is taken to be
+// ongoing.
+var ProjectEndDateLayouts = []string{time.DateOnly, "Jan-2006", "January-2006", "01-2006", "2006-01", "Jan 2006", "January 2006"}
+
+// Statuses of an invitation. A pending invitation is open and has not expired, an overdue one has.
+const (
+	InvitationPending   = "pending"
+	InvitationOverdue   = "overdue"
+	InvitationCompleted = "completed"
+)
+
+// ListQueryParams for invitations
 var (
-	AdminProfileID = 0
+	InvitationsStatusStr    = "status"
+	InvitationsInvitedByStr = "invited_by"
+	InvitationsFromStr      = "from"
+	InvitationsToStr        = "to"
+	InvitationsPageStr      = "page"
+	InvitationsLimitStr     = "limit"
+)
+
+// Invitation listing defaults
+const (
+	DefaultInvitationsLimit = 100
+	MaxInvitationsLimit     = 1000
 )
 
-// // Email subject for send invitation and update profile
+// Kinds of in-app notifications
+const (
+	NotificationInvitationSent   = "invitation_sent"
+	NotificationProfileSubmitted = "profile_submitted"
+	NotificationIntranetSync     = "intranet_sync"
+)
+
+// ListQueryParams for notifications
 var (
-	EmployeeInvitationSubject = "Action Required: Profile Successfully Created - Please Complete Your Profile"
-	AdminRequestSubject       = "Profile Update: Employee Profile Completed - Please Review and Download"
-	AdminInvitationSubject    = "You have been invited as an Admin on Profile Builder"
+	NotificationsUnreadStr = "unread"
+	NotificationsPageStr   = "page"
+	NotificationsLimitStr  = "limit"
+)
+
+// Notification listing defaults
+const (
+	DefaultNotificationsLimit = 50
+	MaxNotificationsLimit     = 200
+)
+
+// Notification stream events. The unread count is sent when the stream opens, whenever it changes and every
+// NotificationStreamHeartbeat, which also keeps idle connections open.
+const (
+	NotificationStreamEventNotification = "notification"
+	NotificationStreamEventUnread       = "unread"
+	NotificationStreamHeartbeat         = 30 * time.Second
+	// NotificationStreamBuffer is how many events a slow stream can fall behind before events are dropped for it
+	NotificationStreamBuffer = 16
+)
+
+// IntranetEventTypes lists the intranet webhook events that are accepted
+var IntranetEventTypes = map[string]bool{
+	IntranetEventEmployeeCreated:         true,
+	IntranetEventEmployeeUpdated:         true,
+	IntranetEventEmployeeExited:          true,
+	IntranetEventEmployeeProjectAssigned: true,
+}
+
+// IntranetSyncPolicies is the default policy of every profile field synced from the intranet, keyed by profile column.
+// The employee id is not listed: it identifies the employee and is always taken from the intranet.
+var IntranetSyncPolicies = map[string]string{
+	"name":                SyncPolicyProfileWins,
+	"gender":              SyncPolicyFillIfEmpty,
+	"mobile":              SyncPolicyIntranetWins,
+	"designation":         SyncPolicyIntranetWins,
+	"josh_joining_date":   SyncPolicyIntranetWins,
+	"years_of_experience": SyncPolicyFillIfEmpty,
+	"primary_skills":      SyncPolicyFillIfEmpty,
+	"secondary_skills":    SyncPolicyFillIfEmpty,
+	"linkedin_link":       SyncPolicyFillIfEmpty,
+	"github_link":         SyncPolicyFillIfEmpty,
+}
+
+// Default profileID for the admin is 0
+var (
+	AdminProfileID = 0
 )
@@ -8,7 +8,6 @@ var (
 	ErrParameterMissing       = errors.New("parameter missing")
 	ErrInvalidID              = errors.New("invalid id")
 	ErrEmptyPayload           = errors.New("empty payload array")
-	ErrSecretKey              = errors.New("secret key not found")
 	ErrEmailNotFound          = errors.New("email not found")
 	ErrDecodeRequest          = errors.New("unable to decode request")
 	ErrGoogleRequest          = errors.New("unable to send request to google")
@@ -33,7 +32,9 @@ var (
 	ErrFailedToUpdateRecord   = errors.New("failed to update record")
 	ErrComponentNotSuppoerted = errors.New("component name not supported")
 	ErrUnableToSendEmail      = errors.New("unable to send email")
+	ErrUnknownEmailBackend    = errors.New("unknown email backend")
 	ErrFailedToGet            = errors.New("failed to get data")
+	ErrFailedToCreate         = errors.New("failed to create record")
 	ErrUserRole               = errors.New("error in parsing role from claims")
 	ErrEmail                  = errors.New("error in parsing email from claims")
 	ErrProfileID              = errors.New("error in parsing profileID from claims")
@@ -43,12 +44,102 @@ var (
 	ErrProfileExists          = errors.New("profile already exists for this employee id")
 )
 
+// JWT signing key errors
+var (
+	ErrSigningKeyNotConfigured = errors.New("jwt signing key is not configured")
+	ErrInvalidKey              = errors.New("invalid or unsupported jwt key")
+	ErrMissingKeyID            = errors.New("token is missing the kid header")
+	ErrUnknownKeyID            = errors.New("token signed with an unknown key")
+)
+
+// Role and permission errors
+var (
+	ErrPermissionDenied  = errors.New("permission denied")
+	ErrUnknownPermission = errors.New("unknown permission")
+	ErrInvalidScope      = errors.New("invalid permission scope")
+	ErrInvalidRoleName   = errors.New("role name is required and may only contain lowercase letters, digits and underscores")
+	ErrSystemRole        = errors.New("system roles cannot be modified or deleted")
+	ErrRoleInUse         = errors.New("role is still assigned to users")
+	ErrRoleNotFound      = errors.New("role not found")
+	ErrPermissionCheck   = errors.New("unable to verify permissions")
+)
+
+// User management errors
+var (
+	ErrUserInactive   = errors.New("user account is deactivated")
+	ErrLastAdmin      = errors.New("at least one active admin is required")
+	ErrSelfManagement = errors.New("you cannot deactivate, demote or delete your own account")
+	ErrUserNotFound   = errors.New("user not found")
+)
+
 // Internal API key authentication errors
 var (
-	ErrAPIKeyMissing = errors.New("missing API key: X-API-Key header is required")
-	ErrAPIKeyInvalid = errors.New("invalid API key: access denied")
+	ErrAPIKeyMissing    = errors.New("missing API key: X-API-Key header is required")
+	ErrAPIKeyInvalid    = errors.New("invalid API key: access denied")
+	ErrAPIKeyExpired    = errors.New("API key has expired")
+	ErrAPIKeyScope      = errors.New("API key lacks the scope required for this endpoint")
+	ErrAPIKeyIP         = errors.New("API key is not allowed from this address")
+	ErrAPIKeyNotFound   = errors.New("API key not found")
+	ErrAPIKeyRevoked    = errors.New("API key has been revoked")
+	ErrUnknownAPIScope  = errors.New("unknown API key scope")
+	ErrInvalidIPAddress = errors.New("invalid IP address or CIDR range")
+)
+
+// Intranet errors
+var (
+	ErrInvalidSyncPolicy   = errors.New("invalid intranet sync policy")
+	ErrDuplicateEmployeeID = errors.New("employee id already belongs to another profile")
+	ErrIntranetUnavailable = errors.New("intranet is unavailable, please try again later")
+	ErrSyncInProgress      = errors.New("an intranet sync is already running")
+	ErrSyncRunNotFound     = errors.New("intranet sync run not found")
+	ErrNoEmployeeID        = errors.New("profile has no employee id to look up in the intranet")
+	ErrProjectNotProposed  = errors.New("intranet project is not proposed for this profile")
+	ErrDuplicateDecision   = errors.New("intranet project decided more than once")
+)
+
+// Intranet webhook errors
+var (
+	ErrWebhookNotConfigured     = errors.New("intranet webhook is not configured")
+	ErrInvalidWebhookSignature  = errors.New("invalid intranet webhook signature")
+	ErrInvalidWebhookEvent      = errors.New("intranet event needs an id, a known type and an employee id")
+	ErrWebhookEventNotFound     = errors.New("intranet event not found")
+	ErrWebhookEventNotRetryable = errors.New("only failed or dead intranet events can be retried")
+)
+
+// Email outbox errors
+var (
+	ErrOutboxEmailNotFound = errors.New("email not found in the outbox")
+	ErrEmailNotResendable  = errors.New("the email is already waiting to be sent")
 )
 
+// Invitation errors
+var (
+	ErrInvitationNotFound = errors.New("the profile has no open invitation")
+	ErrInvitationExpired  = errors.New("your invitation has expired, please ask for it to be resent")
+)
+
+// Notification errors
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+	ErrStreamingUnsupported = errors.New("streaming is not supported")
+)
+
+// Email template errors
+var (
+	ErrEmailTemplateNotFound = errors.New("email template not found")
+	ErrInvalidEmailTemplate  = errors.New("invalid email template")
+)
+
+// IsIntranetUnavailable reports whether err, or any error it wraps, means the intranet could not be reached
+func IsIntranetUnavailable(err error) bool {
+	return errors.Is(err, ErrIntranetUnavailable)
+}
+
+// IsInvalidEmailTemplate reports whether err, or any error it wraps, means an email template does not render
+func IsInvalidEmailTemplate(err error) bool {
+	return errors.Is(err, ErrInvalidEmailTemplate)
+}
+
 // Profile Related variables
 var (
 	ErrInvalidFormat          = errors.New("invalid request format")
@@ -81,3 +172,15 @@ func (e ProfileExistsError) Error() string {
 func (e ProfileExistsError) Is(target error) bool {
 	return target == ErrProfileExists
 }
+
+// Backup errors
+var (
+	ErrBackupDirNotSet       = errors.New("BACKUP_DIR is not set")
+	ErrInvalidBackup         = errors.New("invalid backup archive")
+	ErrBackupSchemaMismatch  = errors.New("the backup was taken at another schema version than the database")
+	ErrRestoreTargetNotEmpty = errors.New("the database to restore into is not empty")
+	ErrDirtySchemaMigration  = errors.New("the database schema migration is dirty")
+	ErrBackupChecksum        = errors.New("the backup archive does not match its checksum")
+	ErrNoBackup              = errors.New("no backup found")
+	ErrBackupRolesMissing    = errors.New("users of the backup have roles that are neither in the backup nor in the database")
+)
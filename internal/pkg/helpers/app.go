@@ -2,10 +2,10 @@ package helpers
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"log"
 	"net/http"
-	"os"
 	"strconv"
 	"strings"
 	"sync"
@@ -16,9 +16,6 @@ import (
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
-	"github.com/sendgrid/sendgrid-go"
-	"github.com/sendgrid/sendgrid-go/helpers/mail"
-	"go.uber.org/zap"
 )
 
 // SendRequest used to check valid login request
@@ -150,6 +147,23 @@ func GetMultipleParams(r *http.Request) (int, int, error) {
 	return profileID, id, nil
 }
 
+// DecodeUsersRequest decode Users request and returns a filter
+func DecodeUsersRequest(r *http.Request) (specs.ListUsersFilter, error) {
+	filter := specs.ListUsersFilter{
+		Role: strings.TrimSpace(r.URL.Query().Get(constants.UserRoleStr)),
+	}
+
+	isActive := r.URL.Query().Get(constants.UserIsActiveStr)
+	if isActive != "" {
+		value, err := strconv.ParseBool(isActive)
+		if err != nil {
+			return specs.ListUsersFilter{}, errors.ErrInvalidRequestData
+		}
+		filter.IsActive = &value
+	}
+	return filter, nil
+}
+
 // DecodeAchievementRequest Decode the ListFilter
 func DecodeAchievementRequest(r *http.Request) (specs.ListAchievementFilter, error) {
 	achievementIDs := r.URL.Query().Get(constants.AchievementIDsStr)
@@ -264,57 +278,6 @@ func DecodeExperiencesRequest(r *http.Request) (specs.ListExperiencesFilter, err
 	return filter, nil
 }
 
-// GetQueryStrings returns the string values which is coming from the query parameters
-func getEmailConfig() (from, apiKey string) {
-	from = os.Getenv("FROM_EMAIL")
-	apiKey = os.Getenv("SENDGRID_API_KEY")
-	return
-}
-
-// SendAdminInvitation sends an admin invitation email
-func SendAdminInvitation(email, name string, profileID int) error {
-	message := ConstructAdminEmailMessage(email, name, profileID)
-	return SendInvitation(email, constants.AdminRequestSubject, message)
-}
-
-// SendAdminWelcomeInvitation sends an admin welcome invitation email
-func SendAdminWelcomeInvitation(email, name string) error {
-	message := ConstructAdminInviteMessage(name)
-	return SendInvitation(email, constants.AdminInvitationSubject, message)
-}
-
-// SendUserInvitation sends a user invitation email
-func SendUserInvitation(email, name string, profileID int) error {
-	message := ConstructUserMessage(email, name, profileID)
-	return SendInvitation(email, constants.EmployeeInvitationSubject, message)
-}
-
-// SendInvitation sends an invitation email
-func SendInvitation(emailstr string, subject string, message string) error {
-	email := ConvertToLowerCase(emailstr)
-	from, sendgridAPIKey := getEmailConfig()
-	client := sendgrid.NewSendClient(sendgridAPIKey)
-
-	fromEmail := mail.NewEmail(constants.Intranet, from)
-	toEmail := mail.NewEmail("", email)
-	plainTextContent := message
-	htmlContent := message
-	msg := mail.NewSingleEmail(fromEmail, subject, toEmail, plainTextContent, htmlContent)
-
-	response, err := client.Send(msg)
-	if err != nil {
-		zap.S().Error("failed to send email : ", err)
-		return err
-	}
-
-	if response.StatusCode >= 200 && response.StatusCode < 300 {
-		zap.S().Info("Email sent successfully for email : ", email)
-	} else {
-		zap.S().Error("Failed to send email", zap.String("email", email), zap.String("response", response.Body))
-	}
-	return nil
-}
-
 // GetProfileID returns the profile_id from the request
 func GetProfileID(r *http.Request) (int, error) {
 	vars := mux.Vars(r)
@@ -330,29 +293,119 @@ func GetProfileID(r *http.Request) (int, error) {
 	return profileID, nil
 }
 
-// ProfileIDNotRequiredPath returns true if the profile_id is not required for the given path
-func ProfileIDNotRequiredPath(r *http.Request) bool {
-	if strings.HasPrefix(r.URL.Path, "/api/profiles/resolve/") {
-		return true
+// define the global variable for the store the token, mapped to the id of the user it was issued to
+var (
+	TokenList       = make(map[string]int64)
+	WhiteListMutext = &sync.Mutex{}
+)
+
+// RevokeUserTokens removes every whitelisted token issued to the given user and returns how many were removed
+func RevokeUserTokens(userID int64) int {
+	WhiteListMutext.Lock()
+	defer WhiteListMutext.Unlock()
+
+	revoked := 0
+	for token, tokenUserID := range TokenList {
+		if tokenUserID == userID {
+			delete(TokenList, token)
+			revoked++
+		}
 	}
-	if strings.HasPrefix(r.URL.Path, "/api/intranet/employees/") {
-		return true
+	return revoked
+}
+
+// GetRequestID returns the id of the request being served, or an empty string outside of a request
+func GetRequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(constants.RequestIDKey).(string)
+	return requestID
+}
+
+// WithAuditAction marks the changes made with ctx so that they are recorded under the given audit action
+// instead of a plain create, update or delete
+func WithAuditAction(ctx context.Context, action string) context.Context {
+	return context.WithValue(ctx, constants.AuditActionKey, action)
+}
+
+// DecodeAuditEventsRequest decode audit events request and returns a filter
+func DecodeAuditEventsRequest(r *http.Request) (specs.ListAuditEventsFilter, error) {
+	query := r.URL.Query()
+	filter := specs.ListAuditEventsFilter{
+		Action:     strings.TrimSpace(query.Get(constants.AuditActionStr)),
+		TargetType: strings.TrimSpace(query.Get(constants.AuditTargetTypeStr)),
+		Page:       1,
+		Limit:      constants.DefaultAuditEventsLimit,
+	}
+
+	intParams := map[string]*int{
+		constants.AuditActorIDStr:   &filter.ActorID,
+		constants.AuditProfileIDStr: &filter.ProfileID,
+		constants.AuditPageStr:      &filter.Page,
+		constants.AuditLimitStr:     &filter.Limit,
+	}
+	for name, target := range intParams {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return specs.ListAuditEventsFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), name)
+		}
+		*target = parsed
+	}
+
+	timeParams := map[string]**time.Time{
+		constants.AuditFromStr: &filter.From,
+		constants.AuditToStr:   &filter.To,
+	}
+	for name, target := range timeParams {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			parsed, err = time.Parse(time.DateOnly, value)
+		}
+		if err != nil {
+			return specs.ListAuditEventsFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), name)
+		}
+		*target = &parsed
+	}
+
+	return filter, filter.Validate()
+}
+
+// DecodeProfileChangesRequest decode internal profile changes request and returns a filter. Without since the feed starts from the beginning.
+func DecodeProfileChangesRequest(r *http.Request) (specs.ListProfileChangesFilter, error) {
+	query := r.URL.Query()
+	filter := specs.ListProfileChangesFilter{
+		Limit: constants.DefaultProfileChangesLimit,
 	}
-	pathNotRequired := map[string]bool{
-		"/login":              true,
-		"/api/logout":         true,
-		"/api/profiles":       true,
-		"/api/profiles/full":  true,
-		"/api/skills":         true,
-		"/api/updateSequence": true,
-		"/api/admin_invite":   true,
+
+	if value := query.Get(constants.ProfileChangesSinceStr); value != "" {
+		since, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return specs.ListProfileChangesFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), constants.ProfileChangesSinceStr)
+		}
+		filter.Since = since
 	}
 
-	return pathNotRequired[r.URL.Path]
-}
+	intParams := map[string]*int{
+		constants.ProfileChangesAfterIDStr: &filter.AfterID,
+		constants.ProfileChangesLimitStr:   &filter.Limit,
+	}
+	for name, target := range intParams {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return specs.ListProfileChangesFilter{}, fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), name)
+		}
+		*target = parsed
+	}
 
-// define the global variable for the store the token
-var (
-	TokenList       = make(map[string]struct{})
-	WhiteListMutext = &sync.Mutex{}
-)
+	return filter, filter.Validate()
+}
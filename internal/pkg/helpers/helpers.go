@@ -70,6 +70,16 @@ func ConvertStringToTimeDuration(envVars string, defaultValue time.Duration) tim
 	return value
 }
 
+// RetryBackoff returns how long to wait after the given failed attempt before trying again: base after the first
+// attempt, doubling with every attempt after it, up to max
+func RetryBackoff(attempts int, base, max time.Duration) time.Duration {
+	wait := base
+	for i := 1; i < attempts && wait < max; i++ {
+		wait *= 2
+	}
+	return min(wait, max)
+}
+
 // JoinValues used to join multiple values while data backing up
 func JoinValues(values interface{}, sep string) string {
 	switch v := values.(type) {
@@ -86,62 +96,14 @@ func JoinValues(values interface{}, sep string) string {
 	}
 }
 
-// ConstructUserMessage constructs the email message for a profile invitation
-func ConstructUserMessage(email, name string, profileID int) string {
-	link := fmt.Sprintf("%s/profile-builder/%d", os.Getenv("HOST_URL"), profileID)
-	content := fmt.Sprintf(`
-		<html>
-		<body>
-			<div class="email-content">
-				<p>Hello %s,</p>
-				<p>We are pleased to inform you that your Josh profile has been successfully created in Profile Builder.</p>
-				<p>Please <a href="%s">click here</a> to review your profile and update the remaining details as soon as possible.</p>
-				<p>Once all the required information has been provided, kindly submit your profile for final approval.</p>
-				<p>Feel free to reach out to Talent Acquisition Group if you have any questions or need assistance.</p>
-				<p>Best Regards,</p>
-				<p>Profile Builder Team</p>
-			</div>
-		</body>
-		</html>
-	`, name, link)
-	return content
-}
-
-// ConstructAdminEmailMessage constructs the email message for an admin invitation
-func ConstructAdminEmailMessage(email, name string, profileID int) string {
-	link := fmt.Sprintf("%s/profile-builder/%d", os.Getenv("HOST_URL"), profileID)
-	content := fmt.Sprintf(`
-		<html>
-		<body>
-			<div class="email-content">
-				<p>Hello %s,</p>
-				<p>The candidate has completed their profile. Please <a href="%s">click here</a> to review and download the profile.</p>
-				<p>Best Regards,</p>
-				<p>Profile Builder Team</p>
-			</div>
-		</body>
-		</html>
-	`, name, link)
-	return content
+// ProfileLink returns the link to a profile in the Profile Builder UI
+func ProfileLink(profileID int) string {
+	return fmt.Sprintf("%s/profile-builder/%d", os.Getenv("HOST_URL"), profileID)
 }
 
-// ConstructAdminInviteMessage constructs the email body for a new admin invitation
-func ConstructAdminInviteMessage(name string) string {
-	link := fmt.Sprintf("%s", os.Getenv("HOST_URL"))
-	content := fmt.Sprintf(`
-		<html>
-		<body>
-			<div class="email-content">
-				<p>Hello %s,</p>
-				<p>You have been invited as an Admin on Profile Builder.</p>
-				<p>Please <a href="%s">click here</a> to log in and access the admin dashboard.</p>
-				<p>Best Regards,</p>
-				<p>Profile Builder Team</p>
-			</div>
-		</body>
-		</html>
-	`, name, link)
-	return content
+// LoginLink returns the link to log in to the Profile Builder UI
+func LoginLink() string {
+	return os.Getenv("HOST_URL")
 }
 
 // GetCurrentISTTime returns the current time in the Asia/Kolkata time zone formatted as RFC3339
@@ -13,10 +13,9 @@ import (
 
 // CreateToken used to generate a token
 func CreateToken(userID int64, profileID int, role string, email string) (string, error) {
-	secretKey := os.Getenv("SECRET_KEY")
-
-	if secretKey == "" {
-		return "", errors.ErrSecretKey
+	keySet, err := getKeySet()
+	if err != nil {
+		return "", err
 	}
 
 	expirationHoursStr := os.Getenv("TOKEN_EXPIRATION_HOURS")
@@ -30,10 +29,16 @@ func CreateToken(userID int64, profileID int, role string, email string) (string
 		return "", err
 	}
 
+	method, err := signingMethodFor(keySet.signingKey.Public())
+	if err != nil {
+		return "", err
+	}
+
 	claims := createClaims(userID, profileID, role, email, time.Duration(expirationHours)*time.Hour)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = keySet.signingKID
 
-	tokenString, err := token.SignedString([]byte(secretKey))
+	tokenString, err := token.SignedString(keySet.signingKey)
 	if err != nil {
 		log.Fatalf("Error in generating token: %v", err)
 		return "", err
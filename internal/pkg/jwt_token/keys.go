@@ -0,0 +1,275 @@
+package jwttoken
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// Key file suffixes looked up inside JWT_KEYS_DIR.
+const (
+	privateKeySuffix = ".pem"
+	publicKeySuffix  = ".pub.pem"
+)
+
+// KeySet holds the active signing key and every key accepted for verification, indexed by kid.
+type KeySet struct {
+	signingKID       string
+	signingKey       crypto.Signer
+	verificationKeys map[string]crypto.PublicKey
+}
+
+var (
+	activeKeySet *KeySet
+	keySetMutex  = &sync.RWMutex{}
+)
+
+// InitKeySet loads the key set from JWT_KEYS_DIR and JWT_SIGNING_KEY_ID and makes it active.
+func InitKeySet() error {
+	keySet, err := LoadKeySet(os.Getenv("JWT_KEYS_DIR"), os.Getenv("JWT_SIGNING_KEY_ID"))
+	if err != nil {
+		return err
+	}
+	SetKeySet(keySet)
+	return nil
+}
+
+// SetKeySet replaces the active key set used for signing and verifying tokens.
+func SetKeySet(keySet *KeySet) {
+	keySetMutex.Lock()
+	defer keySetMutex.Unlock()
+	activeKeySet = keySet
+}
+
+// getKeySet returns the active key set, or an error when none has been loaded.
+func getKeySet() (*KeySet, error) {
+	keySetMutex.RLock()
+	defer keySetMutex.RUnlock()
+	if activeKeySet == nil {
+		return nil, errors.ErrSigningKeyNotConfigured
+	}
+	return activeKeySet, nil
+}
+
+// LoadKeySet reads every key in dir. Files named <kid>.pem hold private keys and files named
+// <kid>.pub.pem hold public keys of retired signers that must still verify. The private key
+// named by signingKID is used to sign new tokens.
+func LoadKeySet(dir, signingKID string) (*KeySet, error) {
+	if dir == "" || signingKID == "" {
+		return nil, errors.ErrSigningKeyNotConfigured
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		zap.S().Error("Error reading JWT keys directory: ", err)
+		return nil, err
+	}
+
+	keySet := &KeySet{
+		signingKID:       signingKID,
+		verificationKeys: make(map[string]crypto.PublicKey),
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			zap.S().Error("Error reading JWT key file ", name, ": ", err)
+			return nil, err
+		}
+
+		switch {
+		case strings.HasSuffix(name, publicKeySuffix):
+			kid := strings.TrimSuffix(name, publicKeySuffix)
+			publicKey, err := ParsePublicKey(data)
+			if err != nil {
+				zap.S().Error("Error parsing JWT public key ", name, ": ", err)
+				return nil, err
+			}
+			keySet.verificationKeys[kid] = publicKey
+		case strings.HasSuffix(name, privateKeySuffix):
+			kid := strings.TrimSuffix(name, privateKeySuffix)
+			privateKey, err := ParsePrivateKey(data)
+			if err != nil {
+				zap.S().Error("Error parsing JWT private key ", name, ": ", err)
+				return nil, err
+			}
+			keySet.verificationKeys[kid] = privateKey.Public()
+			if kid == signingKID {
+				keySet.signingKey = privateKey
+			}
+		}
+	}
+
+	if keySet.signingKey == nil {
+		zap.S().Errorf("Signing key %s not found in %s", signingKID, dir)
+		return nil, errors.ErrSigningKeyNotConfigured
+	}
+
+	zap.S().Infof("Loaded %d JWT verification key(s), signing with kid %s", len(keySet.verificationKeys), signingKID)
+	return keySet, nil
+}
+
+// NewKeySet builds a key set from in-memory keys. The signing key is always accepted for verification.
+func NewKeySet(signingKID string, signingKey crypto.Signer, verificationKeys map[string]crypto.PublicKey) (*KeySet, error) {
+	if _, err := signingMethodFor(signingKey.Public()); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(verificationKeys)+1)
+	for kid, key := range verificationKeys {
+		keys[kid] = key
+	}
+	keys[signingKID] = signingKey.Public()
+
+	return &KeySet{
+		signingKID:       signingKID,
+		signingKey:       signingKey,
+		verificationKeys: keys,
+	}, nil
+}
+
+// ParsePrivateKey parses a PEM encoded RSA (PKCS#1 or PKCS#8) or Ed25519 (PKCS#8) private key.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.ErrInvalidKey
+	}
+
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case ed25519.PrivateKey:
+		return k, nil
+	default:
+		return nil, errors.ErrInvalidKey
+	}
+}
+
+// ParsePublicKey parses a PEM encoded RSA or Ed25519 public key.
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.ErrInvalidKey
+	}
+
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := signingMethodFor(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// signingMethodFor returns the JWT signing method matching the type of the given public key.
+func signingMethodFor(key crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, errors.ErrInvalidKey
+	}
+}
+
+// KeyFunc resolves the verification key for a token using its kid header. The token's
+// algorithm must match the type of the key registered under that kid.
+func KeyFunc(token *jwt.Token) (interface{}, error) {
+	keySet, err := getKeySet()
+	if err != nil {
+		return nil, err
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.ErrMissingKeyID
+	}
+
+	key, ok := keySet.verificationKeys[kid]
+	if !ok {
+		return nil, errors.ErrUnknownKeyID
+	}
+
+	method, err := signingMethodFor(key)
+	if err != nil {
+		return nil, err
+	}
+	if token.Method.Alg() != method.Alg() {
+		return nil, errors.ErrSigningMethod
+	}
+
+	return key, nil
+}
+
+// JWKS returns the public verification keys in JSON Web Key Set format.
+func JWKS() (specs.JWKS, error) {
+	keySet, err := getKeySet()
+	if err != nil {
+		return specs.JWKS{}, err
+	}
+
+	kids := make([]string, 0, len(keySet.verificationKeys))
+	for kid := range keySet.verificationKeys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	jwks := specs.JWKS{Keys: []specs.JWK{}}
+	for _, kid := range kids {
+		switch key := keySet.verificationKeys[kid].(type) {
+		case *rsa.PublicKey:
+			jwks.Keys = append(jwks.Keys, specs.JWK{
+				Kty: "RSA",
+				Use: "sig",
+				Alg: jwt.SigningMethodRS256.Alg(),
+				Kid: kid,
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			jwks.Keys = append(jwks.Keys, specs.JWK{
+				Kty: "OKP",
+				Use: "sig",
+				Alg: jwt.SigningMethodEdDSA.Alg(),
+				Kid: kid,
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(key),
+			})
+		}
+	}
+	return jwks, nil
+}
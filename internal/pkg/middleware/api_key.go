@@ -1,39 +1,91 @@
 package middleware
 
 import (
+	"context"
 	"crypto/subtle"
+	"net"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
 	"go.uber.org/zap"
 )
 
-// APIKeyMiddleware authenticates server-to-server requests using a pre-shared API key.
-func APIKeyMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		expectedKey := os.Getenv(constants.APIKeyEnvVar)
-		if expectedKey == "" {
-			// Misconfiguration: the server-side key is not set — deny all requests.
-			zap.S().Error("API key environment variable is not configured: ", constants.APIKeyEnvVar)
-			ErrorResponse(w, http.StatusInternalServerError, errors.ErrInvalidEnv)
-			return
-		}
+// APIKeyAuthenticator resolves the service that an API key was issued to.
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string, clientIP string) (specs.APIKeyPrincipal, error)
+}
 
-		incomingKey := r.Header.Get(constants.APIKeyHeader)
-		if incomingKey == "" {
-			zap.S().Warn("Request rejected: missing API key header")
-			ErrorResponse(w, http.StatusUnauthorized, errors.ErrAPIKeyMissing)
-			return
-		}
+// APIKeyMiddleware authenticates server-to-server requests using the API key in the X-API-Key header.
+// The request is allowed only if the key is active, used from an allowed address and granted the given scope.
+// The id of the key is stored in the request context.
+func APIKeyMiddleware(authenticator APIKeyAuthenticator, scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			incomingKey := r.Header.Get(constants.APIKeyHeader)
+			if incomingKey == "" {
+				zap.S().Warn("Request rejected: missing API key header")
+				ErrorResponse(w, http.StatusUnauthorized, errors.ErrAPIKeyMissing)
+				return
+			}
+
+			principal, err := authenticator.AuthenticateAPIKey(r.Context(), incomingKey, ClientIP(r))
+			if err == errors.ErrAPIKeyInvalid && isLegacyAPIKey(incomingKey) {
+				zap.S().Warnf("Request authenticated with the deprecated %s, create an API key for this service instead", constants.LegacyAPIKeyEnvVar)
+				principal, err = legacyAPIKeyPrincipal, nil
+			}
+			if err != nil {
+				switch err {
+				case errors.ErrAPIKeyInvalid, errors.ErrAPIKeyRevoked, errors.ErrAPIKeyExpired:
+					zap.S().Warn("Request rejected: ", err)
+					ErrorResponse(w, http.StatusUnauthorized, err)
+				case errors.ErrAPIKeyIP:
+					ErrorResponse(w, http.StatusForbidden, err)
+				default:
+					zap.S().Error("Error authenticating API key: ", err)
+					ErrorResponse(w, http.StatusInternalServerError, errors.ErrPermissionCheck)
+				}
+				return
+			}
+
+			if !principal.HasScope(scope) {
+				zap.S().Warnf("Request rejected: API key %s lacks scope %s", principal.Name, scope)
+				ErrorResponse(w, http.StatusForbidden, errors.ErrAPIKeyScope)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), constants.APIKeyIDKey, principal.ID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// legacyAPIKeyPrincipal is the service calling with the deprecated PROFILE_BUILDER_API_KEY. It keeps the access the
+// key gave before API keys were managed in the database, to resolve employee ids, and nothing more.
+var legacyAPIKeyPrincipal = specs.APIKeyPrincipal{Name: constants.LegacyAPIKeyEnvVar, Scopes: []string{constants.APIScopeProfilesResolve}}
+
+// isLegacyAPIKey reports whether key is the deprecated PROFILE_BUILDER_API_KEY, when it is still set
+func isLegacyAPIKey(key string) bool {
+	legacyKey := os.Getenv(constants.LegacyAPIKeyEnvVar)
+	return legacyKey != "" && subtle.ConstantTimeCompare([]byte(key), []byte(legacyKey)) == 1
+}
 
-		if subtle.ConstantTimeCompare([]byte(expectedKey), []byte(incomingKey)) != 1 {
-			zap.S().Warn("Request rejected: invalid API key")
-			ErrorResponse(w, http.StatusUnauthorized, errors.ErrAPIKeyInvalid)
-			return
+// ClientIP returns the address the request came from. The first X-Forwarded-For address is used only
+// when TRUST_PROXY_HEADERS is true, since clients can set that header themselves.
+func ClientIP(r *http.Request) string {
+	if os.Getenv(constants.TrustProxyHeadersEnvVar) == "true" {
+		forwarded := r.Header.Get("X-Forwarded-For")
+		if forwarded != "" {
+			return strings.TrimSpace(strings.Split(forwarded, ",")[0])
 		}
+	}
 
-		next.ServeHTTP(w, r)
-	})
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
 }
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/gorilla/mux"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
@@ -68,32 +69,24 @@ func AuthMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		requestedProfileID := cast.ToInt(reqProfileID)
-
-		if !helpers.ProfileIDNotRequiredPath(r) {
-			profileID, err := helpers.GetProfileID(r)
-			if err != nil {
-				ErrorResponse(w, http.StatusUnauthorized, errors.ErrInvalidProfile)
-				zap.S().Error(errors.ErrProfileID)
-				return
-			}
-
-			if requestedProfileID != profileID && role != constants.Admin {
-				ErrorResponse(w, http.StatusUnauthorized, errors.ErrAuthToken)
-				zap.S().Error(errors.ErrProfileID)
-				return
-			}
-		}
-
 		ctx := context.WithValue(r.Context(), constants.UserIDKey, userID)
 		ctx = context.WithValue(ctx, constants.UserRoleKey, role)
+		ctx = context.WithValue(ctx, constants.ProfileIDKey, cast.ToInt(reqProfileID))
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
-// RoleMiddleware used for Authorization.
-func RoleMiddleware(roles []string) func(http.Handler) http.Handler {
+// PermissionChecker resolves the permissions granted to a role.
+type PermissionChecker interface {
+	GetPermissionScope(ctx context.Context, role string, permission string) (string, error)
+	IsReportee(ctx context.Context, managerID int, profileID int) (bool, error)
+}
+
+// PermissionMiddleware used for Authorization. It allows the request only if the user's role holds the
+// given permission and, on routes with a profile_id, only if that profile is within the permission's scope.
+// The granted scope is stored in the request context for handlers that list profiles.
+func PermissionMiddleware(checker PermissionChecker, permission string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			userRole, ok := r.Context().Value(constants.UserRoleKey).(string)
@@ -102,14 +95,65 @@ func RoleMiddleware(roles []string) func(http.Handler) http.Handler {
 				zap.S().Error(errors.ErrAuthToken)
 				return
 			}
-			for _, role := range roles {
-				if role == userRole {
-					next.ServeHTTP(w, r)
+
+			scope, err := checker.GetPermissionScope(r.Context(), userRole, permission)
+			if err != nil {
+				if err == errors.ErrPermissionDenied {
+					ErrorResponse(w, http.StatusForbidden, err)
+					zap.S().Errorf("Role %s lacks permission %s", userRole, permission)
 					return
 				}
+				ErrorResponse(w, http.StatusInternalServerError, errors.ErrPermissionCheck)
+				zap.S().Error("Error checking permission: ", err)
+				return
 			}
-			ErrorResponse(w, http.StatusForbidden, errors.ErrAuthToken)
-			zap.S().Error(errors.ErrAuthToken)
+
+			if _, ok := mux.Vars(r)[constants.ProfileID]; ok {
+				profileID, err := helpers.GetProfileID(r)
+				if err != nil {
+					ErrorResponse(w, http.StatusUnauthorized, errors.ErrInvalidProfile)
+					zap.S().Error(errors.ErrProfileID)
+					return
+				}
+
+				err = CheckProfileScope(r.Context(), checker, scope, profileID)
+				if err != nil {
+					if err == errors.ErrPermissionDenied {
+						ErrorResponse(w, http.StatusForbidden, err)
+						zap.S().Errorf("Profile %d is outside the %s scope of %s", profileID, scope, permission)
+						return
+					}
+					ErrorResponse(w, http.StatusInternalServerError, errors.ErrPermissionCheck)
+					zap.S().Error("Error checking profile scope: ", err)
+					return
+				}
+			}
+
+			ctx := context.WithValue(r.Context(), constants.PermissionScope, scope)
+			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
+
+// CheckProfileScope returns ErrPermissionDenied if profileID is outside the given scope for the user in ctx.
+func CheckProfileScope(ctx context.Context, checker PermissionChecker, scope string, profileID int) error {
+	switch scope {
+	case constants.ScopeAll:
+		return nil
+	case constants.ScopeOwn:
+		ownProfileID, _ := ctx.Value(constants.ProfileIDKey).(int)
+		if ownProfileID > 0 && ownProfileID == profileID {
+			return nil
+		}
+	case constants.ScopeReportees:
+		userID := cast.ToInt(ctx.Value(constants.UserIDKey))
+		isReportee, err := checker.IsReportee(ctx, userID, profileID)
+		if err != nil {
+			return err
+		}
+		if isReportee {
+			return nil
+		}
+	}
+	return errors.ErrPermissionDenied
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// IntranetSignatureMiddleware authenticates events pushed by the intranet. The request body must be signed with
+// HMAC-SHA256 under INTRANET_WEBHOOK_SECRET, sent as "sha256=<hex>" in the X-Intranet-Signature header. Every
+// request is refused while the secret is not set.
+func IntranetSignatureMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secret := os.Getenv(constants.IntranetWebhookSecretEnvVar)
+		if secret == "" {
+			zap.S().Warn("Intranet event rejected: ", constants.IntranetWebhookSecretEnvVar, " is not set")
+			ErrorResponse(w, http.StatusServiceUnavailable, errors.ErrWebhookNotConfigured)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, constants.MaxWebhookPayloadBytes))
+		if err != nil {
+			zap.S().Warn("Intranet event rejected: unable to read body: ", err)
+			ErrorResponse(w, http.StatusBadRequest, errors.ErrInvalidBody)
+			return
+		}
+
+		expected := IntranetSignature(secret, body)
+		if !hmac.Equal([]byte(r.Header.Get(constants.IntranetSignatureHeader)), []byte(expected)) {
+			zap.S().Warn("Intranet event rejected: invalid signature from ", ClientIP(r))
+			ErrorResponse(w, http.StatusUnauthorized, errors.ErrInvalidWebhookSignature)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// IntranetSignature returns the X-Intranet-Signature of a body signed with secret.
+func IntranetSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
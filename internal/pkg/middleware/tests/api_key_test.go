@@ -0,0 +1,177 @@
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	errs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAPIKeyMiddleware(t *testing.T) {
+	tests := []struct {
+		name               string
+		key                string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedAPIKeyID   int
+	}{
+		{
+			name: "Success_for_key_with_scope",
+			key:  "pbk_0a1b2c3d_secret",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("AuthenticateAPIKey", mock.Anything, "pbk_0a1b2c3d_secret", "192.0.2.1").Return(specs.APIKeyPrincipal{
+					ID: 7, Name: "staffing", Scopes: []string{constants.APIScopeProfilesResolve},
+				}, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedAPIKeyID:   7,
+		},
+		{
+			name:               "Fail_for_missing_key",
+			setup:              func(mockSvc *mocks.Service) {},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Fail_for_invalid_key",
+			key:  "wrong",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("AuthenticateAPIKey", mock.Anything, "wrong", mock.Anything).Return(specs.APIKeyPrincipal{}, errs.ErrAPIKeyInvalid).Once()
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Fail_for_expired_key",
+			key:  "pbk_0a1b2c3d_secret",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("AuthenticateAPIKey", mock.Anything, "pbk_0a1b2c3d_secret", mock.Anything).Return(specs.APIKeyPrincipal{}, errs.ErrAPIKeyExpired).Once()
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Fail_for_disallowed_address",
+			key:  "pbk_0a1b2c3d_secret",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("AuthenticateAPIKey", mock.Anything, "pbk_0a1b2c3d_secret", mock.Anything).Return(specs.APIKeyPrincipal{}, errs.ErrAPIKeyIP).Once()
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "Fail_for_missing_scope",
+			key:  "pbk_0a1b2c3d_secret",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("AuthenticateAPIKey", mock.Anything, "pbk_0a1b2c3d_secret", mock.Anything).Return(specs.APIKeyPrincipal{
+					ID: 7, Name: "staffing", Scopes: []string{constants.APIScopeProfilesRead},
+				}, nil).Once()
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "Fail_for_lookup_error",
+			key:  "pbk_0a1b2c3d_secret",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("AuthenticateAPIKey", mock.Anything, "pbk_0a1b2c3d_secret", mock.Anything).Return(specs.APIKeyPrincipal{}, errors.New("db error")).Once()
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mocks.Service)
+			tt.setup(mockSvc)
+
+			var gotAPIKeyID int
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotAPIKeyID, _ = r.Context().Value(constants.APIKeyIDKey).(int)
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/internal/profiles/resolve/E1", nil)
+			req.RemoteAddr = "192.0.2.1:5000"
+			if tt.key != "" {
+				req.Header.Set(constants.APIKeyHeader, tt.key)
+			}
+
+			rr := httptest.NewRecorder()
+			middleware.APIKeyMiddleware(mockSvc, constants.APIScopeProfilesResolve)(next).ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedAPIKeyID, gotAPIKeyID)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyMiddlewareLegacyKey(t *testing.T) {
+	tests := []struct {
+		name               string
+		key                string
+		scope              string
+		expectedStatusCode int
+	}{
+		{name: "Success_for_legacy_key_resolving_employees", key: "legacy-secret", scope: constants.APIScopeProfilesResolve, expectedStatusCode: http.StatusOK},
+		{name: "Fail_for_legacy_key_reading_profiles", key: "legacy-secret", scope: constants.APIScopeProfilesRead, expectedStatusCode: http.StatusForbidden},
+		{name: "Fail_for_wrong_key", key: "legacy-secreT", scope: constants.APIScopeProfilesResolve, expectedStatusCode: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(constants.LegacyAPIKeyEnvVar, "legacy-secret")
+			mockSvc := new(mocks.Service)
+			mockSvc.On("AuthenticateAPIKey", mock.Anything, tt.key, mock.Anything).Return(specs.APIKeyPrincipal{}, errs.ErrAPIKeyInvalid).Once()
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/internal/profiles/resolve/E1", nil)
+			req.Header.Set(constants.APIKeyHeader, tt.key)
+
+			rr := httptest.NewRecorder()
+			middleware.APIKeyMiddleware(mockSvc, tt.scope)(next).ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name         string
+		trustProxy   string
+		forwardedFor string
+		expectedIP   string
+	}{
+		{
+			name:         "Success_for_remote_address",
+			forwardedFor: "203.0.113.9",
+			expectedIP:   "192.0.2.1",
+		},
+		{
+			name:         "Success_for_trusted_forwarded_header",
+			trustProxy:   "true",
+			forwardedFor: "203.0.113.9, 10.0.0.1",
+			expectedIP:   "203.0.113.9",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(constants.TrustProxyHeadersEnvVar, tt.trustProxy)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = "192.0.2.1:5000"
+			req.Header.Set("X-Forwarded-For", tt.forwardedFor)
+
+			assert.Equal(t, tt.expectedIP, middleware.ClientIP(req))
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/app/service/mocks"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	errs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPermissionMiddleware(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               string
+		ownProfileID       int
+		urlVars            map[string]string
+		setup              func(mockSvc *mocks.Service)
+		expectedStatusCode int
+		expectedScope      string
+	}{
+		{
+			name:    "Success_for_scope_all",
+			role:    constants.Admin,
+			urlVars: map[string]string{"profile_id": "5"},
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetPermissionScope", mock.Anything, constants.Admin, constants.PermProfilesRead).Return(constants.ScopeAll, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedScope:      constants.ScopeAll,
+		},
+		{
+			name:         "Success_for_own_profile",
+			role:         constants.Employee,
+			ownProfileID: 5,
+			urlVars:      map[string]string{"profile_id": "5"},
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetPermissionScope", mock.Anything, constants.Employee, constants.PermProfilesRead).Return(constants.ScopeOwn, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedScope:      constants.ScopeOwn,
+		},
+		{
+			name:         "Fail_for_other_profile_with_own_scope",
+			role:         constants.Employee,
+			ownProfileID: 6,
+			urlVars:      map[string]string{"profile_id": "5"},
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetPermissionScope", mock.Anything, constants.Employee, constants.PermProfilesRead).Return(constants.ScopeOwn, nil).Once()
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:    "Success_for_reportee",
+			role:    "manager",
+			urlVars: map[string]string{"profile_id": "5"},
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetPermissionScope", mock.Anything, "manager", constants.PermProfilesRead).Return(constants.ScopeReportees, nil).Once()
+				mockSvc.On("IsReportee", mock.Anything, 1, 5).Return(true, nil).Once()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedScope:      constants.ScopeReportees,
+		},
+		{
+			name:    "Fail_for_non_reportee",
+			role:    "manager",
+			urlVars: map[string]string{"profile_id": "5"},
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetPermissionScope", mock.Anything, "manager", constants.PermProfilesRead).Return(constants.ScopeReportees, nil).Once()
+				mockSvc.On("IsReportee", mock.Anything, 1, 5).Return(false, nil).Once()
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "Fail_for_permission_not_granted",
+			role: "viewer",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetPermissionScope", mock.Anything, "viewer", constants.PermProfilesRead).Return("", errs.ErrPermissionDenied).Once()
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "Fail_for_permission_lookup_error",
+			role: "viewer",
+			setup: func(mockSvc *mocks.Service) {
+				mockSvc.On("GetPermissionScope", mock.Anything, "viewer", constants.PermProfilesRead).Return("", errors.New("db error")).Once()
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(mocks.Service)
+			tt.setup(mockSvc)
+
+			var gotScope string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotScope, _ = r.Context().Value(constants.PermissionScope).(string)
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/profiles/5", nil)
+			req = mux.SetURLVars(req, tt.urlVars)
+			ctx := context.WithValue(req.Context(), constants.UserIDKey, float64(1))
+			ctx = context.WithValue(ctx, constants.UserRoleKey, tt.role)
+			ctx = context.WithValue(ctx, constants.ProfileIDKey, tt.ownProfileID)
+			req = req.WithContext(ctx)
+
+			rr := httptest.NewRecorder()
+			middleware.PermissionMiddleware(mockSvc, constants.PermProfilesRead)(next).ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			assert.Equal(t, tt.expectedScope, gotScope)
+		})
+	}
+}
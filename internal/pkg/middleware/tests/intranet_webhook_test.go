@@ -0,0 +1,72 @@
+package test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntranetSignatureMiddleware(t *testing.T) {
+	body := `{"id":"evt_1","type":"employee.updated","employee_id":"EMP001"}`
+
+	tests := []struct {
+		name               string
+		secret             string
+		signature          string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Success_for_signed_body",
+			secret:             "shh",
+			signature:          middleware.IntranetSignature("shh", []byte(body)),
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Fail_for_body_signed_with_another_secret",
+			secret:             "shh",
+			signature:          middleware.IntranetSignature("other", []byte(body)),
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "Fail_for_missing_signature",
+			secret:             "shh",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "Fail_while_not_configured",
+			signature:          middleware.IntranetSignature("", []byte(body)),
+			expectedStatusCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(constants.IntranetWebhookSecretEnvVar, tt.secret)
+
+			var received string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				data, _ := io.ReadAll(r.Body)
+				received = string(data)
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/internal/webhooks/intranet", strings.NewReader(body))
+			if tt.signature != "" {
+				req.Header.Set(constants.IntranetSignatureHeader, tt.signature)
+			}
+			rr := httptest.NewRecorder()
+			middleware.IntranetSignatureMiddleware(next).ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+			if tt.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, body, received)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	tests := []struct {
+		name            string
+		incomingID      string
+		expectGenerated bool
+	}{
+		{
+			name:       "Success_for_client_request_id",
+			incomingID: "req-123.abc_DEF",
+		},
+		{
+			name:            "Success_for_missing_request_id",
+			incomingID:      "",
+			expectGenerated: true,
+		},
+		{
+			name:            "Success_for_unsafe_request_id",
+			incomingID:      "bad id\nwith newline",
+			expectGenerated: true,
+		},
+		{
+			name:            "Success_for_overlong_request_id",
+			incomingID:      strings.Repeat("a", 65),
+			expectGenerated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seenID string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seenID = helpers.GetRequestID(r.Context())
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/profiles", nil)
+			if tt.incomingID != "" {
+				req.Header.Set(constants.RequestIDHeader, tt.incomingID)
+			}
+			rr := httptest.NewRecorder()
+			middleware.RequestIDMiddleware(next).ServeHTTP(rr, req)
+
+			responseID := rr.Header().Get(constants.RequestIDHeader)
+			assert.Equal(t, responseID, seenID)
+			if tt.expectGenerated {
+				assert.Len(t, responseID, 32)
+				assert.NotEqual(t, tt.incomingID, responseID)
+			} else {
+				assert.Equal(t, tt.incomingID, responseID)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package test
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"os"
+	"testing"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	jwttoken "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/jwt_token"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyJWTToken(t *testing.T) {
+	os.Setenv("TOKEN_EXPIRATION_HOURS", "1")
+	defer os.Unsetenv("TOKEN_EXPIRATION_HOURS")
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		setup         func(t *testing.T) string
+		expectedError error
+	}{
+		{
+			name: "Success_with_eddsa_signing_key",
+			setup: func(t *testing.T) string {
+				setKeySet(t, "ed-1", edKey, nil)
+				token, err := jwttoken.CreateToken(1, 2, "admin", "admin@example.com")
+				require.NoError(t, err)
+				return token
+			},
+		},
+		{
+			name: "Success_with_rsa_signing_key",
+			setup: func(t *testing.T) string {
+				setKeySet(t, "rsa-1", rsaKey, nil)
+				token, err := jwttoken.CreateToken(1, 2, "admin", "admin@example.com")
+				require.NoError(t, err)
+				return token
+			},
+		},
+		{
+			name: "Success_with_rotated_out_key",
+			setup: func(t *testing.T) string {
+				setKeySet(t, "ed-1", edKey, nil)
+				token, err := jwttoken.CreateToken(1, 2, "admin", "admin@example.com")
+				require.NoError(t, err)
+
+				setKeySet(t, "rsa-1", rsaKey, map[string]crypto.PublicKey{"ed-1": edKey.Public()})
+				return token
+			},
+		},
+		{
+			name: "Fail_for_removed_key",
+			setup: func(t *testing.T) string {
+				setKeySet(t, "ed-1", edKey, nil)
+				token, err := jwttoken.CreateToken(1, 2, "admin", "admin@example.com")
+				require.NoError(t, err)
+
+				setKeySet(t, "rsa-1", rsaKey, nil)
+				return token
+			},
+			expectedError: errors.ErrInvalidToken,
+		},
+		{
+			name: "Fail_for_token_signed_by_unregistered_key",
+			setup: func(t *testing.T) string {
+				setKeySet(t, "ed-1", edKey, nil)
+				token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{"userID": 1})
+				token.Header["kid"] = "ed-1"
+				signed, err := token.SignedString(otherKey)
+				require.NoError(t, err)
+				return signed
+			},
+			expectedError: errors.ErrInvalidToken,
+		},
+		{
+			name: "Fail_for_hmac_token",
+			setup: func(t *testing.T) string {
+				setKeySet(t, "ed-1", edKey, nil)
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"userID": 1})
+				token.Header["kid"] = "ed-1"
+				signed, err := token.SignedString([]byte("secret"))
+				require.NoError(t, err)
+				return signed
+			},
+			expectedError: errors.ErrInvalidToken,
+		},
+		{
+			name: "Fail_for_empty_token",
+			setup: func(t *testing.T) string {
+				return ""
+			},
+			expectedError: errors.ErrTokenEmpty,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := tt.setup(t)
+
+			claims, err := middleware.VerifyJWTToken(token)
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "admin", claims["role"])
+		})
+	}
+}
+
+func setKeySet(t *testing.T, kid string, key crypto.Signer, retired map[string]crypto.PublicKey) {
+	keySet, err := jwttoken.NewKeySet(kid, key, retired)
+	require.NoError(t, err)
+	jwttoken.SetKeySet(keySet)
+}
@@ -1,10 +1,9 @@
 package middleware
 
 import (
-	"os"
-
 	"github.com/golang-jwt/jwt"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	jwttoken "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/jwt_token"
 	"go.uber.org/zap"
 )
 
@@ -13,12 +12,7 @@ func VerifyJWTToken(tokenString string) (jwt.MapClaims, error) {
 	if tokenString == "" {
 		return nil, errors.ErrTokenEmpty
 	}
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.ErrSigningMethod
-		}
-		return []byte(os.Getenv("SECRET_KEY")), nil
-	})
+	token, err := jwt.Parse(tokenString, jwttoken.KeyFunc)
 
 	if err != nil {
 		zap.S().Error("Error in parsing token: %v", err)
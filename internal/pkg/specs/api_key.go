@@ -0,0 +1,97 @@
+package specs
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	errors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+)
+
+// APIKeyResponse represents an API key as seen by admins. The key itself is never returned after it is issued.
+type APIKeyResponse struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"prefix"`
+	Scopes      []string   `json:"scopes"`
+	AllowedIPs  []string   `json:"allowed_ips"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	LastUsedIP  *string    `json:"last_used_ip"`
+	RevokedAt   *time.Time `json:"revoked_at"`
+	CreatedByID *int       `json:"created_by_id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ListAPIKeysResponse represents the list of API keys returned to admins.
+type ListAPIKeysResponse struct {
+	APIKeys []APIKeyResponse `json:"api_keys"`
+}
+
+// APIKeySecretResponse carries a newly issued API key. This is the only time the key is shown.
+type APIKeySecretResponse struct {
+	Message  string `json:"message"`
+	APIKeyID int    `json:"api_key_id"`
+	Key      string `json:"key"`
+}
+
+// CreateAPIKeyRequest represents a request to issue an API key to another service.
+type CreateAPIKeyRequest struct {
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	AllowedIPs []string   `json:"allowed_ips"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+}
+
+// Validate func checks if the CreateAPIKeyRequest is valid.
+func (req *CreateAPIKeyRequest) Validate() error {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return fmt.Errorf("%s : name ", errors.ErrParameterMissing.Error())
+	}
+
+	if len(req.Scopes) == 0 {
+		return fmt.Errorf("%s : scopes ", errors.ErrParameterMissing.Error())
+	}
+
+	for _, scope := range req.Scopes {
+		if _, ok := constants.APIKeyScopes[scope]; !ok {
+			return fmt.Errorf("%s : %s ", errors.ErrUnknownAPIScope.Error(), scope)
+		}
+	}
+
+	if req.AllowedIPs == nil {
+		req.AllowedIPs = []string{}
+	}
+	for _, allowed := range req.AllowedIPs {
+		_, _, err := net.ParseCIDR(allowed)
+		if err != nil && net.ParseIP(allowed) == nil {
+			return fmt.Errorf("%s : %s ", errors.ErrInvalidIPAddress.Error(), allowed)
+		}
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return fmt.Errorf("%s : expires_at must be in the future ", errors.ErrInvalidRequestData.Error())
+	}
+	return nil
+}
+
+// APIKeyPrincipal represents the service identified by an API key.
+type APIKeyPrincipal struct {
+	ID     int
+	Name   string
+	Scopes []string
+}
+
+// HasScope returns true if the API key was granted the scope.
+func (principal APIKeyPrincipal) HasScope(scope string) bool {
+	for _, granted := range principal.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
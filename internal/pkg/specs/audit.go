@@ -0,0 +1,56 @@
+package specs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	errors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+)
+
+// ListAuditEventsFilter narrows the audit events returned by a listing.
+type ListAuditEventsFilter struct {
+	ActorID    int        `json:"actor_id"`
+	ProfileID  int        `json:"profile_id"`
+	Action     string     `json:"action"`
+	TargetType string     `json:"target_type"`
+	From       *time.Time `json:"from"`
+	To         *time.Time `json:"to"`
+	Page       int        `json:"page"`
+	Limit      int        `json:"limit"`
+}
+
+// AuditEventResponse represents a single entry of the audit log.
+type AuditEventResponse struct {
+	ID         int64           `json:"id"`
+	ActorID    *int            `json:"actor_id"`
+	ActorRole  *string         `json:"actor_role"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"target_type"`
+	TargetID   *int            `json:"target_id"`
+	ProfileID  *int            `json:"profile_id"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	RequestID  *string         `json:"request_id"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// ListAuditEventsResponse represents a page of the audit log, newest first.
+type ListAuditEventsResponse struct {
+	Events []AuditEventResponse `json:"events"`
+	Page   int                  `json:"page"`
+	Limit  int                  `json:"limit"`
+}
+
+// Validate func checks if the ListAuditEventsFilter is valid.
+func (filter *ListAuditEventsFilter) Validate() error {
+	if filter.Limit > constants.MaxAuditEventsLimit {
+		return fmt.Errorf("%s : limit must not exceed %d ", errors.ErrInvalidRequestData.Error(), constants.MaxAuditEventsLimit)
+	}
+
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return fmt.Errorf("%s : to must not be before from ", errors.ErrInvalidRequestData.Error())
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package specs
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// BackupHeader is the first line of a backup archive. SchemaVersion is the migration the database was at, which
+// the database restored into must be at too.
+type BackupHeader struct {
+	Format        string    `json:"format"`
+	Version       int       `json:"version"`
+	CreatedAt     time.Time `json:"created_at"`
+	SchemaVersion int64     `json:"schema_version"`
+	Tables        []string  `json:"tables"`
+}
+
+// BackupEntry is a line of a backup archive after the header: either a row of a table, as the JSON object of its
+// columns, or the trailer with the number of rows of every table, which is the last line.
+type BackupEntry struct {
+	Table     string          `json:"table,omitempty"`
+	Row       json.RawMessage `json:"row,omitempty"`
+	RowCounts map[string]int  `json:"row_counts,omitempty"`
+}
+
+// BackupReport describes a backup archive that has been written, checked or restored.
+type BackupReport struct {
+	Path          string         `json:"path"`
+	SizeBytes     int64          `json:"size_bytes"`
+	Checksum      string         `json:"checksum,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	SchemaVersion int64          `json:"schema_version"`
+	RowCounts     map[string]int `json:"row_counts"`
+	// TriggersDisabled tells whether a restore kept the triggers of the tables from firing
+	TriggersDisabled bool `json:"triggers_disabled"`
+}
+
+// Backup is an archive in BACKUP_DIR with the outcome of its latest verification. Checksum is empty when the
+// archive has no checksum file.
+type Backup struct {
+	FileName           string     `json:"file_name"`
+	SizeBytes          int64      `json:"size_bytes"`
+	CreatedAt          time.Time  `json:"created_at"`
+	Checksum           string     `json:"checksum"`
+	VerificationStatus string     `json:"verification_status"`
+	VerifiedAt         *time.Time `json:"verified_at,omitempty"`
+	VerificationError  string     `json:"verification_error,omitempty"`
+}
+
+// ListBackupsResponse lists the archives in BACKUP_DIR, the latest first.
+type ListBackupsResponse struct {
+	Backups []Backup `json:"backups"`
+}
+
+// BackupVerification is the outcome of a test restore of a backup archive into a scratch schema.
+type BackupVerification struct {
+	ID         int64          `json:"id"`
+	FileName   string         `json:"file_name"`
+	Checksum   string         `json:"checksum"`
+	Status     string         `json:"status"`
+	Error      string         `json:"error,omitempty"`
+	RowCounts  map[string]int `json:"row_counts"`
+	VerifiedAt time.Time      `json:"verified_at"`
+}
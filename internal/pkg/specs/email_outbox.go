@@ -0,0 +1,31 @@
+package specs
+
+import "time"
+
+// OutboxEmail represents an email in the outbox and how sending it went.
+type OutboxEmail struct {
+	ID            int64      `json:"id"`
+	Kind          string     `json:"kind"`
+	Recipient     string     `json:"recipient"`
+	Subject       string     `json:"subject"`
+	Body          string     `json:"body"`
+	TextBody      string     `json:"text_body"`
+	ProfileID     *int       `json:"profile_id"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error"`
+	CreatedAt     time.Time  `json:"created_at"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	SentAt        *time.Time `json:"sent_at"`
+}
+
+// ListOutboxEmailsFilter holds the filters for listing the emails in the outbox
+type ListOutboxEmailsFilter struct {
+	Status string
+	Limit  int
+}
+
+// ListOutboxEmailsResponse lists the emails in the outbox, newest first
+type ListOutboxEmailsResponse struct {
+	Emails []OutboxEmail `json:"emails"`
+}
@@ -0,0 +1,77 @@
+package specs
+
+import (
+	"strings"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+)
+
+// EmailTemplate represents a version of the template of a kind of email. Templates use Go template syntax and are
+// rendered with EmailTemplateData; the HTML body is escaped as HTML.
+type EmailTemplate struct {
+	ID          int       `json:"id"`
+	Kind        string    `json:"kind"`
+	Version     int       `json:"version"`
+	Subject     string    `json:"subject"`
+	HTMLBody    string    `json:"html_body"`
+	TextBody    string    `json:"text_body"`
+	CreatedByID *int      `json:"created_by_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// EmailTemplateData is what email templates are rendered with. Fields an email is not about are left empty, e.g.
+// EmployeeName is only set for leaver notifications.
+type EmailTemplateData struct {
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	EmployeeName string `json:"employee_name"`
+	ProfileID    int    `json:"profile_id"`
+	ProfileLink  string `json:"profile_link"`
+	LoginLink    string `json:"login_link"`
+	// ExpiresAt is when the invitation of the recipient expires, for invitations and their reminders
+	ExpiresAt string `json:"expires_at"`
+	// LastUpdatedAt and LatestProjectEndedAt tell why a profile is stale, for stale profile reminders. The latter is
+	// only set when the latest project of the employee ended long ago.
+	LastUpdatedAt        string `json:"last_updated_at,omitempty"`
+	LatestProjectEndedAt string `json:"latest_project_ended_at,omitempty"`
+	// Digest is the summary of profiles sent in the profile digest
+	Digest *ProfileDigest `json:"digest,omitempty"`
+}
+
+// ListEmailTemplatesResponse lists the latest version of every email template, or every version of one
+type ListEmailTemplatesResponse struct {
+	Templates []EmailTemplate `json:"templates"`
+}
+
+// UpdateEmailTemplateRequest represents a request to save a new version of an email template.
+type UpdateEmailTemplateRequest struct {
+	Subject  string `json:"subject"`
+	HTMLBody string `json:"html_body"`
+	TextBody string `json:"text_body"`
+}
+
+// PreviewEmailTemplateRequest represents a request to render an email template. Fields left empty are taken from
+// the latest version of the template, and the email is rendered for a sample profile unless ProfileID is given.
+type PreviewEmailTemplateRequest struct {
+	Subject   string `json:"subject"`
+	HTMLBody  string `json:"html_body"`
+	TextBody  string `json:"text_body"`
+	ProfileID int    `json:"profile_id"`
+}
+
+// EmailPreviewResponse is a rendered email along with the data it was rendered with
+type EmailPreviewResponse struct {
+	Subject  string            `json:"subject"`
+	HTMLBody string            `json:"html_body"`
+	TextBody string            `json:"text_body"`
+	Data     EmailTemplateData `json:"data"`
+}
+
+// Validate func checks if the UpdateEmailTemplateRequest is valid.
+func (req *UpdateEmailTemplateRequest) Validate() error {
+	if strings.TrimSpace(req.Subject) == "" || strings.TrimSpace(req.HTMLBody) == "" || strings.TrimSpace(req.TextBody) == "" {
+		return errors.ErrInvalidEmailTemplate
+	}
+	return nil
+}
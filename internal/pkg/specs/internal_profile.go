@@ -0,0 +1,168 @@
+package specs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	errors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+)
+
+// The types below are version 1 of the schema served to other services under /api/internal/v1.
+// They are kept separate from the UI-facing specs so that the UI can change freely: fields may be
+// added to them, but never renamed or removed. Breaking changes need a new version.
+
+// InternalProfileV1 represents a full profile along with all of its sections.
+type InternalProfileV1 struct {
+	ProfileID         int                     `json:"profile_id"`
+	EmployeeID        *string                 `json:"employee_id"`
+	Name              string                  `json:"name"`
+	Email             string                  `json:"email"`
+	Gender            string                  `json:"gender"`
+	Mobile            string                  `json:"mobile"`
+	Designation       string                  `json:"designation"`
+	Description       string                  `json:"description"`
+	Title             string                  `json:"title"`
+	YearsOfExperience float64                 `json:"years_of_experience"`
+	PrimarySkills     []string                `json:"primary_skills"`
+	SecondarySkills   []string                `json:"secondary_skills"`
+	JoshJoiningDate   *string                 `json:"josh_joining_date"`
+	GithubLink        string                  `json:"github_link"`
+	LinkedinLink      string                  `json:"linkedin_link"`
+	CareerObjectives  string                  `json:"career_objectives"`
+	Educations        []InternalEducationV1   `json:"educations"`
+	Certificates      []InternalCertificateV1 `json:"certificates"`
+	Projects          []InternalProjectV1     `json:"projects"`
+	Experiences       []InternalExperienceV1  `json:"experiences"`
+	Achievements      []InternalAchievementV1 `json:"achievements"`
+}
+
+// InternalEducationV1 represents an education of a profile.
+type InternalEducationV1 struct {
+	ID               int    `json:"id"`
+	Degree           string `json:"degree"`
+	UniversityName   string `json:"university_name"`
+	Place            string `json:"place"`
+	PercentageOrCgpa string `json:"percentage_or_cgpa"`
+	PassingYear      string `json:"passing_year"`
+}
+
+// InternalCertificateV1 represents a certificate of a profile.
+type InternalCertificateV1 struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	OrganizationName string `json:"organization_name"`
+	Description      string `json:"description"`
+	IssuedDate       string `json:"issued_date"`
+	FromDate         string `json:"from_date"`
+	ToDate           string `json:"to_date"`
+}
+
+// InternalProjectV1 represents a project of a profile.
+type InternalProjectV1 struct {
+	ID               int      `json:"id"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	Role             string   `json:"role"`
+	Responsibilities string   `json:"responsibilities"`
+	Technologies     []string `json:"technologies"`
+	TechWorkedOn     []string `json:"tech_worked_on"`
+	WorkingStartDate string   `json:"working_start_date"`
+	WorkingEndDate   string   `json:"working_end_date"`
+	Duration         string   `json:"duration"`
+}
+
+// InternalExperienceV1 represents a previous job of a profile.
+type InternalExperienceV1 struct {
+	ID          int    `json:"id"`
+	Designation string `json:"designation"`
+	CompanyName string `json:"company_name"`
+	FromDate    string `json:"from_date"`
+	ToDate      string `json:"to_date"`
+}
+
+// InternalAchievementV1 represents an achievement of a profile.
+type InternalAchievementV1 struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ResolveEmployeesRequest represents a request to resolve many employee ids at once.
+type ResolveEmployeesRequest struct {
+	EmployeeIDs []string `json:"employee_ids"`
+}
+
+// ResolvedEmployeeV1 maps an employee id to its profile id.
+type ResolvedEmployeeV1 struct {
+	EmployeeID string `json:"employee_id"`
+	ProfileID  int    `json:"profile_id"`
+}
+
+// ResolveEmployeesResponseV1 lists the employee ids that have a profile and those that do not.
+type ResolveEmployeesResponseV1 struct {
+	Profiles []ResolvedEmployeeV1 `json:"profiles"`
+	NotFound []string             `json:"not_found"`
+}
+
+// ListProfileChangesFilter selects the profile changes after a position in the change feed. Changes made within Lag
+// of now are left out.
+type ListProfileChangesFilter struct {
+	Since   time.Time
+	AfterID int
+	Limit   int
+	Lag     time.Duration
+}
+
+// ProfileChangeV1 represents a profile that changed or was deleted.
+type ProfileChangeV1 struct {
+	EmployeeID string    `json:"employee_id"`
+	ProfileID  int       `json:"profile_id"`
+	ChangedAt  time.Time `json:"changed_at"`
+	Deleted    bool      `json:"deleted"`
+}
+
+// ProfileChangesResponseV1 represents a page of the change feed, oldest first. Passing next_since and
+// next_after_id back as since and after_id returns the next page.
+type ProfileChangesResponseV1 struct {
+	Changes     []ProfileChangeV1 `json:"changes"`
+	HasMore     bool              `json:"has_more"`
+	NextSince   time.Time         `json:"next_since"`
+	NextAfterID int               `json:"next_after_id"`
+}
+
+// Validate func checks if the ResolveEmployeesRequest is valid. Employee ids are trimmed and de-duplicated.
+func (req *ResolveEmployeesRequest) Validate() error {
+	if len(req.EmployeeIDs) == 0 {
+		return fmt.Errorf("%s : employee_ids ", errors.ErrEmptyPayload.Error())
+	}
+
+	if len(req.EmployeeIDs) > constants.MaxResolveEmployeeIDs {
+		return fmt.Errorf("%s : at most %d employee_ids ", errors.ErrInvalidRequestData.Error(), constants.MaxResolveEmployeeIDs)
+	}
+
+	seen := make(map[string]bool, len(req.EmployeeIDs))
+	employeeIDs := make([]string, 0, len(req.EmployeeIDs))
+	for _, employeeID := range req.EmployeeIDs {
+		employeeID = strings.TrimSpace(employeeID)
+		if employeeID == "" {
+			return fmt.Errorf("%s : employee_ids ", errors.ErrParameterMissing.Error())
+		}
+		if seen[employeeID] {
+			continue
+		}
+		seen[employeeID] = true
+		employeeIDs = append(employeeIDs, employeeID)
+	}
+	req.EmployeeIDs = employeeIDs
+	return nil
+}
+
+// Validate func checks if the ListProfileChangesFilter is valid.
+func (filter *ListProfileChangesFilter) Validate() error {
+	if filter.Limit > constants.MaxProfileChangesLimit {
+		return fmt.Errorf("%s : limit must not exceed %d ", errors.ErrInvalidRequestData.Error(), constants.MaxProfileChangesLimit)
+	}
+	return nil
+}
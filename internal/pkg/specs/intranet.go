@@ -1,5 +1,7 @@
 package specs
 
+import "time"
+
 // IntranetEmployee represents a single employee record returned by the Intranet API.
 type IntranetEmployee struct {
 	EmployeeID        string  `json:"employee_id"`
@@ -16,6 +18,8 @@ type IntranetEmployee struct {
 	SecondarySkill    string  `json:"secondary_skill"`
 	Qualification     string  `json:"qualification"`
 	Projects          []IntranetProject `json:"projects"`
+	Status            string  `json:"status"`
+	ExitDate          string  `json:"exit_date"`
 }
 
 // IntranetProject represents a project fetched from the Intranet API.
@@ -51,3 +55,29 @@ type IntranetProjectResponse struct {
 	StartDate   string `json:"startDate"`
 	EndDate     string `json:"endDate"`
 }
+
+// ListIntranetEmployeesOptions filters the employees fetched from the Intranet API.
+type ListIntranetEmployeesOptions struct {
+	// UpdatedSince fetches only the employees changed after this time; nil fetches everyone
+	UpdatedSince *time.Time
+}
+
+// IntranetEmployeesPage represents one page of a paginated employee list from the Intranet API. The next page is
+// asked for with the cursor, or with the page number for an intranet that pages by number.
+type IntranetEmployeesPage struct {
+	Employees  []IntranetEmployee `json:"employees"`
+	NextCursor string             `json:"next_cursor"`
+	NextPage   int                `json:"next_page"`
+}
+
+// IntranetCacheStats reports how often intranet lookups were answered from cache.
+type IntranetCacheStats struct {
+	Enabled bool `json:"enabled"`
+	Entries int  `json:"entries"`
+	// Hits were answered from cache, StaleHits from an expired entry while the intranet was unavailable
+	Hits      int64   `json:"hits"`
+	StaleHits int64   `json:"stale_hits"`
+	Misses    int64   `json:"misses"`
+	Errors    int64   `json:"errors"`
+	HitRate   float64 `json:"hit_rate"`
+}
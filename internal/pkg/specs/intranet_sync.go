@@ -0,0 +1,167 @@
+package specs
+
+import (
+	"strings"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+)
+
+// ProfileSyncChange represents a profile field changed by the intranet sync and why it was changed.
+type ProfileSyncChange struct {
+	ID         int64     `json:"id"`
+	ProfileID  int       `json:"profile_id"`
+	EmployeeID string    `json:"employee_id"`
+	Field      string    `json:"field"`
+	OldValue   string    `json:"old_value"`
+	NewValue   string    `json:"new_value"`
+	Policy     string    `json:"policy"`
+	Reason     string    `json:"reason"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ListProfileSyncChangesResponse lists the changes made to a profile by the intranet sync, newest first.
+type ListProfileSyncChangesResponse struct {
+	Changes []ProfileSyncChange `json:"changes"`
+}
+
+// SyncEmployeesOptions controls a run of the intranet employee sync.
+type SyncEmployeesOptions struct {
+	// DryRun plans the changes without writing them
+	DryRun bool
+	// Only limits the sync to these emails or employee ids; empty syncs everyone
+	Only []string
+	// DeactivateLeavers also deactivates the profiles of employees who have left
+	DeactivateLeavers bool
+	// Incremental fetches only the employees changed since the last successful sync
+	Incremental bool
+}
+
+// SyncFieldChange represents a profile field that the intranet sync changed, or would change on a dry run.
+type SyncFieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+	Policy   string `json:"policy"`
+	Reason   string `json:"reason"`
+}
+
+// SyncEmployeeResult represents the outcome of the intranet sync for one intranet employee.
+type SyncEmployeeResult struct {
+	EmployeeID string            `json:"employee_id"`
+	Email      string            `json:"email"`
+	ProfileID  int               `json:"profile_id,omitempty"`
+	Status     string            `json:"status"`
+	Employment string            `json:"employment,omitempty"`
+	SkipReason string            `json:"skip_reason,omitempty"`
+	Error      string            `json:"error,omitempty"`
+	Changes    []SyncFieldChange `json:"changes"`
+}
+
+// SyncEmployeesReport lists the outcome of an intranet sync run for every employee it considered.
+type SyncEmployeesReport struct {
+	DryRun bool `json:"dry_run"`
+	// UpdatedSince is the watermark an incremental run fetched changes after, if it had one
+	UpdatedSince *time.Time `json:"updated_since,omitempty"`
+	Updated      int        `json:"updated"`
+	Unchanged    int        `json:"unchanged"`
+	Skipped      int        `json:"skipped"`
+	Left         int        `json:"left"`
+	Rejoined     int        `json:"rejoined"`
+	// LeaverCheckSkipped explains why leavers were not looked for, when they were not
+	LeaverCheckSkipped string               `json:"leaver_check_skipped,omitempty"`
+	Employees          []SyncEmployeeResult `json:"employees"`
+}
+
+// Add appends the result for an employee to the report and counts it.
+func (report *SyncEmployeesReport) Add(result SyncEmployeeResult) {
+	switch result.Status {
+	case constants.SyncStatusUpdated, constants.SyncStatusWouldUpdate:
+		report.Updated++
+	case constants.SyncStatusUnchanged:
+		report.Unchanged++
+	default:
+		report.Skipped++
+	}
+
+	switch result.Employment {
+	case constants.EmploymentLeft:
+		report.Left++
+	case constants.EmploymentRejoined:
+		report.Rejoined++
+	}
+	report.Employees = append(report.Employees, result)
+}
+
+// HasErrors reports whether any employee could not be synced because of an error.
+func (report SyncEmployeesReport) HasErrors() bool {
+	return report.Errors() > 0
+}
+
+// HasDuplicateEmployeeIDs reports whether any employee was skipped because their employee id is listed more than
+// once or already belongs to another profile.
+func (report SyncEmployeesReport) HasDuplicateEmployeeIDs() bool {
+	for _, result := range report.Employees {
+		if result.SkipReason == constants.SyncSkipDuplicateEmployeeID {
+			return true
+		}
+	}
+	return false
+}
+
+// Errors counts the employees that could not be synced because of an error.
+func (report SyncEmployeesReport) Errors() int {
+	errors := 0
+	for _, result := range report.Employees {
+		if result.SkipReason == constants.SyncSkipUpdateError {
+			errors++
+		}
+	}
+	return errors
+}
+
+// IsExited reports whether the intranet marks the employee as having left.
+func (employee IntranetEmployee) IsExited() bool {
+	return strings.EqualFold(employee.Status, constants.IntranetStatusExited) || employee.ExitDate != ""
+}
+
+// ProfileEmployment represents the employment status of a profile, used to find leavers and re-joiners.
+type ProfileEmployment struct {
+	ProfileID         int     `json:"profile_id"`
+	Name              string  `json:"name"`
+	Email             string  `json:"email"`
+	EmployeeID        *string `json:"employee_id"`
+	IsCurrentEmployee int     `json:"is_current_employee"`
+	IsActive          int     `json:"is_active"`
+	CreatedByID       int     `json:"created_by_id"`
+}
+
+// StartSyncRunRequest represents the options of a sync run started by an admin. Without a body a full sync is run.
+type StartSyncRunRequest struct {
+	Incremental       bool `json:"incremental"`
+	DeactivateLeavers bool `json:"deactivate_leavers"`
+}
+
+// SyncRun represents one run of the intranet employee sync and its outcome.
+type SyncRun struct {
+	ID                int64      `json:"id"`
+	Trigger           string     `json:"trigger"`
+	TriggeredByID     *int       `json:"triggered_by_id"`
+	Incremental       bool       `json:"incremental"`
+	DeactivateLeavers bool       `json:"deactivate_leavers"`
+	Status            string     `json:"status"`
+	StartedAt         time.Time  `json:"started_at"`
+	FinishedAt        *time.Time `json:"finished_at"`
+	Updated           int        `json:"updated"`
+	Unchanged         int        `json:"unchanged"`
+	Skipped           int        `json:"skipped"`
+	Left              int        `json:"left"`
+	Rejoined          int        `json:"rejoined"`
+	Errors            int        `json:"errors"`
+	Error             string     `json:"error"`
+}
+
+// ListSyncRunsResponse lists intranet sync runs, newest first.
+type ListSyncRunsResponse struct {
+	Runs []SyncRun `json:"runs"`
+}
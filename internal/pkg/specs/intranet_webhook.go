@@ -0,0 +1,65 @@
+package specs
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+)
+
+// IntranetWebhookEvent represents an employee lifecycle event pushed by the intranet. Only the employee id is used:
+// the employee is fetched again from the intranet when the event is processed, so that events arriving late or out
+// of order still leave the profile as the intranet has it now.
+type IntranetWebhookEvent struct {
+	ID         string     `json:"id"`
+	Type       string     `json:"type"`
+	EmployeeID string     `json:"employee_id"`
+	OccurredAt *time.Time `json:"occurred_at"`
+}
+
+// Validate checks that the event has an id, a known type and an employee id
+func (event *IntranetWebhookEvent) Validate() error {
+	event.ID = strings.TrimSpace(event.ID)
+	event.EmployeeID = strings.TrimSpace(event.EmployeeID)
+	if event.ID == "" || event.EmployeeID == "" || !constants.IntranetEventTypes[event.Type] {
+		return errors.ErrInvalidWebhookEvent
+	}
+	return nil
+}
+
+// ReceiveIntranetWebhookResponse acknowledges an intranet event. Duplicate is true when the event had already
+// been received, in which case it is not processed again.
+type ReceiveIntranetWebhookResponse struct {
+	EventID   string `json:"event_id"`
+	Status    string `json:"status"`
+	Duplicate bool   `json:"duplicate"`
+}
+
+// IntranetWebhookEventRecord represents a stored intranet event and how processing it went.
+type IntranetWebhookEventRecord struct {
+	ID            int64               `json:"id"`
+	EventID       string              `json:"event_id"`
+	EventType     string              `json:"event_type"`
+	EmployeeID    string              `json:"employee_id"`
+	Payload       json.RawMessage     `json:"payload"`
+	Status        string              `json:"status"`
+	Attempts      int                 `json:"attempts"`
+	LastError     string              `json:"last_error"`
+	Result        *SyncEmployeeResult `json:"result"`
+	ReceivedAt    time.Time           `json:"received_at"`
+	NextAttemptAt time.Time           `json:"next_attempt_at"`
+	ProcessedAt   *time.Time          `json:"processed_at"`
+}
+
+// ListIntranetWebhookEventsFilter selects the intranet events to list
+type ListIntranetWebhookEventsFilter struct {
+	Status string
+	Limit  int
+}
+
+// ListIntranetWebhookEventsResponse lists stored intranet events, newest first.
+type ListIntranetWebhookEventsResponse struct {
+	Events []IntranetWebhookEventRecord `json:"events"`
+}
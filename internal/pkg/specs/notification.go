@@ -0,0 +1,57 @@
+package specs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+)
+
+// Notification represents an in-app notification of a user. Link is where the frontend takes the user to, if anywhere.
+type Notification struct {
+	ID        int64      `json:"id"`
+	UserID    int        `json:"user_id"`
+	Kind      string     `json:"kind"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	Link      string     `json:"link"`
+	ProfileID *int       `json:"profile_id"`
+	ReadAt    *time.Time `json:"read_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// ListNotificationsFilter narrows the notifications returned by a listing.
+type ListNotificationsFilter struct {
+	UnreadOnly bool `json:"unread"`
+	Page       int  `json:"page"`
+	Limit      int  `json:"limit"`
+}
+
+// ListNotificationsResponse represents a page of the notifications of a user, newest first, along with how many of
+// them are unread.
+type ListNotificationsResponse struct {
+	Notifications []Notification `json:"notifications"`
+	UnreadCount   int            `json:"unread_count"`
+	Page          int            `json:"page"`
+	Limit         int            `json:"limit"`
+}
+
+// MarkAllNotificationsReadResponse tells how many notifications were marked read
+type MarkAllNotificationsReadResponse struct {
+	MarkedRead int `json:"marked_read"`
+}
+
+// NotificationEvent is sent on the notification stream of a user, with the notification when one is created
+type NotificationEvent struct {
+	Notification *Notification `json:"notification,omitempty"`
+	UnreadCount  int           `json:"unread_count"`
+}
+
+// Validate func checks if the ListNotificationsFilter is valid.
+func (filter *ListNotificationsFilter) Validate() error {
+	if filter.Limit > constants.MaxNotificationsLimit {
+		return fmt.Errorf("%s : limit must not exceed %d ", errors.ErrInvalidRequestData.Error(), constants.MaxNotificationsLimit)
+	}
+	return nil
+}
@@ -41,6 +41,12 @@ type Profile struct {
 	EmployeeID        string   `json:"employee_id"`
 }
 
+// ListProfilesFilter narrows the profiles returned by a listing. A zero ManagerID and ProfileID lists every profile.
+type ListProfilesFilter struct {
+	ManagerID int `json:"manager_id"`
+	ProfileID int `json:"profile_id"`
+}
+
 // ListProfiles struct represents details of user profiles for listing.
 type ListProfiles struct {
 	ID                int            `json:"id"`
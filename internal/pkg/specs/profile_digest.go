@@ -0,0 +1,47 @@
+package specs
+
+import "time"
+
+// ProfileActivity tells when the profile of a current employee was last updated and when their projects ended,
+// to find out whether it has gone stale.
+type ProfileActivity struct {
+	ProfileID int
+	Name      string
+	Email     string
+	UpdatedAt time.Time
+	// ProjectEndDates are the working end dates of the projects as entered, empty for ongoing projects
+	ProjectEndDates   []string
+	HasOpenInvitation bool
+	// HasCompletedInvitation tells whether the employee has completed an invitation, and so can log in to update it
+	HasCompletedInvitation bool
+	RemindersSent          int
+	LastRemindedAt         *time.Time
+}
+
+// StaleProfile is a profile of a current employee that has not been kept up to date. LatestProjectEndedAt is set
+// when it is stale because the latest project of the employee ended long ago.
+type StaleProfile struct {
+	ProfileID            int
+	Name                 string
+	Email                string
+	UpdatedAt            time.Time
+	LatestProjectEndedAt *time.Time
+}
+
+// ProfileDigest summarises the profiles needing attention, and those completed, between From and To.
+type ProfileDigest struct {
+	From               string          `json:"from"`
+	To                 string          `json:"to"`
+	StaleProfiles      []DigestProfile `json:"stale_profiles"`
+	IncompleteProfiles []DigestProfile `json:"incomplete_profiles"`
+	CompletedProfiles  []DigestProfile `json:"completed_profiles"`
+}
+
+// DigestProfile is a profile listed in the profile digest. Note tells why it is listed, e.g. when it was last updated.
+type DigestProfile struct {
+	ProfileID   int    `json:"profile_id"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	ProfileLink string `json:"profile_link"`
+	Note        string `json:"note"`
+}
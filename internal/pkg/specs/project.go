@@ -2,6 +2,7 @@ package specs
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
 )
@@ -111,3 +112,72 @@ type DeleteProjectRequest struct {
 	ProfileID int `json:"profile_id"`
 	ProjectID int `json:"id"`
 }
+
+// IntranetProjectChange represents a project field that importing an intranet project would change.
+type IntranetProjectChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// IntranetProjectProposal represents an intranet project proposed for a profile: an addition, or an update of the
+// profile project with the same name.
+type IntranetProjectProposal struct {
+	Action    string                  `json:"action"`
+	ProjectID int                     `json:"project_id,omitempty"`
+	Name      string                  `json:"name"`
+	Changes   []IntranetProjectChange `json:"changes"`
+}
+
+// IntranetProjectProposalsResponse lists the intranet projects proposed for a profile.
+type IntranetProjectProposalsResponse struct {
+	EmployeeID string                    `json:"employee_id"`
+	Proposals  []IntranetProjectProposal `json:"proposals"`
+}
+
+// IntranetProjectDecision represents whether an employee accepts or rejects a proposed intranet project.
+type IntranetProjectDecision struct {
+	Name   string `json:"name"`
+	Accept bool   `json:"accept"`
+}
+
+// ApplyIntranetProjectsRequest represents the decisions taken on the intranet projects proposed for a profile.
+type ApplyIntranetProjectsRequest struct {
+	Decisions []IntranetProjectDecision `json:"decisions"`
+}
+
+// ApplyIntranetProjectsResponse counts the intranet projects added, updated and rejected.
+type ApplyIntranetProjectsResponse struct {
+	Added    int `json:"added"`
+	Updated  int `json:"updated"`
+	Rejected int `json:"rejected"`
+}
+
+// IntranetProjectRejection represents an intranet project an employee chose not to import, with the values it was
+// proposed with.
+type IntranetProjectRejection struct {
+	NameKey          string `json:"name_key"`
+	Description      string `json:"description"`
+	WorkingStartDate string `json:"working_start_date"`
+	WorkingEndDate   string `json:"working_end_date"`
+}
+
+// Validate func checks if the ApplyIntranetProjectsRequest is valid.
+func (req *ApplyIntranetProjectsRequest) Validate() error {
+	if len(req.Decisions) == 0 {
+		return fmt.Errorf("%s : decisions ", errors.ErrEmptyPayload.Error())
+	}
+
+	seen := map[string]bool{}
+	for _, decision := range req.Decisions {
+		key := strings.ToLower(strings.TrimSpace(decision.Name))
+		if key == "" {
+			return fmt.Errorf("%s : name ", errors.ErrParameterMissing.Error())
+		}
+		if seen[key] {
+			return fmt.Errorf("%s : %s ", errors.ErrDuplicateDecision.Error(), decision.Name)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package specs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	errors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+)
+
+var roleNameRegex = regexp.MustCompile("^[a-z][a-z0-9_]{1,49}$")
+
+// RolePermission represents a permission granted to a role and the profiles it applies to.
+type RolePermission struct {
+	Permission string `json:"permission"`
+	Scope      string `json:"scope"`
+}
+
+// RoleResponse represents a role along with its granted permissions.
+type RoleResponse struct {
+	ID          int              `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	IsSystem    bool             `json:"is_system"`
+	Permissions []RolePermission `json:"permissions"`
+}
+
+// ListRolesResponse represents the list of roles returned to admins.
+type ListRolesResponse struct {
+	Roles []RoleResponse `json:"roles"`
+}
+
+// RoleMessageResponse represents a JSON response message along with the ID of the role.
+type RoleMessageResponse struct {
+	Message string `json:"message"`
+	RoleID  int    `json:"role_id"`
+}
+
+// PermissionResponse describes a permission that can be granted to a role.
+type PermissionResponse struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ListPermissionsResponse represents all permissions known to the application.
+type ListPermissionsResponse struct {
+	Permissions []PermissionResponse `json:"permissions"`
+	Scopes      []string             `json:"scopes"`
+}
+
+// CreateRoleRequest represents a request to create a role.
+type CreateRoleRequest struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Permissions []RolePermission `json:"permissions"`
+}
+
+// UpdateRoleRequest represents a request to replace the description and permissions of a role.
+type UpdateRoleRequest struct {
+	Description string           `json:"description"`
+	Permissions []RolePermission `json:"permissions"`
+}
+
+// AssignRoleRequest represents a request to change the role of a user.
+type AssignRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// AssignManagerRequest represents a request to set or clear the reporting manager of a profile.
+type AssignManagerRequest struct {
+	ManagerID *int `json:"manager_id"`
+}
+
+// Validate func checks if the CreateRoleRequest is valid.
+func (req *CreateRoleRequest) Validate() error {
+	req.Name = strings.TrimSpace(req.Name)
+	if !roleNameRegex.MatchString(req.Name) {
+		return errors.ErrInvalidRoleName
+	}
+	return validateRolePermissions(req.Permissions)
+}
+
+// Validate func checks if the UpdateRoleRequest is valid.
+func (req *UpdateRoleRequest) Validate() error {
+	return validateRolePermissions(req.Permissions)
+}
+
+// Validate func checks if the AssignRoleRequest is valid.
+func (req *AssignRoleRequest) Validate() error {
+	req.Role = strings.TrimSpace(req.Role)
+	if req.Role == "" {
+		return fmt.Errorf("%s : role ", errors.ErrParameterMissing.Error())
+	}
+	return nil
+}
+
+// Validate func checks if the AssignManagerRequest is valid.
+func (req *AssignManagerRequest) Validate() error {
+	if req.ManagerID != nil && *req.ManagerID <= 0 {
+		return errors.ErrInvalidUserID
+	}
+	return nil
+}
+
+func validateRolePermissions(permissions []RolePermission) error {
+	seen := make(map[string]bool, len(permissions))
+	for _, perm := range permissions {
+		if _, ok := constants.Permissions[perm.Permission]; !ok {
+			return fmt.Errorf("%s : %s ", errors.ErrUnknownPermission.Error(), perm.Permission)
+		}
+		if perm.Scope != constants.ScopeAll && perm.Scope != constants.ScopeReportees && perm.Scope != constants.ScopeOwn {
+			return fmt.Errorf("%s : %s ", errors.ErrInvalidScope.Error(), perm.Scope)
+		}
+		if seen[perm.Permission] {
+			return fmt.Errorf("%s : %s ", errors.ErrDuplicateKey.Error(), perm.Permission)
+		}
+		seen[perm.Permission] = true
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package specs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	errors "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+)
+
+// ListUsersFilter narrows the users returned by a listing.
+type ListUsersFilter struct {
+	Role     string `json:"role"`
+	IsActive *bool  `json:"is_active"`
+	// Permission narrows the listing to users whose role grants it
+	Permission string `json:"permission"`
+}
+
+// UserResponse represents a user account as seen by admins.
+type UserResponse struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Email       string     `json:"email"`
+	Role        string     `json:"role"`
+	IsActive    bool       `json:"is_active"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ListUsersResponse represents the list of users returned to admins.
+type ListUsersResponse struct {
+	Users []UserResponse `json:"users"`
+}
+
+// UserMessageResponse represents a JSON response message along with the ID of the user.
+type UserMessageResponse struct {
+	Message string `json:"message"`
+	UserID  int    `json:"user_id"`
+}
+
+// CreateUserRequest represents a request to add a user account.
+type CreateUserRequest struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// UpdateUserRequest represents a request to change the name and role of a user.
+type UpdateUserRequest struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+// UpdateUserStatusRequest represents a request to activate or deactivate a user.
+type UpdateUserStatusRequest struct {
+	IsActive *bool `json:"is_active"`
+}
+
+// Validate func checks if the CreateUserRequest is valid.
+func (req *CreateUserRequest) Validate() error {
+	req.Name = strings.TrimSpace(req.Name)
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+	req.Role = strings.TrimSpace(req.Role)
+
+	if req.Name == "" {
+		return fmt.Errorf("%s : name ", errors.ErrParameterMissing.Error())
+	}
+
+	if req.Email == "" {
+		return fmt.Errorf("%s : email ", errors.ErrParameterMissing.Error())
+	}
+
+	matchMail, _ := regexp.MatchString(constants.EmailRegex, req.Email)
+	if !matchMail {
+		return fmt.Errorf("%s : email ", errors.ErrInvalidFormat.Error())
+	}
+
+	if req.Role == "" {
+		return fmt.Errorf("%s : role ", errors.ErrParameterMissing.Error())
+	}
+	return nil
+}
+
+// Validate func checks if the UpdateUserRequest is valid.
+func (req *UpdateUserRequest) Validate() error {
+	req.Name = strings.TrimSpace(req.Name)
+	req.Role = strings.TrimSpace(req.Role)
+
+	if req.Name == "" {
+		return fmt.Errorf("%s : name ", errors.ErrParameterMissing.Error())
+	}
+
+	if req.Role == "" {
+		return fmt.Errorf("%s : role ", errors.ErrParameterMissing.Error())
+	}
+	return nil
+}
+
+// Validate func checks if the UpdateUserStatusRequest is valid.
+func (req *UpdateUserStatusRequest) Validate() error {
+	if req.IsActive == nil {
+		return fmt.Errorf("%s : is_active ", errors.ErrParameterMissing.Error())
+	}
+	return nil
+}
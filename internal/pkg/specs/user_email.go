@@ -1,6 +1,12 @@
 package specs
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+)
 
 // InvitationResponse struct to store invitation details
 type InvitationResponse struct {
@@ -11,3 +17,81 @@ type InvitationResponse struct {
 	CreatedByID     int       `json:"created_by_id"`
 	UpdatedByID     int       `json:"updated_by_id"`
 }
+
+// PendingInvitation represents an invitation the employee has not completed yet, along with how long it has been
+// outstanding and the reminders sent for it.
+type PendingInvitation struct {
+	ProfileID       int        `json:"profile_id"`
+	Name            string     `json:"name"`
+	Email           string     `json:"email"`
+	CreatedAt       time.Time  `json:"created_at"`
+	CreatedByID     int        `json:"created_by_id"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	RemindersSent   int        `json:"reminders_sent"`
+	LastRemindedAt  *time.Time `json:"last_reminded_at"`
+	ResentCount     int        `json:"resent_count"`
+	DaysOutstanding int        `json:"days_outstanding"`
+	IsOverdue       bool       `json:"is_overdue"`
+}
+
+// Invitation represents an invitation sent to an employee, open or completed, along with who sent it. HoursToComplete
+// is how long the employee took to complete the profile, and DaysOutstanding how long an open invitation has been
+// waiting; each is null otherwise.
+type Invitation struct {
+	ID              int        `json:"id"`
+	ProfileID       int        `json:"profile_id"`
+	Name            string     `json:"name"`
+	Email           string     `json:"email"`
+	Status          string     `json:"status"`
+	InvitedByID     int        `json:"invited_by_id"`
+	InvitedByName   *string    `json:"invited_by_name"`
+	InvitedByEmail  *string    `json:"invited_by_email"`
+	CreatedAt       time.Time  `json:"created_at"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	CompletedAt     *time.Time `json:"completed_at"`
+	HoursToComplete *float64   `json:"hours_to_complete"`
+	DaysOutstanding *int       `json:"days_outstanding"`
+	RemindersSent   int        `json:"reminders_sent"`
+	LastRemindedAt  *time.Time `json:"last_reminded_at"`
+	ResentCount     int        `json:"resent_count"`
+}
+
+// ListInvitationsFilter narrows the invitations returned by a listing. From and To bound when they were sent.
+type ListInvitationsFilter struct {
+	ProfileID int `json:"profile_id"`
+	// ManagerID narrows the listing to the invitations of the profiles a user manages, for the reportees scope
+	ManagerID   int        `json:"manager_id"`
+	Status      string     `json:"status"`
+	InvitedByID int        `json:"invited_by"`
+	From        *time.Time `json:"from"`
+	To          *time.Time `json:"to"`
+	// CompletedFrom narrows the listing to invitations completed since then, for the profile digest
+	CompletedFrom *time.Time `json:"completed_from"`
+	Page          int        `json:"page"`
+	Limit         int        `json:"limit"`
+}
+
+// ListInvitationsResponse represents a page of invitations, the latest sent first
+type ListInvitationsResponse struct {
+	Invitations []Invitation `json:"invitations"`
+	Page        int          `json:"page"`
+	Limit       int          `json:"limit"`
+}
+
+// Validate func checks if the ListInvitationsFilter is valid.
+func (filter *ListInvitationsFilter) Validate() error {
+	switch filter.Status {
+	case "", constants.InvitationPending, constants.InvitationOverdue, constants.InvitationCompleted:
+	default:
+		return fmt.Errorf("%s : %s ", errors.ErrInvalidFormat.Error(), constants.InvitationsStatusStr)
+	}
+
+	if filter.Limit > constants.MaxInvitationsLimit {
+		return fmt.Errorf("%s : limit must not exceed %d ", errors.ErrInvalidRequestData.Error(), constants.MaxInvitationsLimit)
+	}
+
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return fmt.Errorf("%s : to must not be before from ", errors.ErrInvalidRequestData.Error())
+	}
+	return nil
+}
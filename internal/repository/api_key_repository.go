@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// APIKeyStore implements the APIKeyStorer interface.
+type APIKeyStore struct {
+	db *pgxpool.Pool
+}
+
+// Constants for API key table names
+var (
+	apiKeyTable = "api_keys"
+)
+
+// apiKeyColumns are the columns of an API key shown to admins, in the order scanned by scanAPIKey
+var apiKeyColumns = []string{
+	"id", "name", "prefix", "scopes", "allowed_ips", "expires_at", "last_used_at", "last_used_ip",
+	"revoked_at", "created_by_id", "created_at", "updated_at",
+}
+
+// APIKeyStorer defines methods to manage the API keys used by other services.
+type APIKeyStorer interface {
+	ListAPIKeys(ctx context.Context, tx pgx.Tx) ([]specs.APIKeyResponse, error)
+	GetAPIKey(ctx context.Context, apiKeyID int, tx pgx.Tx) (specs.APIKeyResponse, error)
+	CreateAPIKey(ctx context.Context, apiKey APIKeyRepo, tx pgx.Tx) (int, error)
+	RotateAPIKey(ctx context.Context, apiKeyID int, prefix string, keyHash string, updatedAt string, tx pgx.Tx) error
+	RevokeAPIKey(ctx context.Context, apiKeyID int, revokedAt string, tx pgx.Tx) error
+	GetAPIKeyCredential(ctx context.Context, prefix string) (APIKeyCredential, error)
+	UpdateAPIKeyLastUsed(ctx context.Context, apiKeyID int, usedAt time.Time, ip string) error
+}
+
+// NewAPIKeyRepo creates a new instance of APIKeyRepo.
+func NewAPIKeyRepo(db *pgxpool.Pool) APIKeyStorer {
+	return &APIKeyStore{
+		db: db,
+	}
+}
+
+// scanAPIKey scans a row selected with apiKeyColumns.
+func scanAPIKey(row pgx.Row) (specs.APIKeyResponse, error) {
+	var apiKey specs.APIKeyResponse
+	err := row.Scan(&apiKey.ID, &apiKey.Name, &apiKey.Prefix, &apiKey.Scopes, &apiKey.AllowedIPs, &apiKey.ExpiresAt,
+		&apiKey.LastUsedAt, &apiKey.LastUsedIP, &apiKey.RevokedAt, &apiKey.CreatedByID, &apiKey.CreatedAt, &apiKey.UpdatedAt)
+	return apiKey, err
+}
+
+// ListAPIKeys returns every API key, including revoked ones.
+func (apiKeyStore *APIKeyStore) ListAPIKeys(ctx context.Context, tx pgx.Tx) ([]specs.APIKeyResponse, error) {
+	query, args, err := psql.Select(apiKeyColumns...).From(apiKeyTable).OrderBy("id").ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list API keys query: ", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list API keys query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	apiKeys := []specs.APIKeyResponse{}
+	for rows.Next() {
+		apiKey, err := scanAPIKey(rows)
+		if err != nil {
+			zap.S().Error("Error scanning API key row: ", err)
+			return nil, err
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+
+	return apiKeys, rows.Err()
+}
+
+// GetAPIKey returns a single API key by id.
+func (apiKeyStore *APIKeyStore) GetAPIKey(ctx context.Context, apiKeyID int, tx pgx.Tx) (specs.APIKeyResponse, error) {
+	query, args, err := psql.Select(apiKeyColumns...).From(apiKeyTable).Where(sq.Eq{"id": apiKeyID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get API key query: ", err)
+		return specs.APIKeyResponse{}, err
+	}
+
+	apiKey, err := scanAPIKey(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return specs.APIKeyResponse{}, errors.ErrAPIKeyNotFound
+		}
+		zap.S().Error("Error executing get API key query: ", err)
+		return specs.APIKeyResponse{}, err
+	}
+	return apiKey, nil
+}
+
+// CreateAPIKey stores a new API key and returns its id.
+func (apiKeyStore *APIKeyStore) CreateAPIKey(ctx context.Context, apiKey APIKeyRepo, tx pgx.Tx) (int, error) {
+	query, args, err := psql.Insert(apiKeyTable).
+		Columns("name", "prefix", "key_hash", "scopes", "allowed_ips", "expires_at", "created_by_id", "created_at", "updated_at").
+		Values(apiKey.Name, apiKey.Prefix, apiKey.KeyHash, apiKey.Scopes, apiKey.AllowedIPs, apiKey.ExpiresAt, apiKey.CreatedByID, apiKey.CreatedAt, apiKey.UpdatedAt).
+		Suffix("RETURNING id").ToSql()
+	if err != nil {
+		zap.S().Error("Error generating create API key query: ", err)
+		return 0, err
+	}
+
+	var apiKeyID int
+	err = tx.QueryRow(ctx, query, args...).Scan(&apiKeyID)
+	if err != nil {
+		if helpers.IsDuplicateKeyError(err) {
+			return 0, errors.ErrDuplicateKey
+		}
+		zap.S().Error("Error executing create API key query: ", err)
+		return 0, err
+	}
+	return apiKeyID, nil
+}
+
+// RotateAPIKey replaces the key of an API key, keeping its name, scopes and restrictions.
+func (apiKeyStore *APIKeyStore) RotateAPIKey(ctx context.Context, apiKeyID int, prefix string, keyHash string, updatedAt string, tx pgx.Tx) error {
+	query, args, err := psql.Update(apiKeyTable).
+		Set("prefix", prefix).
+		Set("key_hash", keyHash).
+		Set("last_used_at", nil).
+		Set("last_used_ip", nil).
+		Set("updated_at", updatedAt).
+		Where(sq.Eq{"id": apiKeyID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating rotate API key query: ", err)
+		return err
+	}
+
+	res, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		if helpers.IsDuplicateKeyError(err) {
+			return errors.ErrDuplicateKey
+		}
+		zap.S().Error("Error executing rotate API key query: ", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return errors.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// RevokeAPIKey marks an API key as revoked. The row is kept so that its use stays traceable.
+func (apiKeyStore *APIKeyStore) RevokeAPIKey(ctx context.Context, apiKeyID int, revokedAt string, tx pgx.Tx) error {
+	query, args, err := psql.Update(apiKeyTable).
+		Set("revoked_at", revokedAt).
+		Set("updated_at", revokedAt).
+		Where(sq.Eq{"id": apiKeyID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating revoke API key query: ", err)
+		return err
+	}
+
+	res, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing revoke API key query: ", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return errors.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// GetAPIKeyCredential returns the hash and restrictions of the API key with the given prefix.
+func (apiKeyStore *APIKeyStore) GetAPIKeyCredential(ctx context.Context, prefix string) (APIKeyCredential, error) {
+	query, args, err := psql.Select("id", "name", "key_hash", "scopes", "allowed_ips", "expires_at", "last_used_at", "revoked_at").
+		From(apiKeyTable).Where(sq.Eq{"prefix": prefix}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get API key credential query: ", err)
+		return APIKeyCredential{}, err
+	}
+
+	var credential APIKeyCredential
+	err = apiKeyStore.db.QueryRow(ctx, query, args...).Scan(&credential.ID, &credential.Name, &credential.KeyHash, &credential.Scopes,
+		&credential.AllowedIPs, &credential.ExpiresAt, &credential.LastUsedAt, &credential.RevokedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return APIKeyCredential{}, errors.ErrAPIKeyNotFound
+		}
+		zap.S().Error("Error executing get API key credential query: ", err)
+		return APIKeyCredential{}, err
+	}
+	return credential, nil
+}
+
+// UpdateAPIKeyLastUsed records when and from where an API key was last used.
+func (apiKeyStore *APIKeyStore) UpdateAPIKeyLastUsed(ctx context.Context, apiKeyID int, usedAt time.Time, ip string) error {
+	query, args, err := psql.Update(apiKeyTable).
+		Set("last_used_at", usedAt).
+		Set("last_used_ip", ip).
+		Where(sq.Eq{"id": apiKeyID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating update API key last used query: ", err)
+		return err
+	}
+
+	_, err = apiKeyStore.db.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing update API key last used query: ", err)
+		return err
+	}
+	return nil
+}
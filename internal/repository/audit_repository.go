@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// AuditStore implements the AuditStorer interface.
+type AuditStore struct {
+	db *pgxpool.Pool
+}
+
+// Constants for audit table names
+var (
+	auditEventTable = "audit_events"
+)
+
+// AuditStorer defines methods to write and query the audit log. Row changes are recorded by database
+// triggers, so only events that do not change an audited row are written through CreateAuditEvent.
+type AuditStorer interface {
+	CreateAuditEvent(ctx context.Context, event AuditEventRepo, tx pgx.Tx) error
+	ListAuditEvents(ctx context.Context, filter specs.ListAuditEventsFilter, tx pgx.Tx) ([]specs.AuditEventResponse, error)
+}
+
+// NewAuditRepo creates a new instance of AuditRepo.
+func NewAuditRepo(db *pgxpool.Pool) AuditStorer {
+	return &AuditStore{
+		db: db,
+	}
+}
+
+// CreateAuditEvent appends an event to the audit log.
+func (auditStore *AuditStore) CreateAuditEvent(ctx context.Context, event AuditEventRepo, tx pgx.Tx) error {
+	query, args, err := psql.Insert(auditEventTable).
+		Columns("actor_id", "actor_role", "action", "target_type", "target_id", "request_id").
+		Values(event.ActorID, event.ActorRole, event.Action, event.TargetType, event.TargetID, sq.Expr("NULLIF(?, '')", event.RequestID)).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating create audit event query: ", err)
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing create audit event query: ", err)
+		return err
+	}
+	return nil
+}
+
+// ListAuditEvents returns a page of audit events matching the filter, newest first.
+func (auditStore *AuditStore) ListAuditEvents(ctx context.Context, filter specs.ListAuditEventsFilter, tx pgx.Tx) ([]specs.AuditEventResponse, error) {
+	query := psql.Select("id", "actor_id", "actor_role", "action", "target_type", "target_id", "profile_id", "before", "after", "request_id", "created_at").
+		From(auditEventTable).
+		OrderBy("id DESC").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64((filter.Page - 1) * filter.Limit))
+
+	if filter.ActorID != 0 {
+		query = query.Where(sq.Eq{"actor_id": filter.ActorID})
+	}
+	if filter.ProfileID != 0 {
+		query = query.Where(sq.Eq{"profile_id": filter.ProfileID})
+	}
+	if filter.Action != "" {
+		query = query.Where(sq.Eq{"action": filter.Action})
+	}
+	if filter.TargetType != "" {
+		query = query.Where(sq.Eq{"target_type": filter.TargetType})
+	}
+	if filter.From != nil {
+		query = query.Where(sq.GtOrEq{"created_at": *filter.From})
+	}
+	if filter.To != nil {
+		query = query.Where(sq.LtOrEq{"created_at": *filter.To})
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list audit events query: ", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		zap.S().Error("Error executing list audit events query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []specs.AuditEventResponse{}
+	for rows.Next() {
+		var event specs.AuditEventResponse
+		err = rows.Scan(&event.ID, &event.ActorID, &event.ActorRole, &event.Action, &event.TargetType, &event.TargetID,
+			&event.ProfileID, &event.Before, &event.After, &event.RequestID, &event.CreatedAt)
+		if err != nil {
+			zap.S().Error("Error scanning audit event row: ", err)
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// setAuditContext hands the actor, request and action of ctx to the audit triggers for the rest of the transaction.
+func setAuditContext(ctx context.Context, tx pgx.Tx) error {
+	actorID, _ := ctx.Value(constants.UserIDKey).(float64)
+	role, _ := ctx.Value(constants.UserRoleKey).(string)
+	requestID, _ := ctx.Value(constants.RequestIDKey).(string)
+	action, _ := ctx.Value(constants.AuditActionKey).(string)
+	if actorID == 0 && role == "" && requestID == "" && action == "" {
+		return nil
+	}
+
+	actor := ""
+	if actorID != 0 {
+		actor = strconv.Itoa(int(actorID))
+	}
+
+	_, err := tx.Exec(ctx, `SELECT set_config('audit.actor_id', $1, true), set_config('audit.actor_role', $2, true),
+		set_config('audit.request_id', $3, true), set_config('audit.action', $4, true)`, actor, role, requestID, action)
+	if err != nil {
+		zap.S().Error("Error setting audit context: ", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// BackupStore implements the BackupStorer interface.
+type BackupStore struct {
+	db *pgxpool.Pool
+}
+
+// Constants for backup table names
+var (
+	schemaMigrationTable    = "schema_migrations"
+	backupVerificationTable = "backup_verifications"
+)
+
+// backupExportQueries select the rows of the backup tables that are not exported as they are. Role permissions are
+// exported with the name of their role, as the ids of roles are not kept on restore.
+var backupExportQueries = map[string]string{
+	"role_permissions": "SELECT to_jsonb(t) || jsonb_build_object('role', r.name) FROM role_permissions t JOIN roles r ON r.id = t.role_id ORDER BY t.role_id, t.permission",
+}
+
+// backupMergeQueries load the rows of constants.BackupMergedTables, given as a JSON array in $1, into the rows
+// migrations seeded. A role in the backup replaces the role of the same name along with its permissions, and
+// templates are added unless the database has their kind and version.
+var backupMergeQueries = map[string]string{
+	"roles": `WITH merged AS (
+		INSERT INTO roles (name, description, is_system, created_at, updated_at)
+		SELECT name, description, is_system, created_at, updated_at FROM jsonb_populate_recordset(NULL::roles, $1::JSONB)
+		ON CONFLICT (name) DO UPDATE SET description = EXCLUDED.description, is_system = EXCLUDED.is_system,
+			created_at = EXCLUDED.created_at, updated_at = EXCLUDED.updated_at
+		RETURNING id
+	)
+	DELETE FROM role_permissions WHERE role_id IN (SELECT id FROM merged)`,
+	"role_permissions": `INSERT INTO role_permissions (role_id, permission, scope)
+		SELECT r.id, p.permission, p.scope FROM jsonb_to_recordset($1::JSONB) AS p(role TEXT, permission TEXT, scope TEXT)
+		JOIN roles r ON r.name = p.role`,
+	"email_templates": `INSERT INTO email_templates (kind, version, subject, html_body, text_body, created_by_id, created_at)
+		SELECT kind, version, subject, html_body, text_body, created_by_id, created_at FROM jsonb_populate_recordset(NULL::email_templates, $1::JSONB)
+		ON CONFLICT (kind, version) DO NOTHING`,
+}
+
+// BackupStorer defines methods to export the rows of tables as JSON and to load them back. Table names are quoted,
+// but must still be one of constants.BackupTables.
+type BackupStorer interface {
+	BeginSnapshot(ctx context.Context) (pgx.Tx, error)
+	GetSchemaVersion(ctx context.Context, tx pgx.Tx) (int64, error)
+	ExportTableRows(ctx context.Context, table string, tx pgx.Tx, write func(row json.RawMessage) error) (int, error)
+	CountTableRows(ctx context.Context, table string, tx pgx.Tx) (int, error)
+	ImportTableRows(ctx context.Context, table string, rows []json.RawMessage, tx pgx.Tx) error
+	ResetTableIdentity(ctx context.Context, table string, tx pgx.Tx) error
+	ListMissingUserRoles(ctx context.Context, tx pgx.Tx) ([]string, error)
+	DisableTriggers(ctx context.Context, tx pgx.Tx) (bool, error)
+	CreateScratchSchema(ctx context.Context, schema string, tables []string, tx pgx.Tx) error
+	DropScratchSchema(ctx context.Context, schema string, tx pgx.Tx) error
+	CreateBackupVerification(ctx context.Context, verification BackupVerificationRepo) (specs.BackupVerification, error)
+	ListLatestBackupVerifications(ctx context.Context, fileNames []string) (map[string]specs.BackupVerification, error)
+}
+
+// NewBackupRepo creates a new instance of BackupRepo.
+func NewBackupRepo(db *pgxpool.Pool) BackupStorer {
+	return &BackupStore{
+		db: db,
+	}
+}
+
+// BeginSnapshot begins a read-only transaction in which every table is read as of the same moment
+func (backupStore *BackupStore) BeginSnapshot(ctx context.Context) (pgx.Tx, error) {
+	tx, err := backupStore.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		zap.S().Error("Error beginning backup snapshot: ", err)
+		return nil, err
+	}
+	return tx, nil
+}
+
+// GetSchemaVersion returns the migration the database is at. It returns ErrDirtySchemaMigration if the last
+// migration failed part way.
+func (backupStore *BackupStore) GetSchemaVersion(ctx context.Context, tx pgx.Tx) (int64, error) {
+	query, args, err := psql.Select("version", "dirty").From(schemaMigrationTable).Limit(1).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get schema version query: ", err)
+		return 0, err
+	}
+
+	var version int64
+	var dirty bool
+	err = tx.QueryRow(ctx, query, args...).Scan(&version, &dirty)
+	if err != nil {
+		zap.S().Error("Error executing get schema version query: ", err)
+		return 0, err
+	}
+	if dirty {
+		return 0, errors.ErrDirtySchemaMigration
+	}
+	return version, nil
+}
+
+// ExportTableRows passes every row of a table to write as the JSON object of its columns, by id, and returns how
+// many there were
+func (backupStore *BackupStore) ExportTableRows(ctx context.Context, table string, tx pgx.Tx, write func(row json.RawMessage) error) (int, error) {
+	query, ok := backupExportQueries[table]
+	if !ok {
+		query = fmt.Sprintf("SELECT to_jsonb(t) FROM %s t ORDER BY t.id", pgx.Identifier{table}.Sanitize())
+	}
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		zap.S().Errorf("Error executing export %s query: %v", table, err)
+		return 0, err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		var row json.RawMessage
+		err = rows.Scan(&row)
+		if err != nil {
+			zap.S().Errorf("Error scanning %s row: %v", table, err)
+			return 0, err
+		}
+		err = write(row)
+		if err != nil {
+			return 0, err
+		}
+		count++
+	}
+	return count, rows.Err()
+}
+
+// CountTableRows returns how many rows a table has
+func (backupStore *BackupStore) CountTableRows(ctx context.Context, table string, tx pgx.Tx) (int, error) {
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s", pgx.Identifier{table}.Sanitize())
+
+	var count int
+	err := tx.QueryRow(ctx, query).Scan(&count)
+	if err != nil {
+		zap.S().Errorf("Error executing count %s query: %v", table, err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// ImportTableRows inserts rows given as JSON objects of their columns into a table, keeping their ids. The rows of
+// constants.BackupMergedTables are merged into the rows already there instead.
+func (backupStore *BackupStore) ImportTableRows(ctx context.Context, table string, rows []json.RawMessage, tx pgx.Tx) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	query, ok := backupMergeQueries[table]
+	if !ok {
+		name := pgx.Identifier{table}.Sanitize()
+		query = fmt.Sprintf("INSERT INTO %s OVERRIDING SYSTEM VALUE SELECT * FROM jsonb_populate_recordset(NULL::%s, $1::JSONB)", name, name)
+	}
+
+	values := make([]string, len(rows))
+	for i, row := range rows {
+		values[i] = string(row)
+	}
+	_, err := tx.Exec(ctx, query, "["+strings.Join(values, ",")+"]")
+	if err != nil {
+		zap.S().Errorf("Error executing import %s query: %v", table, err)
+		return err
+	}
+	return nil
+}
+
+// ResetTableIdentity moves the id sequence of a table past its rows, so that rows created after a restore get new ids
+func (backupStore *BackupStore) ResetTableIdentity(ctx context.Context, table string, tx pgx.Tx) error {
+	name := pgx.Identifier{table}.Sanitize()
+	query := fmt.Sprintf("SELECT setval(pg_get_serial_sequence($1, 'id'), COALESCE(MAX(id), 1), MAX(id) IS NOT NULL) FROM %s", name)
+
+	_, err := tx.Exec(ctx, query, name)
+	if err != nil {
+		zap.S().Errorf("Error executing reset %s identity query: %v", table, err)
+		return err
+	}
+	return nil
+}
+
+// ListMissingUserRoles returns the roles users have that are not in roles. The foreign key does not catch them while
+// triggers are disabled.
+func (backupStore *BackupStore) ListMissingUserRoles(ctx context.Context, tx pgx.Tx) ([]string, error) {
+	query, args, err := psql.Select("DISTINCT u.role").
+		From(userTable + " u").
+		LeftJoin(roleTable + " r ON r.name = u.role").
+		Where("r.id IS NULL").
+		OrderBy("u.role").
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list missing user roles query: ", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list missing user roles query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := []string{}
+	for rows.Next() {
+		var role string
+		err = rows.Scan(&role)
+		if err != nil {
+			zap.S().Error("Error scanning missing user role: ", err)
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
+
+// DisableTriggers keeps triggers, such as the audit log and the touching of profiles, from firing for the rest of
+// tx, which only a superuser may do. It returns whether they were disabled.
+func (backupStore *BackupStore) DisableTriggers(ctx context.Context, tx pgx.Tx) (bool, error) {
+	var isSuperuser bool
+	err := tx.QueryRow(ctx, "SELECT current_setting('is_superuser') = 'on'").Scan(&isSuperuser)
+	if err != nil {
+		zap.S().Error("Error executing is superuser query: ", err)
+		return false, err
+	}
+	if !isSuperuser {
+		return false, nil
+	}
+
+	_, err = tx.Exec(ctx, "SET LOCAL session_replication_role = replica")
+	if err != nil {
+		zap.S().Error("Error disabling triggers: ", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// CreateScratchSchema creates schema with an empty copy of each of tables, with their defaults, identities and
+// indexes but without their foreign keys and triggers, and puts it first on the search path for the rest of tx, so that the tables
+// are read and written in schema instead.
+func (backupStore *BackupStore) CreateScratchSchema(ctx context.Context, schema string, tables []string, tx pgx.Tx) error {
+	var currentSchema string
+	err := tx.QueryRow(ctx, "SELECT current_schema()").Scan(&currentSchema)
+	if err != nil {
+		zap.S().Error("Error executing current schema query: ", err)
+		return err
+	}
+
+	scratch := pgx.Identifier{schema}.Sanitize()
+	_, err = tx.Exec(ctx, fmt.Sprintf("CREATE SCHEMA %s", scratch))
+	if err != nil {
+		zap.S().Error("Error creating scratch schema: ", err)
+		return err
+	}
+	for _, table := range tables {
+		query := fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING IDENTITY INCLUDING INDEXES)", pgx.Identifier{schema, table}.Sanitize(), pgx.Identifier{currentSchema, table}.Sanitize())
+		_, err = tx.Exec(ctx, query)
+		if err != nil {
+			zap.S().Errorf("Error creating scratch %s table: %v", table, err)
+			return err
+		}
+	}
+
+	_, err = tx.Exec(ctx, "SELECT set_config('search_path', $1, true)", scratch+", "+pgx.Identifier{currentSchema}.Sanitize())
+	if err != nil {
+		zap.S().Error("Error setting the search path to the scratch schema: ", err)
+		return err
+	}
+	return nil
+}
+
+// DropScratchSchema drops a schema created with CreateScratchSchema along with its tables
+func (backupStore *BackupStore) DropScratchSchema(ctx context.Context, schema string, tx pgx.Tx) error {
+	_, err := tx.Exec(ctx, fmt.Sprintf("DROP SCHEMA %s CASCADE", pgx.Identifier{schema}.Sanitize()))
+	if err != nil {
+		zap.S().Error("Error dropping scratch schema: ", err)
+		return err
+	}
+	return nil
+}
+
+// CreateBackupVerification records the outcome of a test restore of a backup archive
+func (backupStore *BackupStore) CreateBackupVerification(ctx context.Context, verification BackupVerificationRepo) (specs.BackupVerification, error) {
+	rowCounts, err := json.Marshal(verification.RowCounts)
+	if err != nil {
+		return specs.BackupVerification{}, err
+	}
+
+	query, args, err := psql.Insert(backupVerificationTable).
+		Columns("file_name", "checksum", "status", "error", "row_counts").
+		Values(verification.FileName, verification.Checksum, verification.Status, verification.Error, rowCounts).
+		Suffix("RETURNING id, file_name, checksum, status, error, row_counts, verified_at").
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating create backup verification query: ", err)
+		return specs.BackupVerification{}, err
+	}
+
+	var created specs.BackupVerification
+	err = backupStore.db.QueryRow(ctx, query, args...).Scan(&created.ID, &created.FileName, &created.Checksum, &created.Status,
+		&created.Error, &created.RowCounts, &created.VerifiedAt)
+	if err != nil {
+		zap.S().Error("Error executing create backup verification query: ", err)
+		return specs.BackupVerification{}, err
+	}
+	return created, nil
+}
+
+// ListLatestBackupVerifications returns the latest verification of each of the archives named that has one, by
+// file name
+func (backupStore *BackupStore) ListLatestBackupVerifications(ctx context.Context, fileNames []string) (map[string]specs.BackupVerification, error) {
+	verifications := map[string]specs.BackupVerification{}
+	if len(fileNames) == 0 {
+		return verifications, nil
+	}
+
+	query, args, err := psql.Select("DISTINCT ON (file_name) id", "file_name", "checksum", "status", "error", "row_counts", "verified_at").
+		From(backupVerificationTable).
+		Where(sq.Eq{"file_name": fileNames}).
+		OrderBy("file_name", "id DESC").
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list backup verifications query: ", err)
+		return nil, err
+	}
+
+	rows, err := backupStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list backup verifications query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var verification specs.BackupVerification
+		err = rows.Scan(&verification.ID, &verification.FileName, &verification.Checksum, &verification.Status,
+			&verification.Error, &verification.RowCounts, &verification.VerifiedAt)
+		if err != nil {
+			zap.S().Error("Error scanning backup verification: ", err)
+			return nil, err
+		}
+		verifications[verification.FileName] = verification
+	}
+	return verifications, rows.Err()
+}
@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// EmailOutboxStore implements the EmailOutboxStorer interface.
+type EmailOutboxStore struct {
+	db *pgxpool.Pool
+}
+
+// Constants for email outbox table names
+var (
+	emailOutboxTable = "email_outbox"
+)
+
+// EmailOutboxStorer defines methods to queue emails and track sending them.
+type EmailOutboxStorer interface {
+	QueueEmail(ctx context.Context, email OutboxEmailRepo, tx pgx.Tx) (int64, error)
+	ClaimOutboxEmail(ctx context.Context, id int64, leaseUntil time.Time) (specs.OutboxEmail, error)
+	ClaimDueOutboxEmails(ctx context.Context, limit int, leaseUntil time.Time) ([]specs.OutboxEmail, error)
+	FinishOutboxEmail(ctx context.Context, id int64, attempts int, result OutboxEmailResultRepo) (bool, error)
+	ListOutboxEmails(ctx context.Context, filter specs.ListOutboxEmailsFilter) ([]specs.OutboxEmail, error)
+	GetOutboxEmail(ctx context.Context, id int64) (specs.OutboxEmail, error)
+	RequeueOutboxEmail(ctx context.Context, id int64) (bool, error)
+}
+
+// NewEmailOutboxRepo creates a new instance of EmailOutboxRepo.
+func NewEmailOutboxRepo(db *pgxpool.Pool) EmailOutboxStorer {
+	return &EmailOutboxStore{
+		db: db,
+	}
+}
+
+// outboxEmailColumns are the columns scanned by scanOutboxEmail, in order
+var outboxEmailColumns = []string{"id", "kind", "recipient", "subject", "body", "text_body", "profile_id", "status", "attempts", "last_error",
+	"created_at", "next_attempt_at", "sent_at"}
+
+// claimableEmailStatuses are the statuses of emails waiting to be sent. An email still sending once its lease has
+// run out was abandoned and is claimed again.
+var claimableEmailStatuses = []string{constants.EmailPending, constants.EmailFailed, constants.EmailSending}
+
+// QueueEmail adds a pending email to the outbox in tx and returns its id, so that it is only sent if tx commits.
+func (outboxStore *EmailOutboxStore) QueueEmail(ctx context.Context, email OutboxEmailRepo, tx pgx.Tx) (int64, error) {
+	query, args, err := psql.Insert(emailOutboxTable).
+		Columns("kind", "recipient", "subject", "body", "text_body", "profile_id", "status").
+		Values(email.Kind, email.Recipient, email.Subject, email.Body, email.TextBody, email.ProfileID, constants.EmailPending).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating queue email query: ", err)
+		return 0, err
+	}
+
+	var id int64
+	err = tx.QueryRow(ctx, query, args...).Scan(&id)
+	if err != nil {
+		zap.S().Error("Error executing queue email query: ", err)
+		return 0, err
+	}
+	return id, nil
+}
+
+// ClaimOutboxEmail marks an email that is due as sending until leaseUntil and counts the attempt. It returns
+// ErrNoRecordFound if the email is not due, e.g. because it was sent or claimed by someone else.
+func (outboxStore *EmailOutboxStore) ClaimOutboxEmail(ctx context.Context, id int64, leaseUntil time.Time) (specs.OutboxEmail, error) {
+	query, args, err := claimOutboxEmails(leaseUntil).
+		Where(sq.Eq{"id": id, "status": claimableEmailStatuses}).
+		Where(sq.Expr("next_attempt_at <= CURRENT_TIMESTAMP")).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating claim email query: ", err)
+		return specs.OutboxEmail{}, err
+	}
+
+	email, err := scanOutboxEmail(outboxStore.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return specs.OutboxEmail{}, errors.ErrNoRecordFound
+		}
+		zap.S().Error("Error executing claim email query: ", err)
+		return specs.OutboxEmail{}, err
+	}
+	return email, nil
+}
+
+// ClaimDueOutboxEmails claims up to limit emails that are due, oldest first, skipping those another server is
+// claiming at the same time.
+func (outboxStore *EmailOutboxStore) ClaimDueOutboxEmails(ctx context.Context, limit int, leaseUntil time.Time) ([]specs.OutboxEmail, error) {
+	due := "id IN (SELECT id FROM " + emailOutboxTable + " WHERE status IN (?, ?, ?) AND next_attempt_at <= CURRENT_TIMESTAMP" +
+		" ORDER BY next_attempt_at, id LIMIT ? FOR UPDATE SKIP LOCKED)"
+	query, args, err := claimOutboxEmails(leaseUntil).
+		Where(sq.Expr(due, constants.EmailPending, constants.EmailFailed, constants.EmailSending, limit)).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating claim due emails query: ", err)
+		return nil, err
+	}
+
+	return outboxStore.queryOutboxEmails(ctx, query, args)
+}
+
+// claimOutboxEmails builds the update claiming emails, to be limited to the emails to claim
+func claimOutboxEmails(leaseUntil time.Time) sq.UpdateBuilder {
+	return psql.Update(emailOutboxTable).
+		Set("status", constants.EmailSending).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("next_attempt_at", leaseUntil).
+		Suffix("RETURNING " + strings.Join(outboxEmailColumns, ", "))
+}
+
+// FinishOutboxEmail records the outcome of an attempt to send an email, attempts being the attempts it was claimed
+// with, and reports whether it did. Nothing is recorded once the email is no longer being sent by that attempt, e.g.
+// when its lease ran out and another server claimed it again.
+func (outboxStore *EmailOutboxStore) FinishOutboxEmail(ctx context.Context, id int64, attempts int, result OutboxEmailResultRepo) (bool, error) {
+	query, args, err := psql.Update(emailOutboxTable).
+		SetMap(map[string]interface{}{
+			"status":          result.Status,
+			"last_error":      result.LastError,
+			"next_attempt_at": result.NextAttemptAt,
+			"sent_at":         result.SentAt,
+		}).
+		Where(sq.Eq{"id": id, "status": constants.EmailSending, "attempts": attempts}).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating finish email query: ", err)
+		return false, err
+	}
+
+	tag, err := outboxStore.db.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing finish email query: ", err)
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListOutboxEmails returns the latest emails, newest first, optionally only those with the given status.
+func (outboxStore *EmailOutboxStore) ListOutboxEmails(ctx context.Context, filter specs.ListOutboxEmailsFilter) ([]specs.OutboxEmail, error) {
+	builder := psql.Select(outboxEmailColumns...).
+		From(emailOutboxTable).
+		OrderBy("created_at DESC", "id DESC").
+		Limit(uint64(filter.Limit))
+	if filter.Status != "" {
+		builder = builder.Where(sq.Eq{"status": filter.Status})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list emails query: ", err)
+		return nil, err
+	}
+
+	return outboxStore.queryOutboxEmails(ctx, query, args)
+}
+
+// GetOutboxEmail returns an email in the outbox, or ErrOutboxEmailNotFound if there is none with the id.
+func (outboxStore *EmailOutboxStore) GetOutboxEmail(ctx context.Context, id int64) (specs.OutboxEmail, error) {
+	query, args, err := psql.Select(outboxEmailColumns...).From(emailOutboxTable).Where(sq.Eq{"id": id}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get email query: ", err)
+		return specs.OutboxEmail{}, err
+	}
+
+	email, err := scanOutboxEmail(outboxStore.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return specs.OutboxEmail{}, errors.ErrOutboxEmailNotFound
+		}
+		zap.S().Error("Error executing get email query: ", err)
+		return specs.OutboxEmail{}, err
+	}
+	return email, nil
+}
+
+// RequeueOutboxEmail makes a sent, failed or dead email pending again with no attempts, and reports whether it did.
+// Emails that are pending or being sent are left alone.
+func (outboxStore *EmailOutboxStore) RequeueOutboxEmail(ctx context.Context, id int64) (bool, error) {
+	query, args, err := psql.Update(emailOutboxTable).
+		Set("status", constants.EmailPending).
+		Set("attempts", 0).
+		Set("last_error", "").
+		Set("next_attempt_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": id, "status": []string{constants.EmailSent, constants.EmailFailed, constants.EmailDead}}).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating requeue email query: ", err)
+		return false, err
+	}
+
+	tag, err := outboxStore.db.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing requeue email query: ", err)
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// queryOutboxEmails runs a query returning outboxEmailColumns and scans every email
+func (outboxStore *EmailOutboxStore) queryOutboxEmails(ctx context.Context, query string, args []interface{}) ([]specs.OutboxEmail, error) {
+	rows, err := outboxStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing emails query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	emails := []specs.OutboxEmail{}
+	for rows.Next() {
+		email, err := scanOutboxEmail(rows)
+		if err != nil {
+			zap.S().Error("Error scanning email: ", err)
+			return nil, err
+		}
+		emails = append(emails, email)
+	}
+
+	return emails, rows.Err()
+}
+
+// scanOutboxEmail scans a row selected with outboxEmailColumns
+func scanOutboxEmail(row pgx.Row) (specs.OutboxEmail, error) {
+	var email specs.OutboxEmail
+	err := row.Scan(&email.ID, &email.Kind, &email.Recipient, &email.Subject, &email.Body, &email.TextBody, &email.ProfileID, &email.Status,
+		&email.Attempts, &email.LastError, &email.CreatedAt, &email.NextAttemptAt, &email.SentAt)
+	return email, err
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// EmailTemplateStore implements the EmailTemplateStorer interface.
+type EmailTemplateStore struct {
+	db *pgxpool.Pool
+}
+
+// Constants for email template table names
+var (
+	emailTemplateTable = "email_templates"
+)
+
+// EmailTemplateStorer defines methods to read and version the templates of emails.
+type EmailTemplateStorer interface {
+	ListEmailTemplates(ctx context.Context) ([]specs.EmailTemplate, error)
+	ListEmailTemplateVersions(ctx context.Context, kind string) ([]specs.EmailTemplate, error)
+	GetEmailTemplate(ctx context.Context, kind string) (specs.EmailTemplate, error)
+	CreateEmailTemplateVersion(ctx context.Context, template EmailTemplateRepo) (specs.EmailTemplate, error)
+}
+
+// NewEmailTemplateRepo creates a new instance of EmailTemplateRepo.
+func NewEmailTemplateRepo(db *pgxpool.Pool) EmailTemplateStorer {
+	return &EmailTemplateStore{
+		db: db,
+	}
+}
+
+// emailTemplateColumns are the columns scanned by scanEmailTemplate, in order
+var emailTemplateColumns = []string{"id", "kind", "version", "subject", "html_body", "text_body", "created_by_id", "created_at"}
+
+// ListEmailTemplates returns the latest version of the template of every kind of email, ordered by kind.
+func (templateStore *EmailTemplateStore) ListEmailTemplates(ctx context.Context) ([]specs.EmailTemplate, error) {
+	query, args, err := psql.Select(emailTemplateColumns...).
+		Options("DISTINCT ON (kind)").
+		From(emailTemplateTable).
+		OrderBy("kind", "version DESC").
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list email templates query: ", err)
+		return nil, err
+	}
+
+	return templateStore.queryEmailTemplates(ctx, query, args)
+}
+
+// ListEmailTemplateVersions returns every version of the template of a kind of email, latest first.
+func (templateStore *EmailTemplateStore) ListEmailTemplateVersions(ctx context.Context, kind string) ([]specs.EmailTemplate, error) {
+	query, args, err := psql.Select(emailTemplateColumns...).
+		From(emailTemplateTable).
+		Where(sq.Eq{"kind": kind}).
+		OrderBy("version DESC").
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list email template versions query: ", err)
+		return nil, err
+	}
+
+	return templateStore.queryEmailTemplates(ctx, query, args)
+}
+
+// GetEmailTemplate returns the latest version of the template of a kind of email, or ErrEmailTemplateNotFound if
+// it has none.
+func (templateStore *EmailTemplateStore) GetEmailTemplate(ctx context.Context, kind string) (specs.EmailTemplate, error) {
+	query, args, err := psql.Select(emailTemplateColumns...).
+		From(emailTemplateTable).
+		Where(sq.Eq{"kind": kind}).
+		OrderBy("version DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get email template query: ", err)
+		return specs.EmailTemplate{}, err
+	}
+
+	template, err := scanEmailTemplate(templateStore.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return specs.EmailTemplate{}, errors.ErrEmailTemplateNotFound
+		}
+		zap.S().Error("Error executing get email template query: ", err)
+		return specs.EmailTemplate{}, err
+	}
+	return template, nil
+}
+
+// CreateEmailTemplateVersion stores a template as the next version of its kind and returns it. It returns
+// ErrDuplicateKey if another version of the kind was saved at the same time.
+func (templateStore *EmailTemplateStore) CreateEmailTemplateVersion(ctx context.Context, template EmailTemplateRepo) (specs.EmailTemplate, error) {
+	nextVersion := sq.Expr("(SELECT COALESCE(MAX(version), 0) + 1 FROM "+emailTemplateTable+" WHERE kind = ?)", template.Kind)
+	query, args, err := psql.Insert(emailTemplateTable).
+		Columns("kind", "version", "subject", "html_body", "text_body", "created_by_id").
+		Values(template.Kind, nextVersion, template.Subject, template.HTMLBody, template.TextBody, template.CreatedByID).
+		Suffix("RETURNING " + strings.Join(emailTemplateColumns, ", ")).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating create email template query: ", err)
+		return specs.EmailTemplate{}, err
+	}
+
+	created, err := scanEmailTemplate(templateStore.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if helpers.IsDuplicateKeyError(err) {
+			return specs.EmailTemplate{}, errors.ErrDuplicateKey
+		}
+		zap.S().Error("Error executing create email template query: ", err)
+		return specs.EmailTemplate{}, err
+	}
+	return created, nil
+}
+
+// queryEmailTemplates runs a query returning emailTemplateColumns and scans every template
+func (templateStore *EmailTemplateStore) queryEmailTemplates(ctx context.Context, query string, args []interface{}) ([]specs.EmailTemplate, error) {
+	rows, err := templateStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing email templates query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates := []specs.EmailTemplate{}
+	for rows.Next() {
+		template, err := scanEmailTemplate(rows)
+		if err != nil {
+			zap.S().Error("Error scanning email template: ", err)
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, rows.Err()
+}
+
+// scanEmailTemplate scans a row selected with emailTemplateColumns
+func scanEmailTemplate(row pgx.Row) (specs.EmailTemplate, error) {
+	var template specs.EmailTemplate
+	err := row.Scan(&template.ID, &template.Kind, &template.Version, &template.Subject, &template.HTMLBody, &template.TextBody,
+		&template.CreatedByID, &template.CreatedAt)
+	return template, err
+}
@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// IntranetSyncStore implements the IntranetSyncStorer interface.
+type IntranetSyncStore struct {
+	db *pgxpool.Pool
+}
+
+// Constants for intranet sync table names
+var (
+	profileSyncChangeTable = "profile_sync_changes"
+	syncStateTable         = "sync_state"
+	syncRunTable           = "sync_runs"
+)
+
+// IntranetSyncStorer defines methods to record what the intranet sync changed.
+type IntranetSyncStorer interface {
+	CreateProfileSyncChanges(ctx context.Context, changes []ProfileSyncChangeRepo, tx pgx.Tx) error
+	ListProfileSyncChanges(ctx context.Context, profileID int, tx pgx.Tx) ([]specs.ProfileSyncChange, error)
+	GetSyncWatermark(ctx context.Context, name string) (time.Time, error)
+	SetSyncWatermark(ctx context.Context, name string, watermark time.Time) error
+	CreateSyncRun(ctx context.Context, run SyncRunRepo) (int64, error)
+	FinishSyncRun(ctx context.Context, runID int64, result SyncRunResultRepo) error
+	FailStaleSyncRuns(ctx context.Context, startedBefore time.Time, reason string) (int64, error)
+	ListSyncRuns(ctx context.Context, limit int) ([]specs.SyncRun, error)
+	GetSyncRun(ctx context.Context, runID int64) (specs.SyncRun, error)
+}
+
+// NewIntranetSyncRepo creates a new instance of IntranetSyncRepo.
+func NewIntranetSyncRepo(db *pgxpool.Pool) IntranetSyncStorer {
+	return &IntranetSyncStore{
+		db: db,
+	}
+}
+
+// CreateProfileSyncChanges records the profile fields changed by the intranet sync.
+func (syncStore *IntranetSyncStore) CreateProfileSyncChanges(ctx context.Context, changes []ProfileSyncChangeRepo, tx pgx.Tx) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	insert := psql.Insert(profileSyncChangeTable).
+		Columns("profile_id", "employee_id", "field", "old_value", "new_value", "policy", "reason")
+	for _, change := range changes {
+		insert = insert.Values(change.ProfileID, change.EmployeeID, change.Field, change.OldValue, change.NewValue, change.Policy, change.Reason)
+	}
+
+	query, args, err := insert.ToSql()
+	if err != nil {
+		zap.S().Error("Error generating create profile sync changes query: ", err)
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing create profile sync changes query: ", err)
+		return err
+	}
+	return nil
+}
+
+// ListProfileSyncChanges returns the changes made to a profile by the intranet sync, newest first.
+func (syncStore *IntranetSyncStore) ListProfileSyncChanges(ctx context.Context, profileID int, tx pgx.Tx) ([]specs.ProfileSyncChange, error) {
+	query, args, err := psql.Select("id", "profile_id", "employee_id", "field", "old_value", "new_value", "policy", "reason", "created_at").
+		From(profileSyncChangeTable).
+		Where(sq.Eq{"profile_id": profileID}).
+		OrderBy("created_at DESC", "id DESC").
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list profile sync changes query: ", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list profile sync changes query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []specs.ProfileSyncChange{}
+	for rows.Next() {
+		var change specs.ProfileSyncChange
+		err := rows.Scan(&change.ID, &change.ProfileID, &change.EmployeeID, &change.Field, &change.OldValue, &change.NewValue,
+			&change.Policy, &change.Reason, &change.CreatedAt)
+		if err != nil {
+			zap.S().Error("Error scanning profile sync change: ", err)
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
+
+// GetSyncWatermark returns the watermark of the named sync, or ErrNoRecordFound if it has never completed.
+func (syncStore *IntranetSyncStore) GetSyncWatermark(ctx context.Context, name string) (time.Time, error) {
+	query, args, err := psql.Select("watermark").From(syncStateTable).Where(sq.Eq{"name": name}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get sync watermark query: ", err)
+		return time.Time{}, err
+	}
+
+	var watermark time.Time
+	err = syncStore.db.QueryRow(ctx, query, args...).Scan(&watermark)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return time.Time{}, errors.ErrNoRecordFound
+		}
+		zap.S().Error("Error executing get sync watermark query: ", err)
+		return time.Time{}, err
+	}
+	return watermark, nil
+}
+
+// SetSyncWatermark stores the watermark of the named sync.
+func (syncStore *IntranetSyncStore) SetSyncWatermark(ctx context.Context, name string, watermark time.Time) error {
+	query, args, err := psql.Insert(syncStateTable).
+		Columns("name", "watermark", "updated_at").
+		Values(name, watermark, sq.Expr("CURRENT_TIMESTAMP")).
+		Suffix("ON CONFLICT (name) DO UPDATE SET watermark = EXCLUDED.watermark, updated_at = EXCLUDED.updated_at").
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating set sync watermark query: ", err)
+		return err
+	}
+
+	_, err = syncStore.db.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing set sync watermark query: ", err)
+		return err
+	}
+	return nil
+}
+
+// syncRunColumns are the columns scanned by scanSyncRun, in order
+var syncRunColumns = []string{"id", "trigger", "triggered_by_id", "incremental", "deactivate_leavers", "status", "started_at",
+	"finished_at", "updated", "unchanged", "skipped", "leavers", "rejoiners", "errors", "error"}
+
+// CreateSyncRun records the start of an intranet sync run and returns its id. It returns ErrSyncInProgress if
+// another run is still running.
+func (syncStore *IntranetSyncStore) CreateSyncRun(ctx context.Context, run SyncRunRepo) (int64, error) {
+	query, args, err := psql.Insert(syncRunTable).
+		Columns("trigger", "triggered_by_id", "incremental", "deactivate_leavers", "status", "started_at").
+		Values(run.Trigger, run.TriggeredByID, run.Incremental, run.DeactivateLeavers, constants.SyncRunRunning, run.StartedAt).
+		Suffix("RETURNING id").ToSql()
+	if err != nil {
+		zap.S().Error("Error generating create sync run query: ", err)
+		return 0, err
+	}
+
+	var runID int64
+	err = syncStore.db.QueryRow(ctx, query, args...).Scan(&runID)
+	if err != nil {
+		if helpers.IsDuplicateKeyError(err) {
+			return 0, errors.ErrSyncInProgress
+		}
+		zap.S().Error("Error executing create sync run query: ", err)
+		return 0, err
+	}
+	return runID, nil
+}
+
+// FinishSyncRun records the outcome of a running intranet sync run.
+func (syncStore *IntranetSyncStore) FinishSyncRun(ctx context.Context, runID int64, result SyncRunResultRepo) error {
+	query, args, err := psql.Update(syncRunTable).
+		SetMap(map[string]interface{}{
+			"status":      result.Status,
+			"finished_at": result.FinishedAt,
+			"updated":     result.Updated,
+			"unchanged":   result.Unchanged,
+			"skipped":     result.Skipped,
+			"leavers":     result.Leavers,
+			"rejoiners":   result.Rejoiners,
+			"errors":      result.Errors,
+			"error":       result.Error,
+		}).
+		Where(sq.Eq{"id": runID, "status": constants.SyncRunRunning}).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating finish sync run query: ", err)
+		return err
+	}
+
+	_, err = syncStore.db.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing finish sync run query: ", err)
+		return err
+	}
+	return nil
+}
+
+// FailStaleSyncRuns marks the runs still running that started before startedBefore as failed and returns how
+// many it marked.
+func (syncStore *IntranetSyncStore) FailStaleSyncRuns(ctx context.Context, startedBefore time.Time, reason string) (int64, error) {
+	query, args, err := psql.Update(syncRunTable).
+		Set("status", constants.SyncRunFailed).
+		Set("finished_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Set("error", reason).
+		Where(sq.Eq{"status": constants.SyncRunRunning}).
+		Where(sq.Lt{"started_at": startedBefore}).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating fail stale sync runs query: ", err)
+		return 0, err
+	}
+
+	tag, err := syncStore.db.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing fail stale sync runs query: ", err)
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ListSyncRuns returns the latest intranet sync runs, newest first.
+func (syncStore *IntranetSyncStore) ListSyncRuns(ctx context.Context, limit int) ([]specs.SyncRun, error) {
+	query, args, err := psql.Select(syncRunColumns...).
+		From(syncRunTable).
+		OrderBy("started_at DESC", "id DESC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list sync runs query: ", err)
+		return nil, err
+	}
+
+	rows, err := syncStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list sync runs query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := []specs.SyncRun{}
+	for rows.Next() {
+		run, err := scanSyncRun(rows)
+		if err != nil {
+			zap.S().Error("Error scanning sync run: ", err)
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, rows.Err()
+}
+
+// GetSyncRun returns an intranet sync run, or ErrSyncRunNotFound if there is none with the id.
+func (syncStore *IntranetSyncStore) GetSyncRun(ctx context.Context, runID int64) (specs.SyncRun, error) {
+	query, args, err := psql.Select(syncRunColumns...).From(syncRunTable).Where(sq.Eq{"id": runID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get sync run query: ", err)
+		return specs.SyncRun{}, err
+	}
+
+	run, err := scanSyncRun(syncStore.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return specs.SyncRun{}, errors.ErrSyncRunNotFound
+		}
+		zap.S().Error("Error executing get sync run query: ", err)
+		return specs.SyncRun{}, err
+	}
+	return run, nil
+}
+
+// scanSyncRun scans a row selected with syncRunColumns
+func scanSyncRun(row pgx.Row) (specs.SyncRun, error) {
+	var run specs.SyncRun
+	err := row.Scan(&run.ID, &run.Trigger, &run.TriggeredByID, &run.Incremental, &run.DeactivateLeavers, &run.Status, &run.StartedAt,
+		&run.FinishedAt, &run.Updated, &run.Unchanged, &run.Skipped, &run.Left, &run.Rejoined, &run.Errors, &run.Error)
+	return run, err
+}
@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// IntranetWebhookStore implements the IntranetWebhookStorer interface.
+type IntranetWebhookStore struct {
+	db *pgxpool.Pool
+}
+
+// Constants for intranet webhook table names
+var (
+	intranetWebhookEventTable = "intranet_webhook_events"
+)
+
+// IntranetWebhookStorer defines methods to store intranet webhook events and track their processing.
+type IntranetWebhookStorer interface {
+	CreateWebhookEvent(ctx context.Context, event IntranetWebhookEventRepo) (id int64, created bool, err error)
+	ClaimWebhookEvent(ctx context.Context, id int64, leaseUntil time.Time) (specs.IntranetWebhookEventRecord, error)
+	ClaimDueWebhookEvents(ctx context.Context, limit int, leaseUntil time.Time) ([]specs.IntranetWebhookEventRecord, error)
+	FinishWebhookEvent(ctx context.Context, id int64, result IntranetWebhookResultRepo) error
+	ListWebhookEvents(ctx context.Context, filter specs.ListIntranetWebhookEventsFilter) ([]specs.IntranetWebhookEventRecord, error)
+	GetWebhookEvent(ctx context.Context, id int64) (specs.IntranetWebhookEventRecord, error)
+	ResetWebhookEvent(ctx context.Context, id int64) (bool, error)
+}
+
+// NewIntranetWebhookRepo creates a new instance of IntranetWebhookRepo.
+func NewIntranetWebhookRepo(db *pgxpool.Pool) IntranetWebhookStorer {
+	return &IntranetWebhookStore{
+		db: db,
+	}
+}
+
+// webhookEventColumns are the columns scanned by scanWebhookEvent, in order
+var webhookEventColumns = []string{"id", "event_id", "event_type", "employee_id", "payload", "status", "attempts", "last_error",
+	"result", "received_at", "next_attempt_at", "processed_at"}
+
+// claimableWebhookStatuses are the statuses of events waiting to be processed. An event still processing once its
+// lease has run out was abandoned and is claimed again.
+var claimableWebhookStatuses = []string{constants.WebhookEventPending, constants.WebhookEventFailed, constants.WebhookEventProcessing}
+
+// CreateWebhookEvent stores an intranet event as pending and returns its id. An event whose event id was already
+// stored is left as it is, and created is false.
+func (webhookStore *IntranetWebhookStore) CreateWebhookEvent(ctx context.Context, event IntranetWebhookEventRepo) (int64, bool, error) {
+	query, args, err := psql.Insert(intranetWebhookEventTable).
+		Columns("event_id", "event_type", "employee_id", "payload", "status").
+		Values(event.EventID, event.EventType, event.EmployeeID, event.Payload, constants.WebhookEventPending).
+		Suffix("ON CONFLICT (event_id) DO NOTHING RETURNING id").
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating create webhook event query: ", err)
+		return 0, false, err
+	}
+
+	var id int64
+	err = webhookStore.db.QueryRow(ctx, query, args...).Scan(&id)
+	if err == nil {
+		return id, true, nil
+	}
+	if err != pgx.ErrNoRows {
+		zap.S().Error("Error executing create webhook event query: ", err)
+		return 0, false, err
+	}
+
+	query, args, err = psql.Select("id").From(intranetWebhookEventTable).Where(sq.Eq{"event_id": event.EventID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get webhook event id query: ", err)
+		return 0, false, err
+	}
+
+	err = webhookStore.db.QueryRow(ctx, query, args...).Scan(&id)
+	if err != nil {
+		zap.S().Error("Error executing get webhook event id query: ", err)
+		return 0, false, err
+	}
+	return id, false, nil
+}
+
+// ClaimWebhookEvent marks an event that is due as processing until leaseUntil and counts the attempt. It returns
+// ErrNoRecordFound if the event is not due, e.g. because it was processed or claimed by someone else.
+func (webhookStore *IntranetWebhookStore) ClaimWebhookEvent(ctx context.Context, id int64, leaseUntil time.Time) (specs.IntranetWebhookEventRecord, error) {
+	query, args, err := claimWebhookEvents(leaseUntil).
+		Where(sq.Eq{"id": id, "status": claimableWebhookStatuses}).
+		Where(sq.Expr("next_attempt_at <= CURRENT_TIMESTAMP")).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating claim webhook event query: ", err)
+		return specs.IntranetWebhookEventRecord{}, err
+	}
+
+	event, err := scanWebhookEvent(webhookStore.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return specs.IntranetWebhookEventRecord{}, errors.ErrNoRecordFound
+		}
+		zap.S().Error("Error executing claim webhook event query: ", err)
+		return specs.IntranetWebhookEventRecord{}, err
+	}
+	return event, nil
+}
+
+// ClaimDueWebhookEvents claims up to limit events that are due, oldest first, skipping those another server is
+// claiming at the same time.
+func (webhookStore *IntranetWebhookStore) ClaimDueWebhookEvents(ctx context.Context, limit int, leaseUntil time.Time) ([]specs.IntranetWebhookEventRecord, error) {
+	due := "id IN (SELECT id FROM " + intranetWebhookEventTable + " WHERE status IN (?, ?, ?) AND next_attempt_at <= CURRENT_TIMESTAMP" +
+		" ORDER BY next_attempt_at, id LIMIT ? FOR UPDATE SKIP LOCKED)"
+	query, args, err := claimWebhookEvents(leaseUntil).
+		Where(sq.Expr(due, constants.WebhookEventPending, constants.WebhookEventFailed, constants.WebhookEventProcessing, limit)).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating claim due webhook events query: ", err)
+		return nil, err
+	}
+
+	rows, err := webhookStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing claim due webhook events query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []specs.IntranetWebhookEventRecord{}
+	for rows.Next() {
+		event, err := scanWebhookEvent(rows)
+		if err != nil {
+			zap.S().Error("Error scanning webhook event: ", err)
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// claimWebhookEvents builds the update claiming events, to be limited to the events to claim
+func claimWebhookEvents(leaseUntil time.Time) sq.UpdateBuilder {
+	return psql.Update(intranetWebhookEventTable).
+		Set("status", constants.WebhookEventProcessing).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("next_attempt_at", leaseUntil).
+		Suffix("RETURNING " + strings.Join(webhookEventColumns, ", "))
+}
+
+// FinishWebhookEvent records the outcome of processing an event.
+func (webhookStore *IntranetWebhookStore) FinishWebhookEvent(ctx context.Context, id int64, result IntranetWebhookResultRepo) error {
+	query, args, err := psql.Update(intranetWebhookEventTable).
+		SetMap(map[string]interface{}{
+			"status":          result.Status,
+			"last_error":      result.LastError,
+			"result":          result.Result,
+			"next_attempt_at": result.NextAttemptAt,
+			"processed_at":    result.ProcessedAt,
+		}).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating finish webhook event query: ", err)
+		return err
+	}
+
+	_, err = webhookStore.db.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing finish webhook event query: ", err)
+		return err
+	}
+	return nil
+}
+
+// ListWebhookEvents returns the latest events, newest first, optionally only those with the given status.
+func (webhookStore *IntranetWebhookStore) ListWebhookEvents(ctx context.Context, filter specs.ListIntranetWebhookEventsFilter) ([]specs.IntranetWebhookEventRecord, error) {
+	builder := psql.Select(webhookEventColumns...).
+		From(intranetWebhookEventTable).
+		OrderBy("received_at DESC", "id DESC").
+		Limit(uint64(filter.Limit))
+	if filter.Status != "" {
+		builder = builder.Where(sq.Eq{"status": filter.Status})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list webhook events query: ", err)
+		return nil, err
+	}
+
+	rows, err := webhookStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list webhook events query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []specs.IntranetWebhookEventRecord{}
+	for rows.Next() {
+		event, err := scanWebhookEvent(rows)
+		if err != nil {
+			zap.S().Error("Error scanning webhook event: ", err)
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// GetWebhookEvent returns a stored event, or ErrWebhookEventNotFound if there is none with the id.
+func (webhookStore *IntranetWebhookStore) GetWebhookEvent(ctx context.Context, id int64) (specs.IntranetWebhookEventRecord, error) {
+	query, args, err := psql.Select(webhookEventColumns...).From(intranetWebhookEventTable).Where(sq.Eq{"id": id}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get webhook event query: ", err)
+		return specs.IntranetWebhookEventRecord{}, err
+	}
+
+	event, err := scanWebhookEvent(webhookStore.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return specs.IntranetWebhookEventRecord{}, errors.ErrWebhookEventNotFound
+		}
+		zap.S().Error("Error executing get webhook event query: ", err)
+		return specs.IntranetWebhookEventRecord{}, err
+	}
+	return event, nil
+}
+
+// ResetWebhookEvent makes a failed or dead event pending again with no attempts, and reports whether it did.
+func (webhookStore *IntranetWebhookStore) ResetWebhookEvent(ctx context.Context, id int64) (bool, error) {
+	query, args, err := psql.Update(intranetWebhookEventTable).
+		Set("status", constants.WebhookEventPending).
+		Set("attempts", 0).
+		Set("next_attempt_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"id": id, "status": []string{constants.WebhookEventFailed, constants.WebhookEventDead}}).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating reset webhook event query: ", err)
+		return false, err
+	}
+
+	tag, err := webhookStore.db.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing reset webhook event query: ", err)
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// scanWebhookEvent scans a row selected with webhookEventColumns
+func scanWebhookEvent(row pgx.Row) (specs.IntranetWebhookEventRecord, error) {
+	var event specs.IntranetWebhookEventRecord
+	var payload, result []byte
+	err := row.Scan(&event.ID, &event.EventID, &event.EventType, &event.EmployeeID, &payload, &event.Status, &event.Attempts,
+		&event.LastError, &result, &event.ReceivedAt, &event.NextAttemptAt, &event.ProcessedAt)
+	if err != nil {
+		return event, err
+	}
+
+	event.Payload = json.RawMessage(payload)
+	if len(result) > 0 {
+		event.Result = &specs.SyncEmployeeResult{}
+		if err := json.Unmarshal(result, event.Result); err != nil {
+			return event, err
+		}
+	}
+	return event, nil
+}
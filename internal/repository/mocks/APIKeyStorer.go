@@ -0,0 +1,203 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	pgx "github.com/jackc/pgx/v5"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+
+	time "time"
+)
+
+// APIKeyStorer is an autogenerated mock type for the APIKeyStorer type
+type APIKeyStorer struct {
+	mock.Mock
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, apiKey, tx
+func (_m *APIKeyStorer) CreateAPIKey(ctx context.Context, apiKey repository.APIKeyRepo, tx pgx.Tx) (int, error) {
+	ret := _m.Called(ctx, apiKey, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.APIKeyRepo, pgx.Tx) (int, error)); ok {
+		return rf(ctx, apiKey, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.APIKeyRepo, pgx.Tx) int); ok {
+		r0 = rf(ctx, apiKey, tx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.APIKeyRepo, pgx.Tx) error); ok {
+		r1 = rf(ctx, apiKey, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAPIKey provides a mock function with given fields: ctx, apiKeyID, tx
+func (_m *APIKeyStorer) GetAPIKey(ctx context.Context, apiKeyID int, tx pgx.Tx) (specs.APIKeyResponse, error) {
+	ret := _m.Called(ctx, apiKeyID, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKey")
+	}
+
+	var r0 specs.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) (specs.APIKeyResponse, error)); ok {
+		return rf(ctx, apiKeyID, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) specs.APIKeyResponse); ok {
+		r0 = rf(ctx, apiKeyID, tx)
+	} else {
+		r0 = ret.Get(0).(specs.APIKeyResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, pgx.Tx) error); ok {
+		r1 = rf(ctx, apiKeyID, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAPIKeyCredential provides a mock function with given fields: ctx, prefix
+func (_m *APIKeyStorer) GetAPIKeyCredential(ctx context.Context, prefix string) (repository.APIKeyCredential, error) {
+	ret := _m.Called(ctx, prefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKeyCredential")
+	}
+
+	var r0 repository.APIKeyCredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (repository.APIKeyCredential, error)); ok {
+		return rf(ctx, prefix)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) repository.APIKeyCredential); ok {
+		r0 = rf(ctx, prefix)
+	} else {
+		r0 = ret.Get(0).(repository.APIKeyCredential)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAPIKeys provides a mock function with given fields: ctx, tx
+func (_m *APIKeyStorer) ListAPIKeys(ctx context.Context, tx pgx.Tx) ([]specs.APIKeyResponse, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAPIKeys")
+	}
+
+	var r0 []specs.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) ([]specs.APIKeyResponse, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) []specs.APIKeyResponse); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeAPIKey provides a mock function with given fields: ctx, apiKeyID, revokedAt, tx
+func (_m *APIKeyStorer) RevokeAPIKey(ctx context.Context, apiKeyID int, revokedAt string, tx pgx.Tx) error {
+	ret := _m.Called(ctx, apiKeyID, revokedAt, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, pgx.Tx) error); ok {
+		r0 = rf(ctx, apiKeyID, revokedAt, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RotateAPIKey provides a mock function with given fields: ctx, apiKeyID, prefix, keyHash, updatedAt, tx
+func (_m *APIKeyStorer) RotateAPIKey(ctx context.Context, apiKeyID int, prefix string, keyHash string, updatedAt string, tx pgx.Tx) error {
+	ret := _m.Called(ctx, apiKeyID, prefix, keyHash, updatedAt, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string, string, pgx.Tx) error); ok {
+		r0 = rf(ctx, apiKeyID, prefix, keyHash, updatedAt, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAPIKeyLastUsed provides a mock function with given fields: ctx, apiKeyID, usedAt, ip
+func (_m *APIKeyStorer) UpdateAPIKeyLastUsed(ctx context.Context, apiKeyID int, usedAt time.Time, ip string) error {
+	ret := _m.Called(ctx, apiKeyID, usedAt, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAPIKeyLastUsed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, string) error); ok {
+		r0 = rf(ctx, apiKeyID, usedAt, ip)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAPIKeyStorer creates a new instance of APIKeyStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyStorer {
+	mock := &APIKeyStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	pgx "github.com/jackc/pgx/v5"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// AuditStorer is an autogenerated mock type for the AuditStorer type
+type AuditStorer struct {
+	mock.Mock
+}
+
+// CreateAuditEvent provides a mock function with given fields: ctx, event, tx
+func (_m *AuditStorer) CreateAuditEvent(ctx context.Context, event repository.AuditEventRepo, tx pgx.Tx) error {
+	ret := _m.Called(ctx, event, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.AuditEventRepo, pgx.Tx) error); ok {
+		r0 = rf(ctx, event, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListAuditEvents provides a mock function with given fields: ctx, filter, tx
+func (_m *AuditStorer) ListAuditEvents(ctx context.Context, filter specs.ListAuditEventsFilter, tx pgx.Tx) ([]specs.AuditEventResponse, error) {
+	ret := _m.Called(ctx, filter, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEvents")
+	}
+
+	var r0 []specs.AuditEventResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListAuditEventsFilter, pgx.Tx) ([]specs.AuditEventResponse, error)); ok {
+		return rf(ctx, filter, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListAuditEventsFilter, pgx.Tx) []specs.AuditEventResponse); ok {
+		r0 = rf(ctx, filter, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.AuditEventResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListAuditEventsFilter, pgx.Tx) error); ok {
+		r1 = rf(ctx, filter, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAuditStorer creates a new instance of AuditStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditStorer {
+	mock := &AuditStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,337 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	jsontext "encoding/json/jsontext"
+
+	mock "github.com/stretchr/testify/mock"
+
+	pgx "github.com/jackc/pgx/v5"
+
+	repository "github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// BackupStorer is an autogenerated mock type for the BackupStorer type
+type BackupStorer struct {
+	mock.Mock
+}
+
+// BeginSnapshot provides a mock function with given fields: ctx
+func (_m *BackupStorer) BeginSnapshot(ctx context.Context) (pgx.Tx, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginSnapshot")
+	}
+
+	var r0 pgx.Tx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (pgx.Tx, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) pgx.Tx); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pgx.Tx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountTableRows provides a mock function with given fields: ctx, table, tx
+func (_m *BackupStorer) CountTableRows(ctx context.Context, table string, tx pgx.Tx) (int, error) {
+	ret := _m.Called(ctx, table, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountTableRows")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx) (int, error)); ok {
+		return rf(ctx, table, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx) int); ok {
+		r0 = rf(ctx, table, tx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, pgx.Tx) error); ok {
+		r1 = rf(ctx, table, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBackupVerification provides a mock function with given fields: ctx, verification
+func (_m *BackupStorer) CreateBackupVerification(ctx context.Context, verification repository.BackupVerificationRepo) (specs.BackupVerification, error) {
+	ret := _m.Called(ctx, verification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBackupVerification")
+	}
+
+	var r0 specs.BackupVerification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.BackupVerificationRepo) (specs.BackupVerification, error)); ok {
+		return rf(ctx, verification)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.BackupVerificationRepo) specs.BackupVerification); ok {
+		r0 = rf(ctx, verification)
+	} else {
+		r0 = ret.Get(0).(specs.BackupVerification)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.BackupVerificationRepo) error); ok {
+		r1 = rf(ctx, verification)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateScratchSchema provides a mock function with given fields: ctx, schema, tables, tx
+func (_m *BackupStorer) CreateScratchSchema(ctx context.Context, schema string, tables []string, tx pgx.Tx) error {
+	ret := _m.Called(ctx, schema, tables, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateScratchSchema")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, pgx.Tx) error); ok {
+		r0 = rf(ctx, schema, tables, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DisableTriggers provides a mock function with given fields: ctx, tx
+func (_m *BackupStorer) DisableTriggers(ctx context.Context, tx pgx.Tx) (bool, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableTriggers")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) (bool, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) bool); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DropScratchSchema provides a mock function with given fields: ctx, schema, tx
+func (_m *BackupStorer) DropScratchSchema(ctx context.Context, schema string, tx pgx.Tx) error {
+	ret := _m.Called(ctx, schema, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropScratchSchema")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx) error); ok {
+		r0 = rf(ctx, schema, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportTableRows provides a mock function with given fields: ctx, table, tx, write
+func (_m *BackupStorer) ExportTableRows(ctx context.Context, table string, tx pgx.Tx, write func(jsontext.Value) error) (int, error) {
+	ret := _m.Called(ctx, table, tx, write)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportTableRows")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx, func(jsontext.Value) error) (int, error)); ok {
+		return rf(ctx, table, tx, write)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx, func(jsontext.Value) error) int); ok {
+		r0 = rf(ctx, table, tx, write)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, pgx.Tx, func(jsontext.Value) error) error); ok {
+		r1 = rf(ctx, table, tx, write)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSchemaVersion provides a mock function with given fields: ctx, tx
+func (_m *BackupStorer) GetSchemaVersion(ctx context.Context, tx pgx.Tx) (int64, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSchemaVersion")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) (int64, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) int64); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportTableRows provides a mock function with given fields: ctx, table, rows, tx
+func (_m *BackupStorer) ImportTableRows(ctx context.Context, table string, rows []jsontext.Value, tx pgx.Tx) error {
+	ret := _m.Called(ctx, table, rows, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportTableRows")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []jsontext.Value, pgx.Tx) error); ok {
+		r0 = rf(ctx, table, rows, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListLatestBackupVerifications provides a mock function with given fields: ctx, fileNames
+func (_m *BackupStorer) ListLatestBackupVerifications(ctx context.Context, fileNames []string) (map[string]specs.BackupVerification, error) {
+	ret := _m.Called(ctx, fileNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLatestBackupVerifications")
+	}
+
+	var r0 map[string]specs.BackupVerification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]specs.BackupVerification, error)); ok {
+		return rf(ctx, fileNames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]specs.BackupVerification); ok {
+		r0 = rf(ctx, fileNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]specs.BackupVerification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, fileNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListMissingUserRoles provides a mock function with given fields: ctx, tx
+func (_m *BackupStorer) ListMissingUserRoles(ctx context.Context, tx pgx.Tx) ([]string, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMissingUserRoles")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) ([]string, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) []string); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetTableIdentity provides a mock function with given fields: ctx, table, tx
+func (_m *BackupStorer) ResetTableIdentity(ctx context.Context, table string, tx pgx.Tx) error {
+	ret := _m.Called(ctx, table, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetTableIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx) error); ok {
+		r0 = rf(ctx, table, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewBackupStorer creates a new instance of BackupStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBackupStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BackupStorer {
+	mock := &BackupStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
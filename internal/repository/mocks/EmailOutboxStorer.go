@@ -0,0 +1,235 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	pgx "github.com/jackc/pgx/v5"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+
+	time "time"
+)
+
+// EmailOutboxStorer is an autogenerated mock type for the EmailOutboxStorer type
+type EmailOutboxStorer struct {
+	mock.Mock
+}
+
+// ClaimDueOutboxEmails provides a mock function with given fields: ctx, limit, leaseUntil
+func (_m *EmailOutboxStorer) ClaimDueOutboxEmails(ctx context.Context, limit int, leaseUntil time.Time) ([]specs.OutboxEmail, error) {
+	ret := _m.Called(ctx, limit, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueOutboxEmails")
+	}
+
+	var r0 []specs.OutboxEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) ([]specs.OutboxEmail, error)); ok {
+		return rf(ctx, limit, leaseUntil)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) []specs.OutboxEmail); ok {
+		r0 = rf(ctx, limit, leaseUntil)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.OutboxEmail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, limit, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimOutboxEmail provides a mock function with given fields: ctx, id, leaseUntil
+func (_m *EmailOutboxStorer) ClaimOutboxEmail(ctx context.Context, id int64, leaseUntil time.Time) (specs.OutboxEmail, error) {
+	ret := _m.Called(ctx, id, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimOutboxEmail")
+	}
+
+	var r0 specs.OutboxEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) (specs.OutboxEmail, error)); ok {
+		return rf(ctx, id, leaseUntil)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) specs.OutboxEmail); ok {
+		r0 = rf(ctx, id, leaseUntil)
+	} else {
+		r0 = ret.Get(0).(specs.OutboxEmail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(ctx, id, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FinishOutboxEmail provides a mock function with given fields: ctx, id, attempts, result
+func (_m *EmailOutboxStorer) FinishOutboxEmail(ctx context.Context, id int64, attempts int, result repository.OutboxEmailResultRepo) (bool, error) {
+	ret := _m.Called(ctx, id, attempts, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishOutboxEmail")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, repository.OutboxEmailResultRepo) (bool, error)); ok {
+		return rf(ctx, id, attempts, result)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, repository.OutboxEmailResultRepo) bool); ok {
+		r0 = rf(ctx, id, attempts, result)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int, repository.OutboxEmailResultRepo) error); ok {
+		r1 = rf(ctx, id, attempts, result)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutboxEmail provides a mock function with given fields: ctx, id
+func (_m *EmailOutboxStorer) GetOutboxEmail(ctx context.Context, id int64) (specs.OutboxEmail, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutboxEmail")
+	}
+
+	var r0 specs.OutboxEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.OutboxEmail, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.OutboxEmail); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(specs.OutboxEmail)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOutboxEmails provides a mock function with given fields: ctx, filter
+func (_m *EmailOutboxStorer) ListOutboxEmails(ctx context.Context, filter specs.ListOutboxEmailsFilter) ([]specs.OutboxEmail, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOutboxEmails")
+	}
+
+	var r0 []specs.OutboxEmail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListOutboxEmailsFilter) ([]specs.OutboxEmail, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListOutboxEmailsFilter) []specs.OutboxEmail); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.OutboxEmail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListOutboxEmailsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueueEmail provides a mock function with given fields: ctx, email, tx
+func (_m *EmailOutboxStorer) QueueEmail(ctx context.Context, email repository.OutboxEmailRepo, tx pgx.Tx) (int64, error) {
+	ret := _m.Called(ctx, email, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueEmail")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.OutboxEmailRepo, pgx.Tx) (int64, error)); ok {
+		return rf(ctx, email, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.OutboxEmailRepo, pgx.Tx) int64); ok {
+		r0 = rf(ctx, email, tx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.OutboxEmailRepo, pgx.Tx) error); ok {
+		r1 = rf(ctx, email, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequeueOutboxEmail provides a mock function with given fields: ctx, id
+func (_m *EmailOutboxStorer) RequeueOutboxEmail(ctx context.Context, id int64) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequeueOutboxEmail")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEmailOutboxStorer creates a new instance of EmailOutboxStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailOutboxStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailOutboxStorer {
+	mock := &EmailOutboxStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -64,6 +64,140 @@ func (_m *EmailStorer) GetInvitations(ctx context.Context, getRequest repository
 	return r0, r1
 }
 
+// GetPendingInvitation provides a mock function with given fields: ctx, profileID, tx
+func (_m *EmailStorer) GetPendingInvitation(ctx context.Context, profileID int, tx pgx.Tx) (specs.PendingInvitation, error) {
+	ret := _m.Called(ctx, profileID, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingInvitation")
+	}
+
+	var r0 specs.PendingInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) (specs.PendingInvitation, error)); ok {
+		return rf(ctx, profileID, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) specs.PendingInvitation); ok {
+		r0 = rf(ctx, profileID, tx)
+	} else {
+		r0 = ret.Get(0).(specs.PendingInvitation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, pgx.Tx) error); ok {
+		r1 = rf(ctx, profileID, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInvitations provides a mock function with given fields: ctx, filter
+func (_m *EmailStorer) ListInvitations(ctx context.Context, filter specs.ListInvitationsFilter) ([]specs.Invitation, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvitations")
+	}
+
+	var r0 []specs.Invitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListInvitationsFilter) ([]specs.Invitation, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListInvitationsFilter) []specs.Invitation); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.Invitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListInvitationsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPendingInvitations provides a mock function with given fields: ctx
+func (_m *EmailStorer) ListPendingInvitations(ctx context.Context) ([]specs.PendingInvitation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingInvitations")
+	}
+
+	var r0 []specs.PendingInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]specs.PendingInvitation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []specs.PendingInvitation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.PendingInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordInvitationReminder provides a mock function with given fields: ctx, profileID, remindersSent, tx
+func (_m *EmailStorer) RecordInvitationReminder(ctx context.Context, profileID int, remindersSent int, tx pgx.Tx) (bool, error) {
+	ret := _m.Called(ctx, profileID, remindersSent, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordInvitationReminder")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, pgx.Tx) (bool, error)); ok {
+		return rf(ctx, profileID, remindersSent, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, pgx.Tx) bool); ok {
+		r0 = rf(ctx, profileID, remindersSent, tx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, pgx.Tx) error); ok {
+		r1 = rf(ctx, profileID, remindersSent, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RenewInvitation provides a mock function with given fields: ctx, profileID, renewal, tx
+func (_m *EmailStorer) RenewInvitation(ctx context.Context, profileID int, renewal repository.RenewInvitationRepo, tx pgx.Tx) error {
+	ret := _m.Called(ctx, profileID, renewal, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, repository.RenewInvitationRepo, pgx.Tx) error); ok {
+		r0 = rf(ctx, profileID, renewal, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateProfileCompleteStatus provides a mock function with given fields: ctx, profileID, updateReq, tx
 func (_m *EmailStorer) UpdateProfileCompleteStatus(ctx context.Context, profileID int, updateReq repository.UpdateRequest, tx pgx.Tx) error {
 	ret := _m.Called(ctx, profileID, updateReq, tx)
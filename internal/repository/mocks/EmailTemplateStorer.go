@@ -0,0 +1,146 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	repository "github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EmailTemplateStorer is an autogenerated mock type for the EmailTemplateStorer type
+type EmailTemplateStorer struct {
+	mock.Mock
+}
+
+// CreateEmailTemplateVersion provides a mock function with given fields: ctx, template
+func (_m *EmailTemplateStorer) CreateEmailTemplateVersion(ctx context.Context, template repository.EmailTemplateRepo) (specs.EmailTemplate, error) {
+	ret := _m.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEmailTemplateVersion")
+	}
+
+	var r0 specs.EmailTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.EmailTemplateRepo) (specs.EmailTemplate, error)); ok {
+		return rf(ctx, template)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.EmailTemplateRepo) specs.EmailTemplate); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Get(0).(specs.EmailTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.EmailTemplateRepo) error); ok {
+		r1 = rf(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEmailTemplate provides a mock function with given fields: ctx, kind
+func (_m *EmailTemplateStorer) GetEmailTemplate(ctx context.Context, kind string) (specs.EmailTemplate, error) {
+	ret := _m.Called(ctx, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmailTemplate")
+	}
+
+	var r0 specs.EmailTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (specs.EmailTemplate, error)); ok {
+		return rf(ctx, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) specs.EmailTemplate); ok {
+		r0 = rf(ctx, kind)
+	} else {
+		r0 = ret.Get(0).(specs.EmailTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEmailTemplateVersions provides a mock function with given fields: ctx, kind
+func (_m *EmailTemplateStorer) ListEmailTemplateVersions(ctx context.Context, kind string) ([]specs.EmailTemplate, error) {
+	ret := _m.Called(ctx, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmailTemplateVersions")
+	}
+
+	var r0 []specs.EmailTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]specs.EmailTemplate, error)); ok {
+		return rf(ctx, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []specs.EmailTemplate); ok {
+		r0 = rf(ctx, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.EmailTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEmailTemplates provides a mock function with given fields: ctx
+func (_m *EmailTemplateStorer) ListEmailTemplates(ctx context.Context) ([]specs.EmailTemplate, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEmailTemplates")
+	}
+
+	var r0 []specs.EmailTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]specs.EmailTemplate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []specs.EmailTemplate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.EmailTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEmailTemplateStorer creates a new instance of EmailTemplateStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEmailTemplateStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EmailTemplateStorer {
+	mock := &EmailTemplateStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
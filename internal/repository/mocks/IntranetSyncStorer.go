@@ -0,0 +1,261 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	pgx "github.com/jackc/pgx/v5"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+
+	time "time"
+)
+
+// IntranetSyncStorer is an autogenerated mock type for the IntranetSyncStorer type
+type IntranetSyncStorer struct {
+	mock.Mock
+}
+
+// CreateProfileSyncChanges provides a mock function with given fields: ctx, changes, tx
+func (_m *IntranetSyncStorer) CreateProfileSyncChanges(ctx context.Context, changes []repository.ProfileSyncChangeRepo, tx pgx.Tx) error {
+	ret := _m.Called(ctx, changes, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProfileSyncChanges")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []repository.ProfileSyncChangeRepo, pgx.Tx) error); ok {
+		r0 = rf(ctx, changes, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateSyncRun provides a mock function with given fields: ctx, run
+func (_m *IntranetSyncStorer) CreateSyncRun(ctx context.Context, run repository.SyncRunRepo) (int64, error) {
+	ret := _m.Called(ctx, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSyncRun")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.SyncRunRepo) (int64, error)); ok {
+		return rf(ctx, run)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.SyncRunRepo) int64); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.SyncRunRepo) error); ok {
+		r1 = rf(ctx, run)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailStaleSyncRuns provides a mock function with given fields: ctx, startedBefore, reason
+func (_m *IntranetSyncStorer) FailStaleSyncRuns(ctx context.Context, startedBefore time.Time, reason string) (int64, error) {
+	ret := _m.Called(ctx, startedBefore, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailStaleSyncRuns")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string) (int64, error)); ok {
+		return rf(ctx, startedBefore, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, string) int64); ok {
+		r0 = rf(ctx, startedBefore, reason)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, string) error); ok {
+		r1 = rf(ctx, startedBefore, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FinishSyncRun provides a mock function with given fields: ctx, runID, result
+func (_m *IntranetSyncStorer) FinishSyncRun(ctx context.Context, runID int64, result repository.SyncRunResultRepo) error {
+	ret := _m.Called(ctx, runID, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishSyncRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, repository.SyncRunResultRepo) error); ok {
+		r0 = rf(ctx, runID, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetSyncRun provides a mock function with given fields: ctx, runID
+func (_m *IntranetSyncStorer) GetSyncRun(ctx context.Context, runID int64) (specs.SyncRun, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSyncRun")
+	}
+
+	var r0 specs.SyncRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.SyncRun, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.SyncRun); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Get(0).(specs.SyncRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSyncWatermark provides a mock function with given fields: ctx, name
+func (_m *IntranetSyncStorer) GetSyncWatermark(ctx context.Context, name string) (time.Time, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSyncWatermark")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (time.Time, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) time.Time); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProfileSyncChanges provides a mock function with given fields: ctx, profileID, tx
+func (_m *IntranetSyncStorer) ListProfileSyncChanges(ctx context.Context, profileID int, tx pgx.Tx) ([]specs.ProfileSyncChange, error) {
+	ret := _m.Called(ctx, profileID, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfileSyncChanges")
+	}
+
+	var r0 []specs.ProfileSyncChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) ([]specs.ProfileSyncChange, error)); ok {
+		return rf(ctx, profileID, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) []specs.ProfileSyncChange); ok {
+		r0 = rf(ctx, profileID, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.ProfileSyncChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, pgx.Tx) error); ok {
+		r1 = rf(ctx, profileID, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSyncRuns provides a mock function with given fields: ctx, limit
+func (_m *IntranetSyncStorer) ListSyncRuns(ctx context.Context, limit int) ([]specs.SyncRun, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSyncRuns")
+	}
+
+	var r0 []specs.SyncRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]specs.SyncRun, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []specs.SyncRun); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.SyncRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetSyncWatermark provides a mock function with given fields: ctx, name, watermark
+func (_m *IntranetSyncStorer) SetSyncWatermark(ctx context.Context, name string, watermark time.Time) error {
+	ret := _m.Called(ctx, name, watermark)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSyncWatermark")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, name, watermark)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIntranetSyncStorer creates a new instance of IntranetSyncStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIntranetSyncStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IntranetSyncStorer {
+	mock := &IntranetSyncStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,229 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	repository "github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IntranetWebhookStorer is an autogenerated mock type for the IntranetWebhookStorer type
+type IntranetWebhookStorer struct {
+	mock.Mock
+}
+
+// ClaimDueWebhookEvents provides a mock function with given fields: ctx, limit, leaseUntil
+func (_m *IntranetWebhookStorer) ClaimDueWebhookEvents(ctx context.Context, limit int, leaseUntil time.Time) ([]specs.IntranetWebhookEventRecord, error) {
+	ret := _m.Called(ctx, limit, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueWebhookEvents")
+	}
+
+	var r0 []specs.IntranetWebhookEventRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) ([]specs.IntranetWebhookEventRecord, error)); ok {
+		return rf(ctx, limit, leaseUntil)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) []specs.IntranetWebhookEventRecord); ok {
+		r0 = rf(ctx, limit, leaseUntil)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.IntranetWebhookEventRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, limit, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimWebhookEvent provides a mock function with given fields: ctx, id, leaseUntil
+func (_m *IntranetWebhookStorer) ClaimWebhookEvent(ctx context.Context, id int64, leaseUntil time.Time) (specs.IntranetWebhookEventRecord, error) {
+	ret := _m.Called(ctx, id, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimWebhookEvent")
+	}
+
+	var r0 specs.IntranetWebhookEventRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) (specs.IntranetWebhookEventRecord, error)); ok {
+		return rf(ctx, id, leaseUntil)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) specs.IntranetWebhookEventRecord); ok {
+		r0 = rf(ctx, id, leaseUntil)
+	} else {
+		r0 = ret.Get(0).(specs.IntranetWebhookEventRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time) error); ok {
+		r1 = rf(ctx, id, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWebhookEvent provides a mock function with given fields: ctx, event
+func (_m *IntranetWebhookStorer) CreateWebhookEvent(ctx context.Context, event repository.IntranetWebhookEventRepo) (int64, bool, error) {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookEvent")
+	}
+
+	var r0 int64
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.IntranetWebhookEventRepo) (int64, bool, error)); ok {
+		return rf(ctx, event)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.IntranetWebhookEventRepo) int64); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.IntranetWebhookEventRepo) bool); ok {
+		r1 = rf(ctx, event)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, repository.IntranetWebhookEventRepo) error); ok {
+		r2 = rf(ctx, event)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FinishWebhookEvent provides a mock function with given fields: ctx, id, result
+func (_m *IntranetWebhookStorer) FinishWebhookEvent(ctx context.Context, id int64, result repository.IntranetWebhookResultRepo) error {
+	ret := _m.Called(ctx, id, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishWebhookEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, repository.IntranetWebhookResultRepo) error); ok {
+		r0 = rf(ctx, id, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetWebhookEvent provides a mock function with given fields: ctx, id
+func (_m *IntranetWebhookStorer) GetWebhookEvent(ctx context.Context, id int64) (specs.IntranetWebhookEventRecord, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookEvent")
+	}
+
+	var r0 specs.IntranetWebhookEventRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (specs.IntranetWebhookEventRecord, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) specs.IntranetWebhookEventRecord); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(specs.IntranetWebhookEventRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWebhookEvents provides a mock function with given fields: ctx, filter
+func (_m *IntranetWebhookStorer) ListWebhookEvents(ctx context.Context, filter specs.ListIntranetWebhookEventsFilter) ([]specs.IntranetWebhookEventRecord, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhookEvents")
+	}
+
+	var r0 []specs.IntranetWebhookEventRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListIntranetWebhookEventsFilter) ([]specs.IntranetWebhookEventRecord, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListIntranetWebhookEventsFilter) []specs.IntranetWebhookEventRecord); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.IntranetWebhookEventRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListIntranetWebhookEventsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetWebhookEvent provides a mock function with given fields: ctx, id
+func (_m *IntranetWebhookStorer) ResetWebhookEvent(ctx context.Context, id int64) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetWebhookEvent")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIntranetWebhookStorer creates a new instance of IntranetWebhookStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIntranetWebhookStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IntranetWebhookStorer {
+	mock := &IntranetWebhookStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,177 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	pgx "github.com/jackc/pgx/v5"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// NotificationStorer is an autogenerated mock type for the NotificationStorer type
+type NotificationStorer struct {
+	mock.Mock
+}
+
+// CountUnreadNotifications provides a mock function with given fields: ctx, userID
+func (_m *NotificationStorer) CountUnreadNotifications(ctx context.Context, userID int) (int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnreadNotifications")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateNotifications provides a mock function with given fields: ctx, notification, tx
+func (_m *NotificationStorer) CreateNotifications(ctx context.Context, notification repository.NotificationRepo, tx pgx.Tx) ([]specs.Notification, error) {
+	ret := _m.Called(ctx, notification, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotifications")
+	}
+
+	var r0 []specs.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.NotificationRepo, pgx.Tx) ([]specs.Notification, error)); ok {
+		return rf(ctx, notification, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.NotificationRepo, pgx.Tx) []specs.Notification); ok {
+		r0 = rf(ctx, notification, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.NotificationRepo, pgx.Tx) error); ok {
+		r1 = rf(ctx, notification, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListNotifications provides a mock function with given fields: ctx, userID, filter
+func (_m *NotificationStorer) ListNotifications(ctx context.Context, userID int, filter specs.ListNotificationsFilter) ([]specs.Notification, error) {
+	ret := _m.Called(ctx, userID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotifications")
+	}
+
+	var r0 []specs.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListNotificationsFilter) ([]specs.Notification, error)); ok {
+		return rf(ctx, userID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, specs.ListNotificationsFilter) []specs.Notification); ok {
+		r0 = rf(ctx, userID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, specs.ListNotificationsFilter) error); ok {
+		r1 = rf(ctx, userID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAllNotificationsRead provides a mock function with given fields: ctx, userID
+func (_m *NotificationStorer) MarkAllNotificationsRead(ctx context.Context, userID int) (int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllNotificationsRead")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkNotificationRead provides a mock function with given fields: ctx, userID, notificationID
+func (_m *NotificationStorer) MarkNotificationRead(ctx context.Context, userID int, notificationID int64) (specs.Notification, error) {
+	ret := _m.Called(ctx, userID, notificationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNotificationRead")
+	}
+
+	var r0 specs.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int64) (specs.Notification, error)); ok {
+		return rf(ctx, userID, notificationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int64) specs.Notification); ok {
+		r0 = rf(ctx, userID, notificationID)
+	} else {
+		r0 = ret.Get(0).(specs.Notification)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int64) error); ok {
+		r1 = rf(ctx, userID, notificationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNotificationStorer creates a new instance of NotificationStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationStorer {
+	mock := &NotificationStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,91 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	pgx "github.com/jackc/pgx/v5"
+	mock "github.com/stretchr/testify/mock"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+
+	time "time"
+)
+
+// ProfileReminderStorer is an autogenerated mock type for the ProfileReminderStorer type
+type ProfileReminderStorer struct {
+	mock.Mock
+}
+
+// ListProfileActivity provides a mock function with given fields: ctx
+func (_m *ProfileReminderStorer) ListProfileActivity(ctx context.Context) ([]specs.ProfileActivity, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfileActivity")
+	}
+
+	var r0 []specs.ProfileActivity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]specs.ProfileActivity, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []specs.ProfileActivity); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.ProfileActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordStaleProfileReminder provides a mock function with given fields: ctx, profileID, remindedBefore, tx
+func (_m *ProfileReminderStorer) RecordStaleProfileReminder(ctx context.Context, profileID int, remindedBefore time.Time, tx pgx.Tx) (bool, error) {
+	ret := _m.Called(ctx, profileID, remindedBefore, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordStaleProfileReminder")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, pgx.Tx) (bool, error)); ok {
+		return rf(ctx, profileID, remindedBefore, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, pgx.Tx) bool); ok {
+		r0 = rf(ctx, profileID, remindedBefore, tx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time, pgx.Tx) error); ok {
+		r1 = rf(ctx, profileID, remindedBefore, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewProfileReminderStorer creates a new instance of ProfileReminderStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProfileReminderStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProfileReminderStorer {
+	mock := &ProfileReminderStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -18,11 +18,6 @@ type ProfileStorer struct {
 	mock.Mock
 }
 
-// BackupAllProfiles provides a mock function with given fields: backupDir
-func (_m *ProfileStorer) BackupAllProfiles(backupDir string) {
-	_m.Called(backupDir)
-}
-
 // BeginTransaction provides a mock function with given fields: ctx
 func (_m *ProfileStorer) BeginTransaction(ctx context.Context) (pgx.Tx, error) {
 	ret := _m.Called(ctx)
@@ -211,6 +206,36 @@ func (_m *ProfileStorer) GetProfileIDByEmployeeID(ctx context.Context, employeeI
 	return r0, r1
 }
 
+// GetProfileIDsByEmployeeIDs provides a mock function with given fields: ctx, employeeIDs, tx
+func (_m *ProfileStorer) GetProfileIDsByEmployeeIDs(ctx context.Context, employeeIDs []string, tx pgx.Tx) (map[string]int, error) {
+	ret := _m.Called(ctx, employeeIDs, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfileIDsByEmployeeIDs")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, pgx.Tx) (map[string]int, error)); ok {
+		return rf(ctx, employeeIDs, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, pgx.Tx) map[string]int); ok {
+		r0 = rf(ctx, employeeIDs, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, pgx.Tx) error); ok {
+		r1 = rf(ctx, employeeIDs, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HandleTransaction provides a mock function with given fields: ctx, tx, incomingErr
 func (_m *ProfileStorer) HandleTransaction(ctx context.Context, tx pgx.Tx, incomingErr error) error {
 	ret := _m.Called(ctx, tx, incomingErr)
@@ -229,9 +254,97 @@ func (_m *ProfileStorer) HandleTransaction(ctx context.Context, tx pgx.Tx, incom
 	return r0
 }
 
-// ListProfiles provides a mock function with given fields: ctx, tx
-func (_m *ProfileStorer) ListProfiles(ctx context.Context, tx pgx.Tx) ([]specs.ListProfiles, error) {
-	ret := _m.Called(ctx, tx)
+// IsReportee provides a mock function with given fields: ctx, managerID, profileID
+func (_m *ProfileStorer) IsReportee(ctx context.Context, managerID int, profileID int) (bool, error) {
+	ret := _m.Called(ctx, managerID, profileID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsReportee")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (bool, error)); ok {
+		return rf(ctx, managerID, profileID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) bool); ok {
+		r0 = rf(ctx, managerID, profileID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, managerID, profileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProfileChanges provides a mock function with given fields: ctx, filter, tx
+func (_m *ProfileStorer) ListProfileChanges(ctx context.Context, filter specs.ListProfileChangesFilter, tx pgx.Tx) ([]specs.ProfileChangeV1, error) {
+	ret := _m.Called(ctx, filter, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfileChanges")
+	}
+
+	var r0 []specs.ProfileChangeV1
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListProfileChangesFilter, pgx.Tx) ([]specs.ProfileChangeV1, error)); ok {
+		return rf(ctx, filter, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListProfileChangesFilter, pgx.Tx) []specs.ProfileChangeV1); ok {
+		r0 = rf(ctx, filter, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.ProfileChangeV1)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListProfileChangesFilter, pgx.Tx) error); ok {
+		r1 = rf(ctx, filter, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProfileEmployments provides a mock function with given fields: ctx
+func (_m *ProfileStorer) ListProfileEmployments(ctx context.Context) ([]specs.ProfileEmployment, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfileEmployments")
+	}
+
+	var r0 []specs.ProfileEmployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]specs.ProfileEmployment, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []specs.ProfileEmployment); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.ProfileEmployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProfiles provides a mock function with given fields: ctx, filter, tx
+func (_m *ProfileStorer) ListProfiles(ctx context.Context, filter specs.ListProfilesFilter, tx pgx.Tx) ([]specs.ListProfiles, error) {
+	ret := _m.Called(ctx, filter, tx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListProfiles")
@@ -239,19 +352,19 @@ func (_m *ProfileStorer) ListProfiles(ctx context.Context, tx pgx.Tx) ([]specs.L
 
 	var r0 []specs.ListProfiles
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) ([]specs.ListProfiles, error)); ok {
-		return rf(ctx, tx)
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListProfilesFilter, pgx.Tx) ([]specs.ListProfiles, error)); ok {
+		return rf(ctx, filter, tx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) []specs.ListProfiles); ok {
-		r0 = rf(ctx, tx)
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListProfilesFilter, pgx.Tx) []specs.ListProfiles); ok {
+		r0 = rf(ctx, filter, tx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]specs.ListProfiles)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
-		r1 = rf(ctx, tx)
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListProfilesFilter, pgx.Tx) error); ok {
+		r1 = rf(ctx, filter, tx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -287,24 +400,6 @@ func (_m *ProfileStorer) ListSkills(ctx context.Context, tx pgx.Tx) (specs.ListS
 	return r0, r1
 }
 
-// UpdateEmployeeIDByEmail provides a mock function with given fields: ctx, email, employeeID
-func (_m *ProfileStorer) UpdateEmployeeIDByEmail(ctx context.Context, email string, employeeID string) error {
-	ret := _m.Called(ctx, email, employeeID)
-
-	if len(ret) == 0 {
-		panic("no return value specified for UpdateEmployeeIDByEmail")
-	}
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, email, employeeID)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
 // UpdateProfile provides a mock function with given fields: ctx, profileID, pd, tx
 func (_m *ProfileStorer) UpdateProfile(ctx context.Context, profileID int, pd repository.UpdateProfileRepo, tx pgx.Tx) (int, error) {
 	ret := _m.Called(ctx, profileID, pd, tx)
@@ -333,6 +428,24 @@ func (_m *ProfileStorer) UpdateProfile(ctx context.Context, profileID int, pd re
 	return r0, r1
 }
 
+// UpdateProfileManager provides a mock function with given fields: ctx, profileID, managerID, tx
+func (_m *ProfileStorer) UpdateProfileManager(ctx context.Context, profileID int, managerID *int, tx pgx.Tx) error {
+	ret := _m.Called(ctx, profileID, managerID, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfileManager")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *int, pgx.Tx) error); ok {
+		r0 = rf(ctx, profileID, managerID, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateProfileStatus provides a mock function with given fields: ctx, profileID, updateRequest, tx
 func (_m *ProfileStorer) UpdateProfileStatus(ctx context.Context, profileID int, updateRequest repository.UpdateProfileStatusRepo, tx pgx.Tx) error {
 	ret := _m.Called(ctx, profileID, updateRequest, tx)
@@ -351,6 +464,24 @@ func (_m *ProfileStorer) UpdateProfileStatus(ctx context.Context, profileID int,
 	return r0
 }
 
+// UpdateProfileSyncFields provides a mock function with given fields: ctx, profileID, fields, updatedAt, tx
+func (_m *ProfileStorer) UpdateProfileSyncFields(ctx context.Context, profileID int, fields map[string]interface{}, updatedAt string, tx pgx.Tx) error {
+	ret := _m.Called(ctx, profileID, fields, updatedAt, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfileSyncFields")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, map[string]interface{}, string, pgx.Tx) error); ok {
+		r0 = rf(ctx, profileID, fields, updatedAt, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateSequence provides a mock function with given fields: ctx, us, tx
 func (_m *ProfileStorer) UpdateSequence(ctx context.Context, us repository.UpdateSequenceRequest, tx pgx.Tx) (int, error) {
 	ret := _m.Called(ctx, us, tx)
@@ -54,6 +54,36 @@ func (_m *ProjectStorer) DeleteProject(ctx context.Context, profileID int, proje
 	return r0
 }
 
+// ListIntranetProjectRejections provides a mock function with given fields: ctx, profileID, tx
+func (_m *ProjectStorer) ListIntranetProjectRejections(ctx context.Context, profileID int, tx pgx.Tx) ([]specs.IntranetProjectRejection, error) {
+	ret := _m.Called(ctx, profileID, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIntranetProjectRejections")
+	}
+
+	var r0 []specs.IntranetProjectRejection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) ([]specs.IntranetProjectRejection, error)); ok {
+		return rf(ctx, profileID, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) []specs.IntranetProjectRejection); ok {
+		r0 = rf(ctx, profileID, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.IntranetProjectRejection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, pgx.Tx) error); ok {
+		r1 = rf(ctx, profileID, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListProjects provides a mock function with given fields: ctx, profileID, filter, tx
 func (_m *ProjectStorer) ListProjects(ctx context.Context, profileID int, filter specs.ListProjectsFilter, tx pgx.Tx) ([]specs.ProjectResponse, error) {
 	ret := _m.Called(ctx, profileID, filter, tx)
@@ -112,6 +142,24 @@ func (_m *ProjectStorer) UpdateProject(ctx context.Context, profileID int, eduID
 	return r0, r1
 }
 
+// UpsertIntranetProjectRejection provides a mock function with given fields: ctx, rejection, tx
+func (_m *ProjectStorer) UpsertIntranetProjectRejection(ctx context.Context, rejection repository.IntranetProjectRejectionRepo, tx pgx.Tx) error {
+	ret := _m.Called(ctx, rejection, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertIntranetProjectRejection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.IntranetProjectRejectionRepo, pgx.Tx) error); ok {
+		r0 = rf(ctx, rejection, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewProjectStorer creates a new instance of ProjectStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProjectStorer(t interface {
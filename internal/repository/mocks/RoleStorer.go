@@ -0,0 +1,231 @@
+// Code generated by mockery v2.53.6. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	pgx "github.com/jackc/pgx/v5"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/joshsoftware/profile_builder_backend_go/internal/repository"
+
+	specs "github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+)
+
+// RoleStorer is an autogenerated mock type for the RoleStorer type
+type RoleStorer struct {
+	mock.Mock
+}
+
+// CountUsersWithRole provides a mock function with given fields: ctx, roleName, tx
+func (_m *RoleStorer) CountUsersWithRole(ctx context.Context, roleName string, tx pgx.Tx) (int, error) {
+	ret := _m.Called(ctx, roleName, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUsersWithRole")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx) (int, error)); ok {
+		return rf(ctx, roleName, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, pgx.Tx) int); ok {
+		r0 = rf(ctx, roleName, tx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, pgx.Tx) error); ok {
+		r1 = rf(ctx, roleName, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRole provides a mock function with given fields: ctx, role, tx
+func (_m *RoleStorer) CreateRole(ctx context.Context, role repository.RoleRepo, tx pgx.Tx) (int, error) {
+	ret := _m.Called(ctx, role, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRole")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.RoleRepo, pgx.Tx) (int, error)); ok {
+		return rf(ctx, role, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.RoleRepo, pgx.Tx) int); ok {
+		r0 = rf(ctx, role, tx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.RoleRepo, pgx.Tx) error); ok {
+		r1 = rf(ctx, role, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRole provides a mock function with given fields: ctx, roleID, tx
+func (_m *RoleStorer) DeleteRole(ctx context.Context, roleID int, tx pgx.Tx) error {
+	ret := _m.Called(ctx, roleID, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) error); ok {
+		r0 = rf(ctx, roleID, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRole provides a mock function with given fields: ctx, roleID, tx
+func (_m *RoleStorer) GetRole(ctx context.Context, roleID int, tx pgx.Tx) (specs.RoleResponse, error) {
+	ret := _m.Called(ctx, roleID, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRole")
+	}
+
+	var r0 specs.RoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) (specs.RoleResponse, error)); ok {
+		return rf(ctx, roleID, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) specs.RoleResponse); ok {
+		r0 = rf(ctx, roleID, tx)
+	} else {
+		r0 = ret.Get(0).(specs.RoleResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, pgx.Tx) error); ok {
+		r1 = rf(ctx, roleID, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRolePermissions provides a mock function with given fields: ctx
+func (_m *RoleStorer) ListRolePermissions(ctx context.Context) (map[string]map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRolePermissions")
+	}
+
+	var r0 map[string]map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoles provides a mock function with given fields: ctx, tx
+func (_m *RoleStorer) ListRoles(ctx context.Context, tx pgx.Tx) ([]specs.RoleResponse, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoles")
+	}
+
+	var r0 []specs.RoleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) ([]specs.RoleResponse, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) []specs.RoleResponse); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.RoleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceRolePermissions provides a mock function with given fields: ctx, roleID, permissions, tx
+func (_m *RoleStorer) ReplaceRolePermissions(ctx context.Context, roleID int, permissions []specs.RolePermission, tx pgx.Tx) error {
+	ret := _m.Called(ctx, roleID, permissions, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceRolePermissions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []specs.RolePermission, pgx.Tx) error); ok {
+		r0 = rf(ctx, roleID, permissions, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRole provides a mock function with given fields: ctx, roleID, description, updatedAt, tx
+func (_m *RoleStorer) UpdateRole(ctx context.Context, roleID int, description string, updatedAt string, tx pgx.Tx) error {
+	ret := _m.Called(ctx, roleID, description, updatedAt, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string, pgx.Tx) error); ok {
+		r0 = rf(ctx, roleID, description, updatedAt, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRoleStorer creates a new instance of RoleStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRoleStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RoleStorer {
+	mock := &RoleStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -18,6 +18,62 @@ type UserStorer struct {
 	mock.Mock
 }
 
+// AddUser provides a mock function with given fields: ctx, user, tx
+func (_m *UserStorer) AddUser(ctx context.Context, user repository.UserRepo, tx pgx.Tx) (int, error) {
+	ret := _m.Called(ctx, user, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddUser")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UserRepo, pgx.Tx) (int, error)); ok {
+		return rf(ctx, user, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, repository.UserRepo, pgx.Tx) int); ok {
+		r0 = rf(ctx, user, tx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, repository.UserRepo, pgx.Tx) error); ok {
+		r1 = rf(ctx, user, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountActiveAdmins provides a mock function with given fields: ctx, tx
+func (_m *UserStorer) CountActiveAdmins(ctx context.Context, tx pgx.Tx) (int, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountActiveAdmins")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) (int, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) int); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateUser provides a mock function with given fields: ctx, name, email, role, tx
 func (_m *UserStorer) CreateUser(ctx context.Context, name string, email string, role string, tx pgx.Tx) error {
 	ret := _m.Called(ctx, name, email, role, tx)
@@ -36,6 +92,52 @@ func (_m *UserStorer) CreateUser(ctx context.Context, name string, email string,
 	return r0
 }
 
+// DeleteUser provides a mock function with given fields: ctx, userID, tx
+func (_m *UserStorer) DeleteUser(ctx context.Context, userID int, tx pgx.Tx) error {
+	ret := _m.Called(ctx, userID, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) error); ok {
+		r0 = rf(ctx, userID, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetUser provides a mock function with given fields: ctx, userID, tx
+func (_m *UserStorer) GetUser(ctx context.Context, userID int, tx pgx.Tx) (specs.UserResponse, error) {
+	ret := _m.Called(ctx, userID, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 specs.UserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) (specs.UserResponse, error)); ok {
+		return rf(ctx, userID, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, pgx.Tx) specs.UserResponse); ok {
+		r0 = rf(ctx, userID, tx)
+	} else {
+		r0 = ret.Get(0).(specs.UserResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, pgx.Tx) error); ok {
+		r1 = rf(ctx, userID, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUserInfo provides a mock function with given fields: ctx, filter
 func (_m *UserStorer) GetUserInfo(ctx context.Context, filter specs.UserInfoFilter) (repository.User, error) {
 	ret := _m.Called(ctx, filter)
@@ -64,6 +166,36 @@ func (_m *UserStorer) GetUserInfo(ctx context.Context, filter specs.UserInfoFilt
 	return r0, r1
 }
 
+// ListUsers provides a mock function with given fields: ctx, filter, tx
+func (_m *UserStorer) ListUsers(ctx context.Context, filter specs.ListUsersFilter, tx pgx.Tx) ([]specs.UserResponse, error) {
+	ret := _m.Called(ctx, filter, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 []specs.UserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListUsersFilter, pgx.Tx) ([]specs.UserResponse, error)); ok {
+		return rf(ctx, filter, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, specs.ListUsersFilter, pgx.Tx) []specs.UserResponse); ok {
+		r0 = rf(ctx, filter, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]specs.UserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, specs.ListUsersFilter, pgx.Tx) error); ok {
+		r1 = rf(ctx, filter, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveUser provides a mock function with given fields: ctx, email, tx
 func (_m *UserStorer) RemoveUser(ctx context.Context, email string, tx pgx.Tx) error {
 	ret := _m.Called(ctx, email, tx)
@@ -82,6 +214,78 @@ func (_m *UserStorer) RemoveUser(ctx context.Context, email string, tx pgx.Tx) e
 	return r0
 }
 
+// UpdateLastLogin provides a mock function with given fields: ctx, userID, loginAt, tx
+func (_m *UserStorer) UpdateLastLogin(ctx context.Context, userID int64, loginAt string, tx pgx.Tx) error {
+	ret := _m.Called(ctx, userID, loginAt, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastLogin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, pgx.Tx) error); ok {
+		r0 = rf(ctx, userID, loginAt, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateUser provides a mock function with given fields: ctx, userID, user, tx
+func (_m *UserStorer) UpdateUser(ctx context.Context, userID int, user repository.UpdateUserRepo, tx pgx.Tx) error {
+	ret := _m.Called(ctx, userID, user, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, repository.UpdateUserRepo, pgx.Tx) error); ok {
+		r0 = rf(ctx, userID, user, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateUserRole provides a mock function with given fields: ctx, userID, role, tx
+func (_m *UserStorer) UpdateUserRole(ctx context.Context, userID int, role string, tx pgx.Tx) error {
+	ret := _m.Called(ctx, userID, role, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, pgx.Tx) error); ok {
+		r0 = rf(ctx, userID, role, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateUserStatus provides a mock function with given fields: ctx, userID, isActive, updatedAt, tx
+func (_m *UserStorer) UpdateUserStatus(ctx context.Context, userID int, isActive bool, updatedAt string, tx pgx.Tx) error {
+	ret := _m.Called(ctx, userID, isActive, updatedAt, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool, string, pgx.Tx) error); ok {
+		r0 = rf(ctx, userID, isActive, updatedAt, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewUserStorer creates a new instance of UserStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserStorer(t interface {
@@ -1,13 +1,16 @@
 package repository
 
+import "time"
+
 // User represents a data access object for user-related information.
 // This struct maps to a database table, where each field corresponds to a column
 // in the users table.
 type User struct {
-	ID    int64  `db:"id"`
-	Email string `db:"email"`
-	Role  string `db:"role"`
-	Name  string `db:"name"`
+	ID       int64  `db:"id"`
+	Email    string `db:"email"`
+	Role     string `db:"role"`
+	Name     string `db:"name"`
+	IsActive bool   `db:"is_active"`
 }
 
 // ProfileRepo represents a data access object for profile-related information.
@@ -222,12 +225,23 @@ type UpdateRequest struct {
 
 // Invitations represents a data access object for email information.
 type Invitations struct {
-	ProfileID       int    `db:"profile_id"`
-	ProfileComplete int    `db:"is_profile_complete"`
-	CreatedAt       string `db:"created_at"`
-	UpdatedAt       string `db:"updated_at"`
-	CreatedByID     int    `db:"created_by_id"`
-	UpdatedByID     int    `db:"updated_by_id"`
+	ProfileID       int       `db:"profile_id"`
+	ProfileComplete int       `db:"is_profile_complete"`
+	CreatedAt       string    `db:"created_at"`
+	UpdatedAt       string    `db:"updated_at"`
+	CreatedByID     int       `db:"created_by_id"`
+	UpdatedByID     int       `db:"updated_by_id"`
+	ExpiresAt       time.Time `db:"expires_at"`
+	// RemindersSent counts the reminders already due when the invitation is sent, which are not sent
+	RemindersSent int `db:"reminders_sent"`
+}
+
+// RenewInvitationRepo represents an invitation being resent with a new expiry.
+type RenewInvitationRepo struct {
+	ExpiresAt     time.Time `db:"expires_at"`
+	RemindersSent int       `db:"reminders_sent"`
+	UpdatedAt     string    `db:"updated_at"`
+	UpdatedByID   int       `db:"updated_by_id"`
 }
 
 // UserInfo represents a data access object for user information.
@@ -241,3 +255,171 @@ type GetRequest struct {
 	ProfileID         int `db:"profile_id"`
 	IsProfileComplete int `db:"is_profile_complete"`
 }
+
+// RoleRepo represents a data access object for role information.
+type RoleRepo struct {
+	Name        string `db:"name"`
+	Description string `db:"description"`
+	CreatedAt   string `db:"created_at"`
+	UpdatedAt   string `db:"updated_at"`
+}
+
+// UserRepo represents a data access object for creating a user.
+type UserRepo struct {
+	Name      string `db:"name"`
+	Email     string `db:"email"`
+	Role      string `db:"role"`
+	CreatedAt string `db:"created_at"`
+	UpdatedAt string `db:"updated_at"`
+}
+
+// UpdateUserRepo represents a data access object for updating a user.
+type UpdateUserRepo struct {
+	Name      string `db:"name"`
+	Role      string `db:"role"`
+	UpdatedAt string `db:"updated_at"`
+}
+
+// AuditEventRepo represents a data access object for an audit event recorded by the application.
+type AuditEventRepo struct {
+	ActorID    int    `db:"actor_id"`
+	ActorRole  string `db:"actor_role"`
+	Action     string `db:"action"`
+	TargetType string `db:"target_type"`
+	TargetID   int    `db:"target_id"`
+	RequestID  string `db:"request_id"`
+}
+
+// APIKeyRepo represents a data access object for issuing an API key.
+type APIKeyRepo struct {
+	Name        string     `db:"name"`
+	Prefix      string     `db:"prefix"`
+	KeyHash     string     `db:"key_hash"`
+	Scopes      []string   `db:"scopes"`
+	AllowedIPs  []string   `db:"allowed_ips"`
+	ExpiresAt   *time.Time `db:"expires_at"`
+	CreatedByID int        `db:"created_by_id"`
+	CreatedAt   string     `db:"created_at"`
+	UpdatedAt   string     `db:"updated_at"`
+}
+
+// APIKeyCredential represents what is needed to authenticate a request made with an API key.
+type APIKeyCredential struct {
+	ID         int        `db:"id"`
+	Name       string     `db:"name"`
+	KeyHash    string     `db:"key_hash"`
+	Scopes     []string   `db:"scopes"`
+	AllowedIPs []string   `db:"allowed_ips"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
+
+// ProfileSyncChangeRepo represents a data access object for a profile field changed by the intranet sync.
+type ProfileSyncChangeRepo struct {
+	ProfileID  int    `db:"profile_id"`
+	EmployeeID string `db:"employee_id"`
+	Field      string `db:"field"`
+	OldValue   string `db:"old_value"`
+	NewValue   string `db:"new_value"`
+	Policy     string `db:"policy"`
+	Reason     string `db:"reason"`
+}
+
+// SyncRunRepo represents an intranet sync run being started.
+type SyncRunRepo struct {
+	Trigger           string    `db:"trigger"`
+	TriggeredByID     *int      `db:"triggered_by_id"`
+	Incremental       bool      `db:"incremental"`
+	DeactivateLeavers bool      `db:"deactivate_leavers"`
+	StartedAt         time.Time `db:"started_at"`
+}
+
+// SyncRunResultRepo represents the outcome of a finished intranet sync run.
+type SyncRunResultRepo struct {
+	Status     string    `db:"status"`
+	FinishedAt time.Time `db:"finished_at"`
+	Updated    int       `db:"updated"`
+	Unchanged  int       `db:"unchanged"`
+	Skipped    int       `db:"skipped"`
+	Leavers    int       `db:"leavers"`
+	Rejoiners  int       `db:"rejoiners"`
+	Errors     int       `db:"errors"`
+	Error      string    `db:"error"`
+}
+
+// IntranetProjectRejectionRepo represents an intranet project an employee chose not to import into their profile.
+type IntranetProjectRejectionRepo struct {
+	ProfileID        int    `db:"profile_id"`
+	NameKey          string `db:"name_key"`
+	Description      string `db:"description"`
+	WorkingStartDate string `db:"working_start_date"`
+	WorkingEndDate   string `db:"working_end_date"`
+	RejectedByID     int    `db:"rejected_by_id"`
+}
+
+// IntranetWebhookEventRepo represents an intranet webhook event being stored.
+type IntranetWebhookEventRepo struct {
+	EventID    string `db:"event_id"`
+	EventType  string `db:"event_type"`
+	EmployeeID string `db:"employee_id"`
+	Payload    []byte `db:"payload"`
+}
+
+// IntranetWebhookResultRepo represents the outcome of an attempt to process an intranet webhook event.
+type IntranetWebhookResultRepo struct {
+	Status        string     `db:"status"`
+	LastError     string     `db:"last_error"`
+	Result        []byte     `db:"result"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	ProcessedAt   *time.Time `db:"processed_at"`
+}
+
+// OutboxEmailRepo represents an email being queued in the outbox.
+type OutboxEmailRepo struct {
+	Kind      string `db:"kind"`
+	Recipient string `db:"recipient"`
+	Subject   string `db:"subject"`
+	Body      string `db:"body"`
+	TextBody  string `db:"text_body"`
+	ProfileID *int   `db:"profile_id"`
+}
+
+// EmailTemplateRepo represents a new version of an email template.
+type EmailTemplateRepo struct {
+	Kind        string `db:"kind"`
+	Subject     string `db:"subject"`
+	HTMLBody    string `db:"html_body"`
+	TextBody    string `db:"text_body"`
+	CreatedByID int    `db:"created_by_id"`
+}
+
+// OutboxEmailResultRepo represents the outcome of an attempt to send an email from the outbox.
+type OutboxEmailResultRepo struct {
+	Status        string     `db:"status"`
+	LastError     string     `db:"last_error"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	SentAt        *time.Time `db:"sent_at"`
+}
+
+// NotificationRepo represents a notification to create for its recipients: the user with UserID, the user with
+// Email, or every active user whose role has Permission, whichever is set.
+type NotificationRepo struct {
+	UserID     int    `db:"user_id"`
+	Email      string `db:"email"`
+	Permission string `db:"permission"`
+	Kind       string `db:"kind"`
+	Title      string `db:"title"`
+	Body       string `db:"body"`
+	Link       string `db:"link"`
+	ProfileID  *int   `db:"profile_id"`
+}
+
+// BackupVerificationRepo represents the outcome of a test restore of a backup archive.
+type BackupVerificationRepo struct {
+	FileName  string         `db:"file_name"`
+	Checksum  string         `db:"checksum"`
+	Status    string         `db:"status"`
+	Error     string         `db:"error"`
+	RowCounts map[string]int `db:"row_counts"`
+}
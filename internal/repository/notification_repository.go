@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// NotificationStore implements the NotificationStorer interface.
+type NotificationStore struct {
+	db *pgxpool.Pool
+}
+
+// Constants for notification table names
+var (
+	notificationTable = "notifications"
+)
+
+// notificationColumns are the columns of a notification in the order scanNotification reads them
+var notificationColumns = []string{"id", "user_id", "kind", "title", "body", "link", "profile_id", "read_at", "created_at"}
+
+// NotificationStorer defines methods to create and read the in-app notifications of users.
+type NotificationStorer interface {
+	CreateNotifications(ctx context.Context, notification NotificationRepo, tx pgx.Tx) ([]specs.Notification, error)
+	ListNotifications(ctx context.Context, userID int, filter specs.ListNotificationsFilter) ([]specs.Notification, error)
+	CountUnreadNotifications(ctx context.Context, userID int) (int, error)
+	MarkNotificationRead(ctx context.Context, userID int, notificationID int64) (specs.Notification, error)
+	MarkAllNotificationsRead(ctx context.Context, userID int) (int, error)
+}
+
+// NewNotificationRepo creates a new instance of NotificationRepo.
+func NewNotificationRepo(db *pgxpool.Pool) NotificationStorer {
+	return &NotificationStore{
+		db: db,
+	}
+}
+
+// scanNotification scans a row of notificationColumns
+func scanNotification(row pgx.Row) (specs.Notification, error) {
+	var notification specs.Notification
+	err := row.Scan(&notification.ID, &notification.UserID, &notification.Kind, &notification.Title, &notification.Body,
+		&notification.Link, &notification.ProfileID, &notification.ReadAt, &notification.CreatedAt)
+	return notification, err
+}
+
+// CreateNotifications creates the notification for each of its recipients and returns the notifications created,
+// none if no user matches.
+func (notificationStore *NotificationStore) CreateNotifications(ctx context.Context, notification NotificationRepo, tx pgx.Tx) ([]specs.Notification, error) {
+	recipients := psql.Select("u.id").
+		Column(sq.Expr("?", notification.Kind)).
+		Column(sq.Expr("?", notification.Title)).
+		Column(sq.Expr("?", notification.Body)).
+		Column(sq.Expr("?", notification.Link)).
+		Column(sq.Expr("?::INT", notification.ProfileID)).
+		From(userTable + " u")
+
+	switch {
+	case notification.UserID != 0:
+		recipients = recipients.Where(sq.Eq{"u.id": notification.UserID})
+	case notification.Email != "":
+		recipients = recipients.Where(sq.Eq{"u.email": notification.Email})
+	case notification.Permission != "":
+		recipients = recipients.Where(sq.Eq{"u.is_active": true}).Where(
+			fmt.Sprintf("u.role IN (SELECT r.name FROM %s r JOIN %s rp ON rp.role_id = r.id WHERE rp.permission = ?)", roleTable, rolePermissionTable),
+			notification.Permission)
+	default:
+		return nil, fmt.Errorf("notification %s has no recipient", notification.Kind)
+	}
+
+	query, args, err := psql.Insert(notificationTable).
+		Columns("user_id", "kind", "title", "body", "link", "profile_id").
+		Select(recipients).
+		Suffix("RETURNING " + strings.Join(notificationColumns, ", ")).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating create notifications query: ", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing create notifications query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := []specs.Notification{}
+	for rows.Next() {
+		created, err := scanNotification(rows)
+		if err != nil {
+			zap.S().Error("Error scanning created notification: ", err)
+			return nil, err
+		}
+		notifications = append(notifications, created)
+	}
+	return notifications, rows.Err()
+}
+
+// ListNotifications returns a page of the notifications of a user, newest first.
+func (notificationStore *NotificationStore) ListNotifications(ctx context.Context, userID int, filter specs.ListNotificationsFilter) ([]specs.Notification, error) {
+	builder := psql.Select(notificationColumns...).
+		From(notificationTable).
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("id DESC").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64((filter.Page - 1) * filter.Limit))
+	if filter.UnreadOnly {
+		builder = builder.Where(sq.Eq{"read_at": nil})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list notifications query: ", err)
+		return nil, err
+	}
+
+	rows, err := notificationStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list notifications query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := []specs.Notification{}
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			zap.S().Error("Error scanning notification: ", err)
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications, rows.Err()
+}
+
+// CountUnreadNotifications returns how many notifications of a user are unread
+func (notificationStore *NotificationStore) CountUnreadNotifications(ctx context.Context, userID int) (int, error) {
+	query, args, err := psql.Select("COUNT(*)").
+		From(notificationTable).
+		Where(sq.Eq{"user_id": userID, "read_at": nil}).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating count unread notifications query: ", err)
+		return 0, err
+	}
+
+	var count int
+	err = notificationStore.db.QueryRow(ctx, query, args...).Scan(&count)
+	if err != nil {
+		zap.S().Error("Error executing count unread notifications query: ", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// MarkNotificationRead marks a notification of a user read, keeping when it was first read. It returns
+// ErrNotificationNotFound if the user has no such notification.
+func (notificationStore *NotificationStore) MarkNotificationRead(ctx context.Context, userID int, notificationID int64) (specs.Notification, error) {
+	query, args, err := psql.Update(notificationTable).
+		Set("read_at", sq.Expr("COALESCE(read_at, CURRENT_TIMESTAMP)")).
+		Where(sq.Eq{"id": notificationID, "user_id": userID}).
+		Suffix("RETURNING " + strings.Join(notificationColumns, ", ")).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating mark notification read query: ", err)
+		return specs.Notification{}, err
+	}
+
+	notification, err := scanNotification(notificationStore.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return specs.Notification{}, errors.ErrNotificationNotFound
+		}
+		zap.S().Error("Error executing mark notification read query: ", err)
+		return specs.Notification{}, err
+	}
+	return notification, nil
+}
+
+// MarkAllNotificationsRead marks every unread notification of a user read and returns how many there were
+func (notificationStore *NotificationStore) MarkAllNotificationsRead(ctx context.Context, userID int) (int, error) {
+	query, args, err := psql.Update(notificationTable).
+		Set("read_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"user_id": userID, "read_at": nil}).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating mark all notifications read query: ", err)
+		return 0, err
+	}
+
+	res, err := notificationStore.db.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing mark all notifications read query: ", err)
+		return 0, err
+	}
+	return int(res.RowsAffected()), nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// ProfileReminderStore implements the ProfileReminderStorer interface.
+type ProfileReminderStore struct {
+	db *pgxpool.Pool
+}
+
+// Constants for stale profile reminder table names
+var (
+	staleProfileReminderTable = "stale_profile_reminders"
+)
+
+// ProfileReminderStorer defines methods to find the profiles that have gone stale and to record the reminders sent
+// for them.
+type ProfileReminderStorer interface {
+	ListProfileActivity(ctx context.Context) ([]specs.ProfileActivity, error)
+	RecordStaleProfileReminder(ctx context.Context, profileID int, remindedBefore time.Time, tx pgx.Tx) (bool, error)
+}
+
+// NewProfileReminderRepo creates a new instance of ProfileReminderRepo.
+func NewProfileReminderRepo(db *pgxpool.Pool) ProfileReminderStorer {
+	return &ProfileReminderStore{
+		db: db,
+	}
+}
+
+// ListProfileActivity returns when the profile of every active current employee was last updated, the end dates of
+// their projects, whether they have been invited and whether they have been reminded of it already.
+func (reminderStore *ProfileReminderStore) ListProfileActivity(ctx context.Context) ([]specs.ProfileActivity, error) {
+	query, args, err := psql.Select("p.id", "p.name", "p.email", "p.updated_at",
+		"ARRAY(SELECT COALESCE(pr.working_end_date, '') FROM projects pr WHERE pr.profile_id = p.id) AS project_end_dates",
+		fmt.Sprintf("EXISTS (SELECT 1 FROM %s i WHERE i.profile_id = p.id AND i.is_profile_complete = 0) AS has_open_invitation", invitationTable),
+		fmt.Sprintf("EXISTS (SELECT 1 FROM %s i WHERE i.profile_id = p.id AND i.is_profile_complete = 1) AS has_completed_invitation", invitationTable),
+		"COALESCE(r.reminders_sent, 0)", "r.last_reminded_at").
+		From(ProfileTable + " p").
+		LeftJoin(staleProfileReminderTable + " r ON r.profile_id = p.id").
+		Where(sq.Eq{"p.is_active": 1, "p.is_current_employee": 1}).
+		OrderBy("p.id").
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list profile activity query: ", err)
+		return nil, err
+	}
+
+	rows, err := reminderStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list profile activity query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	activities := []specs.ProfileActivity{}
+	for rows.Next() {
+		var activity specs.ProfileActivity
+		err = rows.Scan(&activity.ProfileID, &activity.Name, &activity.Email, &activity.UpdatedAt, &activity.ProjectEndDates,
+			&activity.HasOpenInvitation, &activity.HasCompletedInvitation, &activity.RemindersSent, &activity.LastRemindedAt)
+		if err != nil {
+			zap.S().Error("Error scanning profile activity: ", err)
+			return nil, err
+		}
+		activities = append(activities, activity)
+	}
+	return activities, rows.Err()
+}
+
+// RecordStaleProfileReminder records that the employee of a profile has just been reminded that it is stale, and
+// reports whether it did. Nothing is recorded when they were last reminded after remindedBefore, e.g. by another run.
+func (reminderStore *ProfileReminderStore) RecordStaleProfileReminder(ctx context.Context, profileID int, remindedBefore time.Time, tx pgx.Tx) (bool, error) {
+	query, args, err := psql.Insert(staleProfileReminderTable).
+		Columns("profile_id", "reminders_sent", "last_reminded_at").
+		Values(profileID, 1, sq.Expr("CURRENT_TIMESTAMP")).
+		Suffix("ON CONFLICT (profile_id) DO UPDATE SET reminders_sent = "+staleProfileReminderTable+".reminders_sent + 1, last_reminded_at = EXCLUDED.last_reminded_at"+
+			" WHERE "+staleProfileReminderTable+".last_reminded_at IS NULL OR "+staleProfileReminderTable+".last_reminded_at <= ?", remindedBefore).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating record stale profile reminder query: ", err)
+		return false, err
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing record stale profile reminder query: ", err)
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
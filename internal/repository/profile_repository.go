@@ -3,10 +3,6 @@ package repository
 import (
 	"context"
 	"fmt"
-	"log"
-	"os"
-	"os/exec"
-	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
@@ -32,7 +28,7 @@ var (
 // ProfileStorer defines methods to interact with user profile data.
 type ProfileStorer interface {
 	CreateProfile(ctx context.Context, pd ProfileRepo, tx pgx.Tx) (int, error)
-	ListProfiles(ctx context.Context, tx pgx.Tx) (values []specs.ListProfiles, err error)
+	ListProfiles(ctx context.Context, filter specs.ListProfilesFilter, tx pgx.Tx) (values []specs.ListProfiles, err error)
 	GetProfile(ctx context.Context, profileID int, tx pgx.Tx) (value specs.ResponseProfile, err error)
 	UpdateProfile(ctx context.Context, profileID int, pd UpdateProfileRepo, tx pgx.Tx) (int, error)
 	UpdateSequence(ctx context.Context, us UpdateSequenceRequest, tx pgx.Tx) (ID int, err error)
@@ -42,10 +38,14 @@ type ProfileStorer interface {
 	ListSkills(ctx context.Context, tx pgx.Tx) (values specs.ListSkills, err error)
 	BeginTransaction(ctx context.Context) (tx pgx.Tx, err error)
 	HandleTransaction(ctx context.Context, tx pgx.Tx, incomingErr error) (err error)
-	BackupAllProfiles(backupDir string)
 	GetProfileIDByEmail(ctx context.Context, email string, tx pgx.Tx) (int, error)
 	GetProfileIDByEmployeeID(ctx context.Context, employeeID string, tx pgx.Tx) (int, error)
-	UpdateEmployeeIDByEmail(ctx context.Context, email string, employeeID string) error
+	GetProfileIDsByEmployeeIDs(ctx context.Context, employeeIDs []string, tx pgx.Tx) (map[string]int, error)
+	ListProfileChanges(ctx context.Context, filter specs.ListProfileChangesFilter, tx pgx.Tx) ([]specs.ProfileChangeV1, error)
+	UpdateProfileSyncFields(ctx context.Context, profileID int, fields map[string]interface{}, updatedAt string, tx pgx.Tx) error
+	ListProfileEmployments(ctx context.Context) ([]specs.ProfileEmployment, error)
+	UpdateProfileManager(ctx context.Context, profileID int, managerID *int, tx pgx.Tx) error
+	IsReportee(ctx context.Context, managerID int, profileID int) (bool, error)
 }
 
 // NewProfileRepo creates a new instance of ProfileRepo.
@@ -96,8 +96,14 @@ func (profileStore *ProfileStore) CreateProfile(ctx context.Context, pd ProfileR
 }
 
 // ListProfiles returns a list of all profiles in the Database that are currently available
-func (profileStore *ProfileStore) ListProfiles(ctx context.Context, tx pgx.Tx) ([]specs.ListProfiles, error) {
+func (profileStore *ProfileStore) ListProfiles(ctx context.Context, filter specs.ListProfilesFilter, tx pgx.Tx) ([]specs.ListProfiles, error) {
 	queryBuilder := psql.Select(constants.ListProfilesColumns...).From("profiles p").OrderBy("p.created_at DESC")
+	if filter.ManagerID > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"p.manager_id": filter.ManagerID})
+	}
+	if filter.ProfileID > 0 {
+		queryBuilder = queryBuilder.Where(sq.Eq{"p.id": filter.ProfileID})
+	}
 	sql, args, err := queryBuilder.ToSql()
 	if err != nil {
 		zap.S().Error("Error generating select query: ", err)
@@ -400,14 +406,27 @@ func (profileStore *ProfileStore) UpdateProfileStatus(ctx context.Context, profi
 	return nil
 }
 
-// BeginTransaction used to begin transaction while each task
+// BeginTransaction used to begin transaction while each task, passing the actor and request of ctx on to the audit triggers
 func (profileStore *ProfileStore) BeginTransaction(ctx context.Context) (tx pgx.Tx, err error) {
 	tx, err = profileStore.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return
+	}
+
+	err = setAuditContext(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, err
+	}
 	return
 }
 
 // HandleTransaction used to handle transaction while each task
 func (profileStore *ProfileStore) HandleTransaction(ctx context.Context, tx pgx.Tx, incomingErr error) (err error) {
+	// BeginTransaction failed, so there is nothing to roll back or commit
+	if tx == nil {
+		return incomingErr
+	}
 	if incomingErr != nil {
 		err = tx.Rollback(ctx)
 		if err != nil {
@@ -422,26 +441,6 @@ func (profileStore *ProfileStore) HandleTransaction(ctx context.Context, tx pgx.
 	return nil
 }
 
-// BackupAllProfiles used to backing up all the data at every midnight by CronJOb
-func (profileStore *ProfileStore) BackupAllProfiles(backupDir string) {
-	zap.S().Info("Starting backing up data...")
-
-	dbName := os.Getenv("BACKUP_DBNAME")
-	dbUser := os.Getenv("BACKUP_USER")
-	dbPass := os.Getenv("BACKUP_PASSWORD")
-	backupFile := fmt.Sprintf("%s/%s_backup_%s.sql", backupDir, dbName, time.Now().Format("20060102150405"))
-
-	os.Setenv("PGPASSWORD", dbPass)
-	defer os.Unsetenv("PGPASSWORD")
-
-	cmd := exec.Command("pg_dump", "-U", dbUser, "-f", backupFile, dbName)
-	if output, err := cmd.CombinedOutput(); err != nil {
-		log.Fatalf("Failed to run pg_dump: %v, output: %s", err, output)
-	}
-
-	zap.S().Infow("Database backed up successfully", "fileName", backupFile)
-}
-
 // GetProfileIDByEmail returns the profile ID for a given email.
 func (profileStore *ProfileStore) GetProfileIDByEmail(ctx context.Context, email string, tx pgx.Tx) (int, error) {
 	query := psql.Select("id").From("profiles").Where(sq.Eq{"email": email})
@@ -486,27 +485,184 @@ func (profileStore *ProfileStore) GetProfileIDByEmployeeID(ctx context.Context,
 	return profileID, nil
 }
 
-// UpdateEmployeeIDByEmail sets the employee_id on the profile identified by email.
-func (profileStore *ProfileStore) UpdateEmployeeIDByEmail(ctx context.Context, email string, employeeID string) error {
+// GetProfileIDsByEmployeeIDs returns the profile ID of each of the given employee IDs that has a profile.
+func (profileStore *ProfileStore) GetProfileIDsByEmployeeIDs(ctx context.Context, employeeIDs []string, tx pgx.Tx) (map[string]int, error) {
+	query, args, err := psql.Select("employee_id", "id").From(ProfileTable).Where(sq.Eq{"employee_id": employeeIDs}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating select query: ", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing select query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	profileIDs := make(map[string]int, len(employeeIDs))
+	for rows.Next() {
+		var employeeID string
+		var profileID int
+		if err := rows.Scan(&employeeID, &profileID); err != nil {
+			zap.S().Error("Error scanning row: ", err)
+			return nil, err
+		}
+		profileIDs[employeeID] = profileID
+	}
+
+	return profileIDs, rows.Err()
+}
+
+// ListProfileEmployments returns the employment status of every profile.
+func (profileStore *ProfileStore) ListProfileEmployments(ctx context.Context) ([]specs.ProfileEmployment, error) {
+	query, args, err := psql.Select("id", "name", "email", "employee_id", "is_current_employee", "is_active", "created_by_id").
+		From(ProfileTable).OrderBy("id").ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list profile employments query: ", err)
+		return nil, err
+	}
+
+	rows, err := profileStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list profile employments query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	employments := []specs.ProfileEmployment{}
+	for rows.Next() {
+		var employment specs.ProfileEmployment
+		if err := rows.Scan(&employment.ProfileID, &employment.Name, &employment.Email, &employment.EmployeeID, &employment.IsCurrentEmployee, &employment.IsActive, &employment.CreatedByID); err != nil {
+			zap.S().Error("Error scanning row: ", err)
+			return nil, err
+		}
+		employments = append(employments, employment)
+	}
+
+	return employments, rows.Err()
+}
+
+// ListProfileChanges returns the profiles with an employee ID that changed or were deleted after the position in the
+// filter and more than the lag of the filter ago, oldest first. Deletions are read from the audit log, since the
+// deleted rows are gone.
+func (profileStore *ProfileStore) ListProfileChanges(ctx context.Context, filter specs.ListProfileChangesFilter, tx pgx.Tx) ([]specs.ProfileChangeV1, error) {
+	deleted, deletedArgs, err := sq.Select("before->>'employee_id'", "target_id", "created_at", "true").
+		From("audit_events").
+		Where(sq.Eq{"target_type": ProfileTable}).
+		Where("before IS NOT NULL AND after IS NULL AND before->>'employee_id' IS NOT NULL").
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating deleted profiles query: ", err)
+		return nil, err
+	}
+
+	changes := sq.Select("employee_id", "id AS profile_id", "last_modified_at AS changed_at", "false AS deleted").
+		From(ProfileTable).
+		Where("employee_id IS NOT NULL").
+		Suffix("UNION ALL "+deleted, deletedArgs...)
+
+	query, args, err := psql.Select("employee_id", "profile_id", "changed_at", "deleted").
+		FromSelect(changes, "changes").
+		Where(sq.Expr("(changed_at, profile_id) > (?, ?)", filter.Since, filter.AfterID)).
+		Where(sq.Expr("changed_at < LOCALTIMESTAMP - make_interval(secs => ?)", filter.Lag.Seconds())).
+		OrderBy("changed_at", "profile_id").
+		Limit(uint64(filter.Limit)).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating profile changes query: ", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing profile changes query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []specs.ProfileChangeV1{}
+	for rows.Next() {
+		var change specs.ProfileChangeV1
+		if err := rows.Scan(&change.EmployeeID, &change.ProfileID, &change.ChangedAt, &change.Deleted); err != nil {
+			zap.S().Error("Error scanning profile change: ", err)
+			return nil, err
+		}
+		values = append(values, change)
+	}
+
+	return values, rows.Err()
+}
+
+// UpdateProfileSyncFields sets the given profile columns to the values taken from the intranet.
+func (profileStore *ProfileStore) UpdateProfileSyncFields(ctx context.Context, profileID int, fields map[string]interface{}, updatedAt string, tx pgx.Tx) error {
 	updateQuery, args, err := psql.Update(ProfileTable).
-		Set("employee_id", employeeID).
-		Where(sq.Eq{"email": email}).
+		SetMap(fields).
+		Set("updated_at", updatedAt).
+		Where(sq.Eq{"id": profileID}).
 		ToSql()
-		
 	if err != nil {
-		zap.S().Error("Error generating update employee_id by email query: ", err)
+		zap.S().Error("Error generating update profile sync fields query: ", err)
 		return err
 	}
 
-	res, err := profileStore.db.Exec(ctx, updateQuery, args...)
+	res, err := tx.Exec(ctx, updateQuery, args...)
 	if err != nil {
-		zap.S().Errorf("Error executing update employee_id for email %s: %v", email, err)
+		if helpers.IsDuplicateKeyError(err) {
+			return errors.ErrDuplicateKey
+		}
+		zap.S().Error("Error executing update profile sync fields query: ", err)
 		return err
 	}
 
 	if res.RowsAffected() == 0 {
 		return errors.ErrNoRecordFound
 	}
+	return nil
+}
+
+// UpdateProfileManager sets the reporting manager of a profile, or clears it when managerID is nil.
+func (profileStore *ProfileStore) UpdateProfileManager(ctx context.Context, profileID int, managerID *int, tx pgx.Tx) error {
+	query, args, err := psql.Update(ProfileTable).
+		Set("manager_id", managerID).
+		Where(sq.Eq{"id": profileID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating update profile manager query: ", err)
+		return err
+	}
 
+	res, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		if helpers.IsInvalidProfileError(err) {
+			return errors.ErrInvalidUserID
+		}
+		zap.S().Error("Error executing update profile manager query: ", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return errors.ErrNoRecordFound
+	}
 	return nil
 }
+
+// IsReportee returns true if the given profile reports to the user with managerID.
+func (profileStore *ProfileStore) IsReportee(ctx context.Context, managerID int, profileID int) (bool, error) {
+	query, args, err := psql.Select("1").From(ProfileTable).
+		Where(sq.Eq{"id": profileID, "manager_id": managerID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating reportee query: ", err)
+		return false, err
+	}
+
+	var exists int
+	err = profileStore.db.QueryRow(ctx, query, args...).Scan(&exists)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		zap.S().Error("Error executing reportee query: ", err)
+		return false, err
+	}
+	return true, nil
+}
@@ -24,6 +24,8 @@ type ProjectStorer interface {
 	ListProjects(ctx context.Context, profileID int, filter specs.ListProjectsFilter, tx pgx.Tx) (values []specs.ProjectResponse, err error)
 	UpdateProject(ctx context.Context, profileID int, eduID int, req UpdateProjectRepo, tx pgx.Tx) (int, error)
 	DeleteProject(ctx context.Context, profileID, projectID int, tx pgx.Tx) error
+	ListIntranetProjectRejections(ctx context.Context, profileID int, tx pgx.Tx) ([]specs.IntranetProjectRejection, error)
+	UpsertIntranetProjectRejection(ctx context.Context, rejection IntranetProjectRejectionRepo, tx pgx.Tx) error
 }
 
 // NewProjectRepo creates a new instance of ProfileRepo.
@@ -157,3 +159,61 @@ func (projectStore *ProjectStore) DeleteProject(ctx context.Context, profileID,
 	}
 	return nil
 }
+
+// ListIntranetProjectRejections returns the intranet projects the employee of a profile chose not to import.
+func (projectStore *ProjectStore) ListIntranetProjectRejections(ctx context.Context, profileID int, tx pgx.Tx) ([]specs.IntranetProjectRejection, error) {
+	query, args, err := psql.Select("name_key", "description", "working_start_date", "working_end_date").
+		From("intranet_project_rejections").
+		Where(sq.Eq{"profile_id": profileID}).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list intranet project rejections query: ", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list intranet project rejections query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	rejections := []specs.IntranetProjectRejection{}
+	for rows.Next() {
+		var rejection specs.IntranetProjectRejection
+		if err := rows.Scan(&rejection.NameKey, &rejection.Description, &rejection.WorkingStartDate, &rejection.WorkingEndDate); err != nil {
+			zap.S().Error("Error scanning intranet project rejection: ", err)
+			return nil, err
+		}
+		rejections = append(rejections, rejection)
+	}
+
+	return rejections, rows.Err()
+}
+
+// UpsertIntranetProjectRejection records that an employee chose not to import an intranet project, replacing an
+// earlier rejection of the same project.
+func (projectStore *ProjectStore) UpsertIntranetProjectRejection(ctx context.Context, rejection IntranetProjectRejectionRepo, tx pgx.Tx) error {
+	query, args, err := psql.Insert("intranet_project_rejections").
+		Columns("profile_id", "name_key", "description", "working_start_date", "working_end_date", "rejected_by_id", "rejected_at").
+		Values(rejection.ProfileID, rejection.NameKey, rejection.Description, rejection.WorkingStartDate, rejection.WorkingEndDate,
+			rejection.RejectedByID, sq.Expr("CURRENT_TIMESTAMP")).
+		Suffix(`ON CONFLICT (profile_id, name_key) DO UPDATE SET description = EXCLUDED.description,
+			working_start_date = EXCLUDED.working_start_date, working_end_date = EXCLUDED.working_end_date,
+			rejected_by_id = EXCLUDED.rejected_by_id, rejected_at = EXCLUDED.rejected_at`).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating upsert intranet project rejection query: ", err)
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		if helpers.IsInvalidProfileError(err) {
+			return errors.ErrInvalidProfile
+		}
+		zap.S().Error("Error executing upsert intranet project rejection query: ", err)
+		return err
+	}
+	return nil
+}
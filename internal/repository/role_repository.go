@@ -0,0 +1,285 @@
+package repository
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
+	"go.uber.org/zap"
+)
+
+// RoleStore implements the RoleStorer interface.
+type RoleStore struct {
+	db *pgxpool.Pool
+}
+
+// Constants for role table names
+var (
+	roleTable           = "roles"
+	rolePermissionTable = "role_permissions"
+)
+
+// RoleStorer defines methods to interact with roles and their permissions.
+type RoleStorer interface {
+	ListRoles(ctx context.Context, tx pgx.Tx) ([]specs.RoleResponse, error)
+	GetRole(ctx context.Context, roleID int, tx pgx.Tx) (specs.RoleResponse, error)
+	CreateRole(ctx context.Context, role RoleRepo, tx pgx.Tx) (int, error)
+	UpdateRole(ctx context.Context, roleID int, description string, updatedAt string, tx pgx.Tx) error
+	ReplaceRolePermissions(ctx context.Context, roleID int, permissions []specs.RolePermission, tx pgx.Tx) error
+	DeleteRole(ctx context.Context, roleID int, tx pgx.Tx) error
+	CountUsersWithRole(ctx context.Context, roleName string, tx pgx.Tx) (int, error)
+	ListRolePermissions(ctx context.Context) (map[string]map[string]string, error)
+}
+
+// NewRoleRepo creates a new instance of RoleRepo.
+func NewRoleRepo(db *pgxpool.Pool) RoleStorer {
+	return &RoleStore{
+		db: db,
+	}
+}
+
+// ListRoles returns every role along with its granted permissions.
+func (roleStore *RoleStore) ListRoles(ctx context.Context, tx pgx.Tx) ([]specs.RoleResponse, error) {
+	query, args, err := psql.Select("r.id", "r.name", "r.description", "r.is_system", "rp.permission", "rp.scope").
+		From(roleTable+" r").
+		LeftJoin(rolePermissionTable+" rp ON rp.role_id = r.id").
+		OrderBy("r.id", "rp.permission").ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list roles query: ", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list roles query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []specs.RoleResponse
+	for rows.Next() {
+		var role specs.RoleResponse
+		var permission, scope *string
+		err = rows.Scan(&role.ID, &role.Name, &role.Description, &role.IsSystem, &permission, &scope)
+		if err != nil {
+			zap.S().Error("Error scanning role row: ", err)
+			return nil, err
+		}
+
+		if len(roles) == 0 || roles[len(roles)-1].ID != role.ID {
+			role.Permissions = []specs.RolePermission{}
+			roles = append(roles, role)
+		}
+		if permission != nil {
+			last := &roles[len(roles)-1]
+			last.Permissions = append(last.Permissions, specs.RolePermission{Permission: *permission, Scope: *scope})
+		}
+	}
+
+	return roles, rows.Err()
+}
+
+// GetRole returns a single role along with its granted permissions.
+func (roleStore *RoleStore) GetRole(ctx context.Context, roleID int, tx pgx.Tx) (specs.RoleResponse, error) {
+	query, args, err := psql.Select("id", "name", "description", "is_system").From(roleTable).Where(sq.Eq{"id": roleID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get role query: ", err)
+		return specs.RoleResponse{}, err
+	}
+
+	var role specs.RoleResponse
+	err = tx.QueryRow(ctx, query, args...).Scan(&role.ID, &role.Name, &role.Description, &role.IsSystem)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return specs.RoleResponse{}, errors.ErrRoleNotFound
+		}
+		zap.S().Error("Error executing get role query: ", err)
+		return specs.RoleResponse{}, err
+	}
+
+	query, args, err = psql.Select("permission", "scope").From(rolePermissionTable).Where(sq.Eq{"role_id": roleID}).OrderBy("permission").ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get role permissions query: ", err)
+		return specs.RoleResponse{}, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing get role permissions query: ", err)
+		return specs.RoleResponse{}, err
+	}
+	defer rows.Close()
+
+	role.Permissions = []specs.RolePermission{}
+	for rows.Next() {
+		var perm specs.RolePermission
+		if err = rows.Scan(&perm.Permission, &perm.Scope); err != nil {
+			zap.S().Error("Error scanning role permission row: ", err)
+			return specs.RoleResponse{}, err
+		}
+		role.Permissions = append(role.Permissions, perm)
+	}
+
+	return role, rows.Err()
+}
+
+// CreateRole inserts a new, non-system role.
+func (roleStore *RoleStore) CreateRole(ctx context.Context, role RoleRepo, tx pgx.Tx) (int, error) {
+	query, args, err := psql.Insert(roleTable).
+		Columns("name", "description", "is_system", "created_at", "updated_at").
+		Values(role.Name, role.Description, false, role.CreatedAt, role.UpdatedAt).
+		Suffix("RETURNING id").ToSql()
+	if err != nil {
+		zap.S().Error("Error generating create role query: ", err)
+		return 0, err
+	}
+
+	var roleID int
+	err = tx.QueryRow(ctx, query, args...).Scan(&roleID)
+	if err != nil {
+		if helpers.IsDuplicateKeyError(err) {
+			return 0, errors.ErrDuplicateKey
+		}
+		zap.S().Error("Error executing create role query: ", err)
+		return 0, err
+	}
+
+	return roleID, nil
+}
+
+// UpdateRole updates the description of a role.
+func (roleStore *RoleStore) UpdateRole(ctx context.Context, roleID int, description string, updatedAt string, tx pgx.Tx) error {
+	query, args, err := psql.Update(roleTable).
+		Set("description", description).
+		Set("updated_at", updatedAt).
+		Where(sq.Eq{"id": roleID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating update role query: ", err)
+		return err
+	}
+
+	res, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing update role query: ", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return errors.ErrRoleNotFound
+	}
+	return nil
+}
+
+// ReplaceRolePermissions replaces every permission granted to a role.
+func (roleStore *RoleStore) ReplaceRolePermissions(ctx context.Context, roleID int, permissions []specs.RolePermission, tx pgx.Tx) error {
+	query, args, err := psql.Delete(rolePermissionTable).Where(sq.Eq{"role_id": roleID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating delete role permissions query: ", err)
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing delete role permissions query: ", err)
+		return err
+	}
+
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	insertBuilder := psql.Insert(rolePermissionTable).Columns("role_id", "permission", "scope")
+	for _, perm := range permissions {
+		insertBuilder = insertBuilder.Values(roleID, perm.Permission, perm.Scope)
+	}
+
+	query, args, err = insertBuilder.ToSql()
+	if err != nil {
+		zap.S().Error("Error generating insert role permissions query: ", err)
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing insert role permissions query: ", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteRole deletes a role and, through the cascade, its permissions.
+func (roleStore *RoleStore) DeleteRole(ctx context.Context, roleID int, tx pgx.Tx) error {
+	query, args, err := psql.Delete(roleTable).Where(sq.Eq{"id": roleID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating delete role query: ", err)
+		return err
+	}
+
+	res, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		if helpers.IsInvalidProfileError(err) {
+			return errors.ErrRoleInUse
+		}
+		zap.S().Error("Error executing delete role query: ", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return errors.ErrRoleNotFound
+	}
+	return nil
+}
+
+// CountUsersWithRole returns the number of users currently assigned the given role.
+func (roleStore *RoleStore) CountUsersWithRole(ctx context.Context, roleName string, tx pgx.Tx) (int, error) {
+	query, args, err := psql.Select("count(*)").From(userTable).Where(sq.Eq{"role": roleName}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating count users with role query: ", err)
+		return 0, err
+	}
+
+	var count int
+	err = tx.QueryRow(ctx, query, args...).Scan(&count)
+	if err != nil {
+		zap.S().Error("Error executing count users with role query: ", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// ListRolePermissions returns every grant as role name -> permission -> scope.
+func (roleStore *RoleStore) ListRolePermissions(ctx context.Context) (map[string]map[string]string, error) {
+	query, args, err := psql.Select("r.name", "rp.permission", "rp.scope").
+		From(rolePermissionTable + " rp").
+		Join(roleTable + " r ON r.id = rp.role_id").ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list role permissions query: ", err)
+		return nil, err
+	}
+
+	rows, err := roleStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list role permissions query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	grants := make(map[string]map[string]string)
+	for rows.Next() {
+		var role, permission, scope string
+		if err = rows.Scan(&role, &permission, &scope); err != nil {
+			zap.S().Error("Error scanning role permission row: ", err)
+			return nil, err
+		}
+		if grants[role] == nil {
+			grants[role] = make(map[string]string)
+		}
+		grants[role][permission] = scope
+	}
+
+	return grants, rows.Err()
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/constants"
+	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/errors"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/helpers"
 	"github.com/joshsoftware/profile_builder_backend_go/internal/pkg/specs"
 	"go.uber.org/zap"
@@ -28,6 +29,11 @@ type EmailStorer interface {
 	GetInvitations(ctx context.Context, getRequest GetRequest, tx pgx.Tx) (specs.InvitationResponse, error)
 	CreateInvitation(ctx context.Context, invitation Invitations, tx pgx.Tx) error
 	UpdateProfileCompleteStatus(ctx context.Context, profileID int, updateReq UpdateRequest, tx pgx.Tx) error
+	GetPendingInvitation(ctx context.Context, profileID int, tx pgx.Tx) (specs.PendingInvitation, error)
+	ListPendingInvitations(ctx context.Context) ([]specs.PendingInvitation, error)
+	ListInvitations(ctx context.Context, filter specs.ListInvitationsFilter) ([]specs.Invitation, error)
+	RenewInvitation(ctx context.Context, profileID int, renewal RenewInvitationRepo, tx pgx.Tx) error
+	RecordInvitationReminder(ctx context.Context, profileID int, remindersSent int, tx pgx.Tx) (bool, error)
 }
 
 // NewUserEmailRepo creates a new instance of the email repository
@@ -69,7 +75,8 @@ func (emailStore *EmailStore) CreateInvitation(ctx context.Context, invitation I
 
 	insertQuery, args, err := psql.Insert(invitationTable).
 		Columns(constants.RequestInvitationColumns...).
-		Values(values...).
+		Columns("expires_at", "reminders_sent").
+		Values(append(values, invitation.ExpiresAt, invitation.RemindersSent)...).
 		Suffix("RETURNING id").
 		ToSql()
 	if err != nil {
@@ -102,7 +109,7 @@ func (emailStore *EmailStore) CreateInvitation(ctx context.Context, invitation I
 
 // UpdateProfileCompleteStatus updates the profile complete status for the given profile ID
 func (emailStore *EmailStore) UpdateProfileCompleteStatus(ctx context.Context, profileID int, updateReq UpdateRequest, tx pgx.Tx) error {
-	queryBuilder := psql.Update(invitationTable).Set("is_profile_complete", updateReq.ProfileComplete).Set("updated_at", updateReq.UpdatedAt).Where(sq.Eq{"profile_id": profileID, "is_profile_complete": 0})
+	queryBuilder := psql.Update(invitationTable).Set("is_profile_complete", updateReq.ProfileComplete).Set("updated_at", updateReq.UpdatedAt).Set("completed_at", updateReq.UpdatedAt).Where(sq.Eq{"profile_id": profileID, "is_profile_complete": 0})
 	updateQuery, args, err := queryBuilder.ToSql()
 	if err != nil {
 		zap.S().Error("Error generating update query: ", err)
@@ -121,3 +128,198 @@ func (emailStore *EmailStore) UpdateProfileCompleteStatus(ctx context.Context, p
 	}
 	return nil
 }
+
+// pendingInvitations selects the open invitations along with the name and email of their profile. Invitation
+// times are written in IST without a time zone, so days outstanding are counted from the time in IST.
+func pendingInvitations() sq.SelectBuilder {
+	return psql.Select("i.profile_id", "p.name", "p.email", "i.created_at", "i.created_by_id", "i.expires_at", "i.reminders_sent",
+		"i.last_reminded_at", "i.resent_count",
+		"GREATEST(EXTRACT(DAY FROM (CURRENT_TIMESTAMP AT TIME ZONE 'Asia/Kolkata') - i.created_at), 0)::INT AS days_outstanding",
+		"i.expires_at <= CURRENT_TIMESTAMP AS is_overdue").
+		From(invitationTable + " i").
+		Join(ProfileTable + " p ON p.id = i.profile_id").
+		Where(sq.Eq{"i.is_profile_complete": constants.ProfileIncomplete})
+}
+
+// scanPendingInvitation scans a row selected with pendingInvitations
+func scanPendingInvitation(row pgx.Row) (specs.PendingInvitation, error) {
+	var invitation specs.PendingInvitation
+	err := row.Scan(&invitation.ProfileID, &invitation.Name, &invitation.Email, &invitation.CreatedAt, &invitation.CreatedByID,
+		&invitation.ExpiresAt, &invitation.RemindersSent, &invitation.LastRemindedAt, &invitation.ResentCount,
+		&invitation.DaysOutstanding, &invitation.IsOverdue)
+	return invitation, err
+}
+
+// GetPendingInvitation returns the open invitation of a profile, or ErrInvitationNotFound if it has none.
+func (emailStore *EmailStore) GetPendingInvitation(ctx context.Context, profileID int, tx pgx.Tx) (specs.PendingInvitation, error) {
+	query, args, err := pendingInvitations().Where(sq.Eq{"i.profile_id": profileID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get pending invitation query: ", err)
+		return specs.PendingInvitation{}, err
+	}
+
+	invitation, err := scanPendingInvitation(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return specs.PendingInvitation{}, errors.ErrInvitationNotFound
+		}
+		zap.S().Error("Error executing get pending invitation query: ", err)
+		return specs.PendingInvitation{}, err
+	}
+	return invitation, nil
+}
+
+// ListPendingInvitations returns the open invitations that have not expired, the longest outstanding first.
+func (emailStore *EmailStore) ListPendingInvitations(ctx context.Context) ([]specs.PendingInvitation, error) {
+	query, args, err := pendingInvitations().Where("i.expires_at > CURRENT_TIMESTAMP").OrderBy("i.created_at", "i.profile_id").ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list pending invitations query: ", err)
+		return nil, err
+	}
+
+	rows, err := emailStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list pending invitations query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	invitations := []specs.PendingInvitation{}
+	for rows.Next() {
+		invitation, err := scanPendingInvitation(rows)
+		if err != nil {
+			zap.S().Error("Error scanning pending invitation: ", err)
+			return nil, err
+		}
+		invitations = append(invitations, invitation)
+	}
+	return invitations, rows.Err()
+}
+
+// ListInvitations returns the invitations matching the filter, the latest sent first, along with the name and email
+// of the user who sent them. A filter without a limit returns every invitation. Times are written in IST without a
+// time zone, so the bounds of the filter are compared in IST.
+func (emailStore *EmailStore) ListInvitations(ctx context.Context, filter specs.ListInvitationsFilter) ([]specs.Invitation, error) {
+	builder := psql.Select("i.id", "i.profile_id", "p.name", "p.email",
+		fmt.Sprintf("CASE WHEN i.is_profile_complete = %d THEN '%s' WHEN i.expires_at <= CURRENT_TIMESTAMP THEN '%s' ELSE '%s' END AS status",
+			constants.ProfileComplete, constants.InvitationCompleted, constants.InvitationOverdue, constants.InvitationPending),
+		"i.created_by_id", "u.name", "u.email", "i.created_at", "i.expires_at", "i.completed_at",
+		"ROUND((EXTRACT(EPOCH FROM i.completed_at - i.created_at) / 3600)::NUMERIC, 1)::FLOAT8 AS hours_to_complete",
+		fmt.Sprintf("CASE WHEN i.is_profile_complete = %d THEN GREATEST(EXTRACT(DAY FROM (CURRENT_TIMESTAMP AT TIME ZONE 'Asia/Kolkata') - i.created_at), 0)::INT END AS days_outstanding",
+			constants.ProfileIncomplete),
+		"i.reminders_sent", "i.last_reminded_at", "i.resent_count").
+		From(invitationTable+" i").
+		Join(ProfileTable+" p ON p.id = i.profile_id").
+		LeftJoin(userTable+" u ON u.id = i.created_by_id").
+		OrderBy("i.created_at DESC", "i.id DESC")
+
+	if filter.ProfileID != 0 {
+		builder = builder.Where(sq.Eq{"i.profile_id": filter.ProfileID})
+	}
+	if filter.ManagerID != 0 {
+		builder = builder.Where(sq.Eq{"p.manager_id": filter.ManagerID})
+	}
+	switch filter.Status {
+	case constants.InvitationCompleted:
+		builder = builder.Where(sq.Eq{"i.is_profile_complete": constants.ProfileComplete})
+	case constants.InvitationPending:
+		builder = builder.Where(sq.Eq{"i.is_profile_complete": constants.ProfileIncomplete}).Where("i.expires_at > CURRENT_TIMESTAMP")
+	case constants.InvitationOverdue:
+		builder = builder.Where(sq.Eq{"i.is_profile_complete": constants.ProfileIncomplete}).Where("i.expires_at <= CURRENT_TIMESTAMP")
+	}
+	if filter.InvitedByID != 0 {
+		builder = builder.Where(sq.Eq{"i.created_by_id": filter.InvitedByID})
+	}
+	if filter.From != nil {
+		builder = builder.Where("i.created_at >= (?::TIMESTAMPTZ AT TIME ZONE 'Asia/Kolkata')", *filter.From)
+	}
+	if filter.To != nil {
+		builder = builder.Where("i.created_at <= (?::TIMESTAMPTZ AT TIME ZONE 'Asia/Kolkata')", *filter.To)
+	}
+	if filter.CompletedFrom != nil {
+		builder = builder.Where("i.completed_at >= (?::TIMESTAMPTZ AT TIME ZONE 'Asia/Kolkata')", *filter.CompletedFrom)
+	}
+	if filter.Limit > 0 {
+		builder = builder.Limit(uint64(filter.Limit)).Offset(uint64((filter.Page - 1) * filter.Limit))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list invitations query: ", err)
+		return nil, err
+	}
+
+	rows, err := emailStore.db.Query(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing list invitations query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	invitations := []specs.Invitation{}
+	for rows.Next() {
+		var invitation specs.Invitation
+		err = rows.Scan(&invitation.ID, &invitation.ProfileID, &invitation.Name, &invitation.Email, &invitation.Status,
+			&invitation.InvitedByID, &invitation.InvitedByName, &invitation.InvitedByEmail, &invitation.CreatedAt,
+			&invitation.ExpiresAt, &invitation.CompletedAt, &invitation.HoursToComplete, &invitation.DaysOutstanding,
+			&invitation.RemindersSent, &invitation.LastRemindedAt, &invitation.ResentCount)
+		if err != nil {
+			zap.S().Error("Error scanning invitation: ", err)
+			return nil, err
+		}
+		invitations = append(invitations, invitation)
+	}
+	return invitations, rows.Err()
+}
+
+// RenewInvitation gives the open invitation of a profile a new expiry, as it is resent, and restarts its reminders.
+func (emailStore *EmailStore) RenewInvitation(ctx context.Context, profileID int, renewal RenewInvitationRepo, tx pgx.Tx) error {
+	query, args, err := psql.Update(invitationTable).
+		SetMap(map[string]interface{}{
+			"expires_at":       renewal.ExpiresAt,
+			"reminders_sent":   renewal.RemindersSent,
+			"last_reminded_at": nil,
+			"resent_count":     sq.Expr("resent_count + 1"),
+			"updated_at":       renewal.UpdatedAt,
+			"updated_by_id":    renewal.UpdatedByID,
+		}).
+		Where(sq.Eq{"profile_id": profileID, "is_profile_complete": constants.ProfileIncomplete}).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating renew invitation query: ", err)
+		return err
+	}
+
+	res, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing renew invitation query: ", err)
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return errors.ErrInvitationNotFound
+	}
+	return nil
+}
+
+// RecordInvitationReminder records that a reminder was sent for the open invitation of a profile, remindersSent
+// being the number of reminders now sent, and reports whether it did. Nothing is recorded when that many reminders
+// have already been sent, e.g. by another run.
+func (emailStore *EmailStore) RecordInvitationReminder(ctx context.Context, profileID int, remindersSent int, tx pgx.Tx) (bool, error) {
+	query, args, err := psql.Update(invitationTable).
+		Set("reminders_sent", remindersSent).
+		Set("last_reminded_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"profile_id": profileID, "is_profile_complete": constants.ProfileIncomplete}).
+		Where(sq.Lt{"reminders_sent": remindersSent}).
+		ToSql()
+	if err != nil {
+		zap.S().Error("Error generating record invitation reminder query: ", err)
+		return false, err
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		zap.S().Error("Error executing record invitation reminder query: ", err)
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
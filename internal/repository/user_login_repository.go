@@ -30,6 +30,15 @@ type UserStorer interface {
 	GetUserInfo(ctx context.Context, filter specs.UserInfoFilter) (User, error)
 	CreateUser(ctx context.Context, name, email string, role string, tx pgx.Tx) error
 	RemoveUser(ctx context.Context, email string, tx pgx.Tx) error
+	UpdateUserRole(ctx context.Context, userID int, role string, tx pgx.Tx) error
+	ListUsers(ctx context.Context, filter specs.ListUsersFilter, tx pgx.Tx) ([]specs.UserResponse, error)
+	GetUser(ctx context.Context, userID int, tx pgx.Tx) (specs.UserResponse, error)
+	AddUser(ctx context.Context, user UserRepo, tx pgx.Tx) (int, error)
+	UpdateUser(ctx context.Context, userID int, user UpdateUserRepo, tx pgx.Tx) error
+	UpdateUserStatus(ctx context.Context, userID int, isActive bool, updatedAt string, tx pgx.Tx) error
+	DeleteUser(ctx context.Context, userID int, tx pgx.Tx) error
+	CountActiveAdmins(ctx context.Context, tx pgx.Tx) (int, error)
+	UpdateLastLogin(ctx context.Context, userID int64, loginAt string, tx pgx.Tx) error
 }
 
 // NewUserLoginRepo defines repo dependancies
@@ -60,7 +69,7 @@ func (userStore *UserStore) GetUserInfo(ctx context.Context, filter specs.UserIn
 
 	// execute the query using pgx
 	row := userStore.db.QueryRow(ctx, selectQuery, args...)
-	err = row.Scan(&user.ID, &user.Email, &user.Role, &user.Name)
+	err = row.Scan(&user.ID, &user.Email, &user.Role, &user.Name, &user.IsActive)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return User{}, errs.ErrNoRecordFound
@@ -175,3 +184,228 @@ func (userStore *UserStore) RemoveUser(ctx context.Context, email string, tx pgx
 	}
 	return nil
 }
+
+// UpdateUserRole assigns the given role to a user
+func (userStore *UserStore) UpdateUserRole(ctx context.Context, userID int, role string, tx pgx.Tx) error {
+	query := psql.Update(userTable).Set("role", role).Where(sq.Eq{"id": userID})
+	sql, args, err := query.ToSql()
+	if err != nil {
+		zap.S().Error("Error generating update role query: ", err)
+		return err
+	}
+
+	res, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		if helpers.IsInvalidProfileError(err) {
+			return errs.ErrRoleNotFound
+		}
+		zap.S().Error("Error executing update role query: ", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		zap.S().Info("No rows affected for update user role")
+		return errs.ErrNoRecordFound
+	}
+	return nil
+}
+
+// ListUsers returns the users matching the given filter
+func (userStore *UserStore) ListUsers(ctx context.Context, filter specs.ListUsersFilter, tx pgx.Tx) ([]specs.UserResponse, error) {
+	query := psql.Select(constants.ListUsersColumns...).From(userTable).OrderBy("id")
+	if filter.Role != "" {
+		query = query.Where(sq.Eq{"role": filter.Role})
+	}
+	if filter.IsActive != nil {
+		query = query.Where(sq.Eq{"is_active": *filter.IsActive})
+	}
+	if filter.Permission != "" {
+		query = query.Where(fmt.Sprintf("role IN (SELECT r.name FROM %s r JOIN %s rp ON rp.role_id = r.id WHERE rp.permission = ?)", roleTable, rolePermissionTable),
+			filter.Permission)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		zap.S().Error("Error generating list users query: ", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		zap.S().Error("Error executing list users query: ", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []specs.UserResponse{}
+	for rows.Next() {
+		var user specs.UserResponse
+		err = rows.Scan(&user.ID, &user.Name, &user.Email, &user.Role, &user.IsActive, &user.LastLoginAt, &user.CreatedAt, &user.UpdatedAt)
+		if err != nil {
+			zap.S().Error("Error scanning user row: ", err)
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// GetUser returns a single user by id
+func (userStore *UserStore) GetUser(ctx context.Context, userID int, tx pgx.Tx) (specs.UserResponse, error) {
+	sql, args, err := psql.Select(constants.ListUsersColumns...).From(userTable).Where(sq.Eq{"id": userID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating get user query: ", err)
+		return specs.UserResponse{}, err
+	}
+
+	var user specs.UserResponse
+	err = tx.QueryRow(ctx, sql, args...).Scan(&user.ID, &user.Name, &user.Email, &user.Role, &user.IsActive, &user.LastLoginAt, &user.CreatedAt, &user.UpdatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return specs.UserResponse{}, errs.ErrUserNotFound
+		}
+		zap.S().Error("Error executing get user query: ", err)
+		return specs.UserResponse{}, err
+	}
+
+	return user, nil
+}
+
+// AddUser inserts a new user and fails if the email is already registered
+func (userStore *UserStore) AddUser(ctx context.Context, user UserRepo, tx pgx.Tx) (int, error) {
+	sql, args, err := psql.Insert(userTable).
+		Columns("name", "email", "role", "created_at", "updated_at").
+		Values(user.Name, user.Email, user.Role, user.CreatedAt, user.UpdatedAt).
+		Suffix("RETURNING id").ToSql()
+	if err != nil {
+		zap.S().Error("Error generating add user query: ", err)
+		return 0, err
+	}
+
+	var userID int
+	err = tx.QueryRow(ctx, sql, args...).Scan(&userID)
+	if err != nil {
+		if helpers.IsDuplicateKeyError(err) {
+			return 0, errs.ErrDuplicateKey
+		}
+		if helpers.IsInvalidProfileError(err) {
+			return 0, errs.ErrRoleNotFound
+		}
+		zap.S().Error("Error executing add user query: ", err)
+		return 0, err
+	}
+
+	return userID, nil
+}
+
+// UpdateUser updates the name and role of a user
+func (userStore *UserStore) UpdateUser(ctx context.Context, userID int, user UpdateUserRepo, tx pgx.Tx) error {
+	sql, args, err := psql.Update(userTable).
+		Set("name", user.Name).
+		Set("role", user.Role).
+		Set("updated_at", user.UpdatedAt).
+		Where(sq.Eq{"id": userID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating update user query: ", err)
+		return err
+	}
+
+	res, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		if helpers.IsInvalidProfileError(err) {
+			return errs.ErrRoleNotFound
+		}
+		zap.S().Error("Error executing update user query: ", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return errs.ErrUserNotFound
+	}
+	return nil
+}
+
+// UpdateUserStatus activates or deactivates a user
+func (userStore *UserStore) UpdateUserStatus(ctx context.Context, userID int, isActive bool, updatedAt string, tx pgx.Tx) error {
+	sql, args, err := psql.Update(userTable).
+		Set("is_active", isActive).
+		Set("updated_at", updatedAt).
+		Where(sq.Eq{"id": userID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating update user status query: ", err)
+		return err
+	}
+
+	res, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		zap.S().Error("Error executing update user status query: ", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return errs.ErrUserNotFound
+	}
+	return nil
+}
+
+// DeleteUser deletes a user by id
+func (userStore *UserStore) DeleteUser(ctx context.Context, userID int, tx pgx.Tx) error {
+	sql, args, err := psql.Delete(userTable).Where(sq.Eq{"id": userID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating delete user query: ", err)
+		return err
+	}
+
+	res, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		zap.S().Error("Error executing delete user query: ", err)
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return errs.ErrUserNotFound
+	}
+	return nil
+}
+
+// CountActiveAdmins returns the number of active users with the admin role
+func (userStore *UserStore) CountActiveAdmins(ctx context.Context, tx pgx.Tx) (int, error) {
+	// lock the admin rows so that two concurrent demotions cannot both see a second admin
+	sql, args, err := psql.Select("id").From(userTable).
+		Where(sq.Eq{"role": constants.Admin, "is_active": true}).
+		Suffix("FOR UPDATE").ToSql()
+	if err != nil {
+		zap.S().Error("Error generating count admins query: ", err)
+		return 0, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		zap.S().Error("Error executing count admins query: ", err)
+		return 0, err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		count++
+	}
+	return count, rows.Err()
+}
+
+// UpdateLastLogin records the time of the user's latest login
+func (userStore *UserStore) UpdateLastLogin(ctx context.Context, userID int64, loginAt string, tx pgx.Tx) error {
+	sql, args, err := psql.Update(userTable).Set("last_login_at", loginAt).Where(sq.Eq{"id": userID}).ToSql()
+	if err != nil {
+		zap.S().Error("Error generating update last login query: ", err)
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		zap.S().Error("Error executing update last login query: ", err)
+		return err
+	}
+	return nil
+}